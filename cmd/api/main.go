@@ -8,11 +8,18 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/Udean777/uang-bijak-go/internal/authtoken"
 	"github.com/Udean777/uang-bijak-go/internal/config"
 	"github.com/Udean777/uang-bijak-go/internal/handler"
+	"github.com/Udean777/uang-bijak-go/internal/jwtkeys"
+	"github.com/Udean777/uang-bijak-go/internal/loginguard"
+	"github.com/Udean777/uang-bijak-go/internal/mailer"
 	"github.com/Udean777/uang-bijak-go/internal/middleware"
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/oidc"
 	"github.com/Udean777/uang-bijak-go/internal/repository"
 	"github.com/Udean777/uang-bijak-go/internal/service"
+	"github.com/Udean777/uang-bijak-go/internal/worker"
 )
 
 func main() {
@@ -31,58 +38,220 @@ func main() {
 	}
 	log.Println("Berhasil terhubung ke database")
 
+	categoryTemplate := models.DefaultCategoryTemplate
+	if cfg.CategoryTemplateFile != "" {
+		categoryTemplate, err = models.LoadCategoryTemplate(cfg.CategoryTemplateFile)
+		if err != nil {
+			log.Fatalf("Gagal memuat template kategori: %v", err)
+		}
+	}
+
+	jwtKeys := jwtkeys.NewHMACKeySet(cfg.JwtSecret)
+	if cfg.JWTKeysDir != "" {
+		var legacy jwtkeys.LegacyHMAC
+		if cfg.JWTAllowLegacyHS256 {
+			legacy = jwtkeys.LegacyHMAC{Secret: cfg.JwtSecret, Until: cfg.JWTLegacyHS256Until}
+		}
+		jwtKeys, err = jwtkeys.LoadDir(cfg.JWTKeysDir, cfg.JWTActiveKeyID, legacy)
+		if err != nil {
+			log.Fatalf("Gagal memuat kunci JWT: %v", err)
+		}
+	}
+	jwksHandler := handler.NewJWKSHandler(jwtKeys)
+	tokenManager := authtoken.NewManager(jwtKeys, cfg.JWTIssuer, cfg.JWTAudience)
+
 	userRepo := repository.NewUserRepository(dbpool)
-	userService := service.NewUserService(userRepo)
+	preferencesRepo := repository.NewPreferencesRepository(dbpool)
+
+	var mail mailer.Mailer
+	if cfg.Mail.Driver == "smtp" {
+		mail = mailer.NewSMTPMailer(cfg.Mail.SMTPHost, cfg.Mail.SMTPPort, cfg.Mail.SMTPUsername, cfg.Mail.SMTPPassword, cfg.Mail.From)
+	} else {
+		mail = mailer.NewLogMailer(cfg.Mail.OutputDir, cfg.Mail.From)
+	}
+
+	emailVerificationRepo := repository.NewEmailVerificationRepository(dbpool)
+	emailVerificationService := service.NewEmailVerificationService(userRepo, emailVerificationRepo, mail, cfg.AppBaseURL, cfg.EmailVerifyTTL)
+	verificationHandler := handler.NewVerificationHandler(emailVerificationService)
+
+	userService := service.NewUserService(userRepo, preferencesRepo, emailVerificationService)
 	userHandler := handler.NewUserHandler(userService)
 
-	authService := service.NewAuthService(userRepo, cfg.JwtSecret, cfg.AccessTokenTTL, cfg.RefreshTokenTTL)
+	unverifiedPolicy, err := middleware.ParseUnverifiedPolicy(cfg.UnverifiedUserPolicy)
+	if err != nil {
+		log.Fatalf("Konfigurasi UNVERIFIED_USER_POLICY tidak valid: %v", err)
+	}
+
+	mfaRepo := repository.NewMFARepository(dbpool)
+	mfaService := service.NewMFAService(userRepo, mfaRepo, cfg.MFAIssuer)
+	mfaHandler := handler.NewMFAHandler(mfaService)
+
+	var loginAttemptStore loginguard.Store
+	if cfg.LoginGuard.Store == "postgres" {
+		loginAttemptStore = loginguard.NewPostgresStore(dbpool)
+	} else {
+		loginAttemptStore = loginguard.NewMemoryStore()
+	}
+	loginGuard := loginguard.New(loginAttemptStore, loginguard.Policy{
+		AccountAttempts: cfg.LoginGuard.AccountAttempts,
+		IPAttempts:      cfg.LoginGuard.IPAttempts,
+		BaseDelay:       cfg.LoginGuard.BaseDelay,
+		MaxDelay:        cfg.LoginGuard.MaxDelay,
+		Window:          cfg.LoginGuard.Window,
+	})
+
+	sessionRepo := repository.NewSessionRepository(dbpool)
+	refreshTokenRepo := repository.NewRefreshTokenRepository(dbpool)
+	personalAccessTokenRepo := repository.NewPersonalAccessTokenRepository(dbpool)
+	authService := service.NewAuthService(userRepo, sessionRepo, refreshTokenRepo, personalAccessTokenRepo, emailVerificationService, mfaService, loginGuard, categoryTemplate, tokenManager, cfg.AccessTokenTTL, cfg.RefreshTokenTTL)
 	authHandler := handler.NewAuthHandler(authService)
-	authMiddleware := middleware.AuthMiddleware(cfg.JwtSecret)
+
+	oidcProviders := make([]*oidc.Provider, 0, len(cfg.OIDCProviders))
+	for _, p := range cfg.OIDCProviders {
+		oidcProviders = append(oidcProviders, oidc.NewProvider(oidc.Config{
+			Name:         p.Name,
+			IssuerURL:    p.IssuerURL,
+			ClientID:     p.ClientID,
+			ClientSecret: p.ClientSecret,
+			RedirectURL:  p.RedirectURL,
+			Scopes:       p.Scopes,
+		}, nil))
+	}
+	oidcRepo := repository.NewOIDCRepository(dbpool)
+	oidcService := service.NewOIDCService(oidcProviders, oidcRepo, userRepo, authService, categoryTemplate, cfg.OIDCStateTTL)
+	oidcHandler := handler.NewOIDCHandler(oidcService)
+
+	passwordResetRepo := repository.NewPasswordResetRepository(dbpool)
+	passwordService := service.NewPasswordService(userRepo, sessionRepo, personalAccessTokenRepo, passwordResetRepo, mail, cfg.AppBaseURL, cfg.PasswordResetTTL)
+	passwordHandler := handler.NewPasswordHandler(passwordService)
+
+	sessionService := service.NewSessionService(sessionRepo)
+	sessionHandler := handler.NewSessionHandler(sessionService)
+
+	personalAccessTokenService := service.NewPersonalAccessTokenService(personalAccessTokenRepo, userRepo)
+	personalAccessTokenHandler := handler.NewPersonalAccessTokenHandler(personalAccessTokenService)
+
+	authMiddleware := middleware.AuthMiddleware(tokenManager, sessionService, cfg.SessionCacheTTL, personalAccessTokenService)
+	interactiveOnly := middleware.RejectPersonalAccessTokens()
 
 	categoryRepo := repository.NewCategoryRepository(dbpool)
-	categoryService := service.NewCategoryService(categoryRepo)
+	walletRepo := repository.NewWalletRepository(dbpool)
+	trxRepo := repository.NewTransactionRepository(dbpool)
+	transferRepo := repository.NewTransferRepository(dbpool)
+	budgetRepo := repository.NewBudgetRepository(dbpool)
+	envelopeRepo := repository.NewEnvelopeRepository(dbpool)
+	notificationRepo := repository.NewNotificationRepository(dbpool)
+	recurringRepo := repository.NewRecurringRepository(dbpool)
+	billRepo := repository.NewBillRepository(dbpool)
+
+	categoryService := service.NewCategoryService(dbpool, categoryRepo, trxRepo, recurringRepo, billRepo, envelopeRepo, budgetRepo, categoryTemplate)
 	categoryHandler := handler.NewCategoryHandler(categoryService)
 
-	walletRepo := repository.NewWalletRepository(dbpool)
-	walletService := service.NewWalletService(walletRepo)
+	walletService := service.NewWalletService(dbpool, walletRepo, trxRepo, transferRepo, recurringRepo, billRepo)
 	walletHandler := handler.NewWalletHandler(walletService)
 
-	trxRepo := repository.NewTransactionRepository(dbpool)
-	trxService := service.NewTransactionService(dbpool, trxRepo, walletRepo, categoryRepo)
+	trxService := service.NewTransactionService(dbpool, trxRepo, walletRepo, categoryRepo, envelopeRepo, budgetRepo, notificationRepo, preferencesRepo)
 	trxHandler := handler.NewTransactionHandler(trxService)
 
-	dashboardService := service.NewDashboardService(walletRepo, trxRepo)
+	transferService := service.NewTransferService(dbpool, transferRepo, trxRepo, walletRepo, categoryRepo, envelopeRepo, budgetRepo, notificationRepo, preferencesRepo)
+	transferHandler := handler.NewTransferHandler(transferService)
+
+	budgetService := service.NewBudgetService(budgetRepo, categoryRepo, trxRepo, preferencesRepo)
+	budgetHandler := handler.NewBudgetHandler(budgetService)
+
+	envelopeService := service.NewEnvelopeService(dbpool, envelopeRepo, walletRepo, categoryRepo)
+	envelopeHandler := handler.NewEnvelopeHandler(envelopeService)
+
+	recurringService := service.NewRecurringService(dbpool, recurringRepo, trxRepo, walletRepo, categoryRepo, envelopeRepo, budgetRepo, notificationRepo, preferencesRepo)
+	recurringHandler := handler.NewRecurringHandler(recurringService)
+
+	billService := service.NewBillService(dbpool, billRepo, trxRepo, walletRepo, categoryRepo, envelopeRepo, budgetRepo, notificationRepo, preferencesRepo)
+	billHandler := handler.NewBillHandler(billService)
+
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	go worker.NewPeriodicWorker("transaksi berulang", recurringService.ProcessDue, cfg.WorkerInterval).Start(workerCtx)
+	go worker.NewPeriodicWorker("pengingat tagihan", billService.SendDueReminders, cfg.WorkerInterval).Start(workerCtx)
+	go worker.NewPeriodicWorker("catatan login gagal kedaluwarsa", loginGuard.Prune, cfg.WorkerInterval).Start(workerCtx)
+	go worker.NewPeriodicWorker("state login OIDC kedaluwarsa", oidcService.PruneLoginStates, cfg.WorkerInterval).Start(workerCtx)
+
+	notificationService := service.NewNotificationService(notificationRepo)
+	notificationHandler := handler.NewNotificationHandler(notificationService)
+
+	dashboardService := service.NewDashboardService(walletRepo, trxRepo, preferencesRepo)
 	dashboardHandler := handler.NewDashboardHandler(dashboardService)
 
 	router := gin.Default()
 
+	// Tanpa ini gin mempercayai X-Forwarded-For dari siapa pun, sehingga IP pada sesi & batas
+	// percobaan login per IP bisa dipalsukan
+	if err := router.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		log.Fatalf("Konfigurasi TRUSTED_PROXIES tidak valid: %v", err)
+	}
+
 	router.GET("/ping", func(c *gin.Context) {
 		c.JSON(http.StatusOK, gin.H{
 			"message": "pong!",
 		})
 	})
 
+	router.GET("/.well-known/jwks.json", jwksHandler.GetJWKS)
+
 	authRoutes := router.Group("/auth")
 	{
 		authRoutes.POST("/register", authHandler.Register)
 		authRoutes.POST("/login", authHandler.Login)
+		authRoutes.POST("/mfa/verify", authHandler.VerifyMFA)
+		authRoutes.POST("/oidc/:provider/authorize", oidcHandler.StartLogin)
+		authRoutes.POST("/oidc/:provider/callback", oidcHandler.Callback)
 		authRoutes.POST("/refresh", authHandler.Refresh)
+		authRoutes.POST("/logout", authHandler.Logout)
+		authRoutes.POST("/logout-all", authMiddleware, interactiveOnly, authHandler.LogoutAll)
+		authRoutes.POST("/forgot-password", passwordHandler.ForgotPassword)
+		authRoutes.POST("/reset-password", passwordHandler.ResetPassword)
+		authRoutes.POST("/verify-email", verificationHandler.VerifyEmail)
+		authRoutes.POST("/resend-verification", authMiddleware, interactiveOnly, verificationHandler.ResendVerification)
+	}
+
+	// Profil tetap bisa diubah selama email baru belum diverifikasi, agar salah ketik email
+	// bisa diperbaiki walaupun policy user belum terverifikasi membatasi request lain
+	profileRoutes := router.Group("/api/v1/me", authMiddleware, interactiveOnly)
+	{
+		profileRoutes.PATCH("", userHandler.UpdateMe)
 	}
 
 	api := router.Group("/api/v1")
-	api.Use(authMiddleware)
+	api.Use(authMiddleware, middleware.RequireVerifiedEmail(unverifiedPolicy))
 	{
-		api.GET("/me", userHandler.GetMe)
+		api.GET("/me", middleware.RequireScope("profile"), userHandler.GetMe)
+		api.GET("/me/preferences", middleware.RequireScope("profile"), userHandler.GetPreferences)
+
+		// Pengelolaan akun hanya dari login interaktif, tidak dengan personal access token
+		accountRoutes := api.Group("/me", interactiveOnly)
+		{
+			accountRoutes.PATCH("/preferences", userHandler.UpdatePreferences)
+			accountRoutes.PUT("/password", passwordHandler.ChangePassword)
+			accountRoutes.POST("/mfa/totp", mfaHandler.EnrollTOTP)
+			accountRoutes.POST("/mfa/totp/confirm", mfaHandler.ConfirmTOTP)
+			accountRoutes.POST("/mfa/totp/disable", mfaHandler.DisableTOTP)
+			accountRoutes.GET("/sessions", sessionHandler.GetSessions)
+			accountRoutes.DELETE("/sessions/:id", sessionHandler.RevokeSession)
+			accountRoutes.POST("/tokens", personalAccessTokenHandler.CreateToken)
+			accountRoutes.GET("/tokens", personalAccessTokenHandler.GetTokens)
+			accountRoutes.DELETE("/tokens/:id", personalAccessTokenHandler.RevokeToken)
+		}
 
-		catRoutes := api.Group("/categories")
+		catRoutes := api.Group("/categories", middleware.RequireScope("categories"))
 		{
 			catRoutes.POST("/", categoryHandler.CreateCategory)
 			catRoutes.GET("/", categoryHandler.GetUserCategories)
 			catRoutes.PUT("/:id", categoryHandler.UpdateCategory)
 			catRoutes.DELETE("/:id", categoryHandler.DeleteCategory)
+			catRoutes.POST("/:id/merge", categoryHandler.MergeCategory)
+			catRoutes.POST("/defaults", categoryHandler.ApplyDefaultCategories)
 		}
 
-		walletRoutes := api.Group("/wallets")
+		walletRoutes := api.Group("/wallets", middleware.RequireScope("wallets"))
 		{
 			walletRoutes.POST("/", walletHandler.CreateWallet)
 			walletRoutes.GET("/", walletHandler.GetUserWallets)
@@ -90,14 +259,68 @@ func main() {
 			walletRoutes.DELETE("/:id", walletHandler.DeleteWallet)
 		}
 
-		trxRoutes := api.Group("/transactions")
+		trxRoutes := api.Group("/transactions", middleware.RequireScope("transactions"))
 		{
 			trxRoutes.POST("/", trxHandler.CreateTransaction)
 			trxRoutes.GET("/", trxHandler.GetUserTransactions)
-			// TODO: Tambahkan PUT /:id dan DELETE /:id
+			trxRoutes.PUT("/:id", trxHandler.UpdateTransaction)
+			trxRoutes.DELETE("/:id", trxHandler.DeleteTransaction)
+		}
+
+		transferRoutes := api.Group("/transfers", middleware.RequireScope("transfers"))
+		{
+			transferRoutes.POST("/", transferHandler.CreateTransfer)
+			transferRoutes.GET("/", transferHandler.GetUserTransfers)
+			transferRoutes.PUT("/:id", transferHandler.UpdateTransfer)
+			transferRoutes.DELETE("/:id", transferHandler.DeleteTransfer)
+		}
+
+		budgetRoutes := api.Group("/budgets", middleware.RequireScope("budgets"))
+		{
+			budgetRoutes.POST("/", budgetHandler.CreateBudget)
+			budgetRoutes.GET("/", budgetHandler.GetUserBudgets)
+			budgetRoutes.GET("/status", budgetHandler.GetBudgetStatus)
+			budgetRoutes.PUT("/:id", budgetHandler.UpdateBudget)
+			budgetRoutes.DELETE("/:id", budgetHandler.DeleteBudget)
+		}
+
+		envelopeRoutes := api.Group("/envelopes", middleware.RequireScope("envelopes"))
+		{
+			envelopeRoutes.GET("/", envelopeHandler.GetEnvelopes)
+			envelopeRoutes.POST("/assign", envelopeHandler.AssignEnvelope)
+			envelopeRoutes.DELETE("/:id", envelopeHandler.DeleteEnvelope)
+		}
+
+		recurringRoutes := api.Group("/recurring-transactions", middleware.RequireScope("recurring"))
+		{
+			recurringRoutes.POST("/", recurringHandler.CreateRecurring)
+			recurringRoutes.GET("/", recurringHandler.GetUserRecurring)
+			recurringRoutes.PUT("/:id", recurringHandler.UpdateRecurring)
+			recurringRoutes.DELETE("/:id", recurringHandler.DeleteRecurring)
 		}
 
-		api.GET("/dashboard", dashboardHandler.GetDashboardSummary)
+		billRoutes := api.Group("/bills", middleware.RequireScope("bills"))
+		{
+			billRoutes.POST("/", billHandler.CreateBill)
+			billRoutes.GET("/", billHandler.GetUserBills)
+			billRoutes.GET("/upcoming", billHandler.GetUpcomingBills)
+			billRoutes.PUT("/:id", billHandler.UpdateBill)
+			billRoutes.POST("/:id/pay", billHandler.PayBill)
+			billRoutes.DELETE("/:id", billHandler.DeleteBill)
+		}
+
+		notificationRoutes := api.Group("/notifications", middleware.RequireScope("notifications"))
+		{
+			notificationRoutes.GET("/", notificationHandler.GetNotifications)
+			notificationRoutes.PUT("/read-all", notificationHandler.MarkAllAsRead)
+			notificationRoutes.PUT("/:id/read", notificationHandler.MarkAsRead)
+		}
+
+		dashboardRoutes := api.Group("/dashboard", middleware.RequireScope("dashboard"))
+		{
+			dashboardRoutes.GET("", dashboardHandler.GetDashboardSummary)
+			dashboardRoutes.GET("/categories", dashboardHandler.GetCategoryBreakdown)
+		}
 	}
 
 	serverAddr := ":" + cfg.AppPort
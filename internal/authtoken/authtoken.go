@@ -0,0 +1,104 @@
+package authtoken
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/Udean777/uang-bijak-go/internal/jwtkeys"
+)
+
+// Jenis token yang diterbitkan AuthService.
+const (
+	TypeAccess = "access"
+	TypeMFA    = "mfa"
+)
+
+var (
+	ErrInvalidToken   = errors.New("invalid token")
+	ErrWrongTokenType = errors.New("invalid token type")
+)
+
+// Claims adalah isi JWT yang diterbitkan aplikasi ini. Subject berisi ID user; SessionID
+// (claim "sid") wajib ada di access token dan kosong di MFA token.
+type Claims struct {
+	jwt.RegisteredClaims
+	SessionID     string `json:"sid,omitempty"`
+	EmailVerified bool   `json:"email_verified"`
+	TokenType     string `json:"token_type"`
+}
+
+// UserID aman dipanggil pada Claims hasil Verify, yang sudah memastikan Subject berupa UUID.
+func (c *Claims) UserID() uuid.UUID {
+	id, _ := uuid.Parse(c.Subject)
+	return id
+}
+
+// Session mengembalikan ID sesi, atau uuid.Nil untuk token tanpa sesi (MFA token).
+func (c *Claims) Session() uuid.UUID {
+	id, _ := uuid.Parse(c.SessionID)
+	return id
+}
+
+// Verifier memvalidasi token dan mengembalikan claim-nya. Dipakai bersama oleh AuthMiddleware
+// dan AuthService agar aturan validasi hanya ada di satu tempat.
+type Verifier interface {
+	Verify(tokenString string, expectedType string) (*Claims, error)
+}
+
+// Manager menerbitkan dan memverifikasi token dengan kunci dari KeySet. Setiap token membawa
+// iss dan aud yang dikonfigurasi; token dengan issuer atau audience lain ditolak.
+type Manager struct {
+	keys     *jwtkeys.KeySet
+	issuer   string
+	audience string
+	now      func() time.Time
+}
+
+func NewManager(keys *jwtkeys.KeySet, issuer, audience string) *Manager {
+	return &Manager{keys: keys, issuer: issuer, audience: audience, now: time.Now}
+}
+
+// Issue melengkapi claims dengan iss, aud, iat, exp dan jti baru, lalu menandatanganinya.
+func (m *Manager) Issue(claims Claims, ttl time.Duration) (string, error) {
+	now := m.now()
+	claims.Issuer = m.issuer
+	claims.Audience = jwt.ClaimStrings{m.audience}
+	claims.IssuedAt = jwt.NewNumericDate(now)
+	claims.ExpiresAt = jwt.NewNumericDate(now.Add(ttl))
+	claims.ID = uuid.NewString()
+
+	return m.keys.Sign(&claims)
+}
+
+// Verify memeriksa tanda tangan, masa berlaku, issuer, audience dan jenis token. Access token
+// juga wajib membawa ID sesi yang valid.
+func (m *Manager) Verify(tokenString string, expectedType string) (*Claims, error) {
+	var claims Claims
+	_, err := jwt.ParseWithClaims(tokenString, &claims, m.keys.Keyfunc,
+		jwt.WithValidMethods(m.keys.ValidMethods()),
+		jwt.WithIssuer(m.issuer),
+		jwt.WithAudience(m.audience),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(m.now),
+	)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidToken, err)
+	}
+
+	if claims.TokenType != expectedType {
+		return nil, ErrWrongTokenType
+	}
+	if _, err := uuid.Parse(claims.Subject); err != nil {
+		return nil, errors.Join(ErrInvalidToken, errors.New("invalid subject"))
+	}
+	if expectedType == TypeAccess {
+		if _, err := uuid.Parse(claims.SessionID); err != nil {
+			return nil, errors.Join(ErrInvalidToken, errors.New("invalid session ID"))
+		}
+	}
+
+	return &claims, nil
+}
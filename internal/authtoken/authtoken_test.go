@@ -0,0 +1,60 @@
+package authtoken_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Udean777/uang-bijak-go/internal/authtoken"
+	"github.com/Udean777/uang-bijak-go/internal/authtoken/authtokentest"
+)
+
+func TestManager_Verify(t *testing.T) {
+	manager := authtokentest.NewManager()
+	authtokentest.Run(t, func(t *testing.T, token string, expectedType string) (*authtoken.Claims, error) {
+		return manager.Verify(token, expectedType)
+	})
+}
+
+func TestManager_IssueSetsRegisteredClaims(t *testing.T) {
+	manager := authtokentest.NewManager()
+	userID := uuid.New()
+
+	claims := authtoken.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: userID.String()},
+		TokenType:        authtoken.TypeMFA,
+	}
+	first, err := manager.Issue(claims, time.Minute)
+	assert.NoError(t, err)
+	second, err := manager.Issue(claims, time.Minute)
+	assert.NoError(t, err)
+
+	a, err := manager.Verify(first, authtoken.TypeMFA)
+	assert.NoError(t, err)
+	b, err := manager.Verify(second, authtoken.TypeMFA)
+	assert.NoError(t, err)
+
+	assert.Equal(t, authtokentest.Issuer, a.Issuer)
+	assert.Equal(t, jwt.ClaimStrings{authtokentest.Audience}, a.Audience)
+	assert.NotEmpty(t, a.ID)
+	// Setiap token punya jti unik
+	assert.NotEqual(t, a.ID, b.ID)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), a.ExpiresAt.Time, 5*time.Second)
+}
+
+func TestManager_WrongTypeError(t *testing.T) {
+	manager := authtokentest.NewManager()
+	token, _ := manager.Issue(authtoken.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: uuid.NewString()},
+		TokenType:        authtoken.TypeMFA,
+	}, time.Minute)
+
+	_, err := manager.Verify(token, authtoken.TypeAccess)
+	assert.ErrorIs(t, err, authtoken.ErrWrongTokenType)
+
+	_, err = manager.Verify("garbage", authtoken.TypeAccess)
+	assert.ErrorIs(t, err, authtoken.ErrInvalidToken)
+}
@@ -0,0 +1,119 @@
+// Package authtokentest berisi satu suite uji token yang dijalankan terhadap setiap jalur
+// validasi (authtoken.Manager, AuthService, AuthMiddleware) agar perilakunya tidak berbeda.
+package authtokentest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Udean777/uang-bijak-go/internal/authtoken"
+	"github.com/Udean777/uang-bijak-go/internal/jwtkeys"
+)
+
+const (
+	Issuer   = "uang-bijak-test"
+	Audience = "uang-bijak-api-test"
+	secret   = "authtokentest-secret"
+)
+
+// Keys adalah key set yang harus dipakai komponen yang diuji.
+func Keys() *jwtkeys.KeySet {
+	return jwtkeys.NewHMACKeySet(secret)
+}
+
+// NewManager membuat Manager dengan Keys, Issuer dan Audience milik suite.
+func NewManager() *authtoken.Manager {
+	return authtoken.NewManager(Keys(), Issuer, Audience)
+}
+
+// Case adalah satu token beserta jenis yang diharapkan saat verifikasi. Jika Valid, claim
+// hasil verifikasi harus memuat UserID dan SessionID ini.
+type Case struct {
+	Name         string
+	Token        string
+	ExpectedType string
+	Valid        bool
+	UserID       uuid.UUID
+	SessionID    uuid.UUID
+}
+
+// VerifyFunc menjalankan jalur validasi yang diuji.
+type VerifyFunc func(t *testing.T, token string, expectedType string) (*authtoken.Claims, error)
+
+// Cases menyusun token-token uji. Token valid diterbitkan oleh NewManager.
+func Cases(t *testing.T) []Case {
+	manager := NewManager()
+	userID := uuid.New()
+	sessionID := uuid.New()
+
+	issue := func(m *authtoken.Manager, claims authtoken.Claims, ttl time.Duration) string {
+		token, err := m.Issue(claims, ttl)
+		assert.NoError(t, err)
+		return token
+	}
+	access := authtoken.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: userID.String()},
+		SessionID:        sessionID.String(),
+		EmailVerified:    true,
+		TokenType:        authtoken.TypeAccess,
+	}
+	mfa := authtoken.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: userID.String()},
+		TokenType:        authtoken.TypeMFA,
+	}
+
+	noSession := access
+	noSession.SessionID = ""
+	badSubject := access
+	badSubject.Subject = "not-a-uuid"
+
+	// Token format lama (sebelum iss/aud) dengan kunci yang sama
+	legacy, err := Keys().Sign(jwt.MapClaims{
+		"sub":        userID.String(),
+		"sid":        sessionID.String(),
+		"exp":        time.Now().Add(time.Minute).Unix(),
+		"token_type": authtoken.TypeAccess,
+	})
+	assert.NoError(t, err)
+
+	unsigned, err := jwt.NewWithClaims(jwt.SigningMethodNone, &access).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	assert.NoError(t, err)
+
+	return []Case{
+		{Name: "Valid Access Token", Token: issue(manager, access, time.Minute), ExpectedType: authtoken.TypeAccess, Valid: true, UserID: userID, SessionID: sessionID},
+		{Name: "Valid MFA Token", Token: issue(manager, mfa, time.Minute), ExpectedType: authtoken.TypeMFA, Valid: true, UserID: userID},
+		{Name: "MFA Token Used As Access Token", Token: issue(manager, mfa, time.Minute), ExpectedType: authtoken.TypeAccess},
+		{Name: "Access Token Used As MFA Token", Token: issue(manager, access, time.Minute), ExpectedType: authtoken.TypeMFA},
+		{Name: "Expired", Token: issue(manager, access, -time.Minute), ExpectedType: authtoken.TypeAccess},
+		{Name: "Wrong Issuer", Token: issue(authtoken.NewManager(Keys(), "someone-else", Audience), access, time.Minute), ExpectedType: authtoken.TypeAccess},
+		{Name: "Wrong Audience", Token: issue(authtoken.NewManager(Keys(), Issuer, "another-service"), access, time.Minute), ExpectedType: authtoken.TypeAccess},
+		{Name: "Signed With Another Key", Token: issue(authtoken.NewManager(jwtkeys.NewHMACKeySet("other-secret"), Issuer, Audience), access, time.Minute), ExpectedType: authtoken.TypeAccess},
+		{Name: "Access Token Without Session", Token: issue(manager, noSession, time.Minute), ExpectedType: authtoken.TypeAccess},
+		{Name: "Subject Is Not A UUID", Token: issue(manager, badSubject, time.Minute), ExpectedType: authtoken.TypeAccess},
+		{Name: "Legacy Token Without Issuer And Audience", Token: legacy, ExpectedType: authtoken.TypeAccess},
+		{Name: "Unsigned (alg none)", Token: unsigned, ExpectedType: authtoken.TypeAccess},
+		{Name: "Malformed", Token: "not-a-jwt", ExpectedType: authtoken.TypeAccess},
+	}
+}
+
+// Run menjalankan semua Case terhadap verify.
+func Run(t *testing.T, verify VerifyFunc) {
+	for _, tc := range Cases(t) {
+		t.Run(tc.Name, func(t *testing.T) {
+			claims, err := verify(t, tc.Token, tc.ExpectedType)
+
+			if !tc.Valid {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.UserID, claims.UserID())
+				assert.Equal(t, tc.SessionID, claims.Session())
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -15,6 +16,90 @@ type Config struct {
 	JwtSecret       string
 	AccessTokenTTL  time.Duration
 	RefreshTokenTTL time.Duration
+
+	// JWTKeysDir berisi kunci PEM (nama file = kid) untuk RS256/EdDSA dan JWTActiveKeyID
+	// adalah kid yang dipakai menandatangani. Kosong berarti HS256 dengan JwtSecret.
+	JWTKeysDir     string
+	JWTActiveKeyID string
+
+	// JWTAllowLegacyHS256 (JWT_ALLOW_LEGACY_HS256=true) membuat token HS256 lama dari JwtSecret
+	// tetap diterima selama transisi ke JWTKeysDir. JWTLegacyHS256Until (JWT_LEGACY_HS256_UNTIL,
+	// RFC 3339 atau YYYY-MM-DD) adalah batas akhirnya; zero berarti tanpa batas.
+	JWTAllowLegacyHS256 bool
+	JWTLegacyHS256Until time.Time
+
+	// JWTIssuer dan JWTAudience diisi ke claim iss/aud dan diwajibkan saat verifikasi
+	JWTIssuer   string
+	JWTAudience string
+
+	// CategoryTemplateFile adalah path file JSON template kategori bawaan (opsional)
+	CategoryTemplateFile string
+
+	// WorkerInterval adalah jeda antar putaran worker latar (transaksi berulang, pengingat tagihan)
+	WorkerInterval time.Duration
+
+	// SessionCacheTTL adalah lama status sesi di-cache oleh AuthMiddleware
+	SessionCacheTTL time.Duration
+
+	// TrustedProxies adalah IP/CIDR reverse proxy (TRUSTED_PROXIES, dipisah koma) yang header
+	// X-Forwarded-For-nya dipercaya untuk menentukan IP client. Kosong berarti tidak ada proxy
+	// yang dipercaya dan IP client diambil dari koneksi langsung.
+	TrustedProxies []string
+
+	// AppBaseURL dipakai untuk menyusun link di email (reset password, dll)
+	AppBaseURL       string
+	PasswordResetTTL time.Duration
+	EmailVerifyTTL   time.Duration
+	Mail             MailConfig
+
+	// UnverifiedUserPolicy: allow, read_only (default) atau block untuk user yang belum verifikasi email
+	UnverifiedUserPolicy string
+
+	// MFAIssuer adalah nama aplikasi yang tampil di authenticator (TOTP)
+	MFAIssuer string
+
+	LoginGuard LoginGuardConfig
+
+	// OIDCProviders adalah identity provider untuk login OIDC (OIDC_PROVIDERS); OIDCStateTTL
+	// adalah batas waktu menyelesaikan login di provider
+	OIDCProviders []OIDCProviderConfig
+	OIDCStateTTL  time.Duration
+}
+
+// OIDCProviderConfig adalah pendaftaran aplikasi di satu identity provider. Untuk provider
+// bernama "google", nilainya dibaca dari OIDC_GOOGLE_ISSUER_URL, OIDC_GOOGLE_CLIENT_ID,
+// OIDC_GOOGLE_CLIENT_SECRET, OIDC_GOOGLE_REDIRECT_URL dan OIDC_GOOGLE_SCOPES.
+type OIDCProviderConfig struct {
+	Name         string
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+// LoginGuardConfig mengatur perlindungan brute-force login. Store "postgres" membagi hitungan
+// antar instance; selain itu hitungan disimpan di memori proses.
+type LoginGuardConfig struct {
+	Store           string
+	AccountAttempts int
+	IPAttempts      int
+	BaseDelay       time.Duration
+	MaxDelay        time.Duration
+	Window          time.Duration
+}
+
+// MailConfig: Driver "smtp" mengirim lewat server SMTP, selain itu email hanya dicatat ke log
+// (dan disimpan ke OutputDir jika diisi).
+type MailConfig struct {
+	Driver    string
+	From      string
+	OutputDir string
+
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
 }
 
 func LoadConfig() *Config {
@@ -32,11 +117,41 @@ func LoadConfig() *Config {
 		appPort = "8080" // Nilai default jika APP_PORT tidak disetel
 	}
 
+	jwtKeysDir := os.Getenv("JWT_KEYS_DIR")
+	jwtActiveKeyID := os.Getenv("JWT_ACTIVE_KEY_ID")
+	if jwtKeysDir != "" && jwtActiveKeyID == "" {
+		log.Fatal("JWT_ACTIVE_KEY_ID wajib di-set jika JWT_KEYS_DIR dipakai!")
+	}
+
+	jwtIssuer := os.Getenv("JWT_ISSUER")
+	if jwtIssuer == "" {
+		jwtIssuer = "uang-bijak"
+	}
+
+	jwtAudience := os.Getenv("JWT_AUDIENCE")
+	if jwtAudience == "" {
+		jwtAudience = "uang-bijak-api"
+	}
+
 	jwtSecret := os.Getenv("JWT_SECRET_KEY")
-	if jwtSecret == "" {
+	if jwtSecret == "" && jwtKeysDir == "" {
 		log.Fatal("JWT_SECRET_KEY environment variable tidak di-set!")
 	}
 
+	jwtAllowLegacyHS256 := os.Getenv("JWT_ALLOW_LEGACY_HS256") == "true"
+	if jwtAllowLegacyHS256 && jwtSecret == "" {
+		log.Fatal("JWT_SECRET_KEY wajib di-set jika JWT_ALLOW_LEGACY_HS256 dipakai!")
+	}
+
+	var jwtLegacyHS256Until time.Time
+	if raw := os.Getenv("JWT_LEGACY_HS256_UNTIL"); raw != "" {
+		until, err := parseCutOff(raw)
+		if err != nil {
+			log.Fatalf("JWT_LEGACY_HS256_UNTIL tidak valid: %v", err)
+		}
+		jwtLegacyHS256Until = until
+	}
+
 	accessTTL, _ := strconv.Atoi(os.Getenv("JWT_ACCESS_TOKEN_TTL_MINUTES"))
 	if accessTTL == 0 {
 		accessTTL = 15 // Default 15 menit
@@ -47,11 +162,168 @@ func LoadConfig() *Config {
 		refreshTTL = 7 // Default 7 hari
 	}
 
+	workerInterval, _ := strconv.Atoi(os.Getenv("WORKER_INTERVAL_MINUTES"))
+	if workerInterval == 0 {
+		workerInterval = 5 // Default 5 menit
+	}
+
+	sessionCacheTTL, _ := strconv.Atoi(os.Getenv("SESSION_CACHE_TTL_SECONDS"))
+	if sessionCacheTTL == 0 {
+		sessionCacheTTL = 30 // Default 30 detik
+	}
+
+	appBaseURL := os.Getenv("APP_BASE_URL")
+	if appBaseURL == "" {
+		appBaseURL = "http://localhost:" + appPort
+	}
+
+	passwordResetTTL, _ := strconv.Atoi(os.Getenv("PASSWORD_RESET_TTL_MINUTES"))
+	if passwordResetTTL == 0 {
+		passwordResetTTL = 60 // Default 1 jam
+	}
+
+	emailVerifyTTL, _ := strconv.Atoi(os.Getenv("EMAIL_VERIFICATION_TTL_HOURS"))
+	if emailVerifyTTL == 0 {
+		emailVerifyTTL = 24 // Default 24 jam
+	}
+
+	mailFrom := os.Getenv("MAIL_FROM")
+	if mailFrom == "" {
+		mailFrom = "no-reply@uangbijak.local"
+	}
+
+	mfaIssuer := os.Getenv("MFA_ISSUER")
+	if mfaIssuer == "" {
+		mfaIssuer = "Uang Bijak"
+	}
+
+	loginMaxAttempts, _ := strconv.Atoi(os.Getenv("LOGIN_MAX_ATTEMPTS"))
+	if loginMaxAttempts == 0 {
+		loginMaxAttempts = 5 // Default 5 kali gagal per akun
+	}
+
+	loginIPMaxAttempts, _ := strconv.Atoi(os.Getenv("LOGIN_IP_MAX_ATTEMPTS"))
+	if loginIPMaxAttempts == 0 {
+		loginIPMaxAttempts = 20 // Default 20 kali gagal per IP
+	}
+
+	loginBackoffBase, _ := strconv.Atoi(os.Getenv("LOGIN_BACKOFF_BASE_SECONDS"))
+	if loginBackoffBase == 0 {
+		loginBackoffBase = 30 // Default 30 detik
+	}
+
+	loginLockoutMax, _ := strconv.Atoi(os.Getenv("LOGIN_LOCKOUT_MAX_MINUTES"))
+	if loginLockoutMax == 0 {
+		loginLockoutMax = 15 // Default 15 menit
+	}
+
+	loginAttemptWindow, _ := strconv.Atoi(os.Getenv("LOGIN_ATTEMPT_WINDOW_MINUTES"))
+	if loginAttemptWindow == 0 {
+		loginAttemptWindow = 60 // Default 1 jam
+	}
+
+	oidcStateTTL, _ := strconv.Atoi(os.Getenv("OIDC_STATE_TTL_MINUTES"))
+	if oidcStateTTL == 0 {
+		oidcStateTTL = 10 // Default 10 menit
+	}
+
+	smtpPort, _ := strconv.Atoi(os.Getenv("SMTP_PORT"))
+	if smtpPort == 0 {
+		smtpPort = 587
+	}
+
 	return &Config{
-		DatabaseURL:     dbURL,
-		AppPort:         appPort,
-		JwtSecret:       jwtSecret,
-		AccessTokenTTL:  time.Minute * time.Duration(accessTTL),
-		RefreshTokenTTL: time.Hour * 24 * time.Duration(refreshTTL),
+		DatabaseURL:          dbURL,
+		AppPort:              appPort,
+		TrustedProxies:       strings.Fields(strings.ReplaceAll(os.Getenv("TRUSTED_PROXIES"), ",", " ")),
+		JwtSecret:            jwtSecret,
+		JWTKeysDir:           jwtKeysDir,
+		JWTActiveKeyID:       jwtActiveKeyID,
+		JWTAllowLegacyHS256:  jwtAllowLegacyHS256,
+		JWTLegacyHS256Until:  jwtLegacyHS256Until,
+		JWTIssuer:            jwtIssuer,
+		JWTAudience:          jwtAudience,
+		AccessTokenTTL:       time.Minute * time.Duration(accessTTL),
+		RefreshTokenTTL:      time.Hour * 24 * time.Duration(refreshTTL),
+		CategoryTemplateFile: os.Getenv("DEFAULT_CATEGORIES_FILE"),
+
+		WorkerInterval:  time.Minute * time.Duration(workerInterval),
+		SessionCacheTTL: time.Second * time.Duration(sessionCacheTTL),
+
+		AppBaseURL:       strings.TrimSuffix(appBaseURL, "/"),
+		PasswordResetTTL: time.Minute * time.Duration(passwordResetTTL),
+		EmailVerifyTTL:   time.Hour * time.Duration(emailVerifyTTL),
+		Mail: MailConfig{
+			Driver:       os.Getenv("MAIL_DRIVER"),
+			From:         mailFrom,
+			OutputDir:    os.Getenv("MAIL_OUTPUT_DIR"),
+			SMTPHost:     os.Getenv("SMTP_HOST"),
+			SMTPPort:     smtpPort,
+			SMTPUsername: os.Getenv("SMTP_USERNAME"),
+			SMTPPassword: os.Getenv("SMTP_PASSWORD"),
+		},
+
+		UnverifiedUserPolicy: os.Getenv("UNVERIFIED_USER_POLICY"),
+		MFAIssuer:            mfaIssuer,
+
+		LoginGuard: LoginGuardConfig{
+			Store:           os.Getenv("LOGIN_GUARD_STORE"),
+			AccountAttempts: loginMaxAttempts,
+			IPAttempts:      loginIPMaxAttempts,
+			BaseDelay:       time.Second * time.Duration(loginBackoffBase),
+			MaxDelay:        time.Minute * time.Duration(loginLockoutMax),
+			Window:          time.Minute * time.Duration(loginAttemptWindow),
+		},
+
+		OIDCProviders: loadOIDCProviders(strings.TrimSuffix(appBaseURL, "/")),
+		OIDCStateTTL:  time.Minute * time.Duration(oidcStateTTL),
+	}
+}
+
+// loadOIDCProviders membaca provider yang didaftarkan di OIDC_PROVIDERS (dipisah koma).
+// Redirect URL default-nya halaman callback di aplikasi client, yang meneruskan code dan
+// state ke POST /auth/oidc/:provider/callback.
+func loadOIDCProviders(appBaseURL string) []OIDCProviderConfig {
+	var providers []OIDCProviderConfig
+	for _, name := range strings.Split(os.Getenv("OIDC_PROVIDERS"), ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		prefix := "OIDC_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_"
+		issuerURL := os.Getenv(prefix + "ISSUER_URL")
+		clientID := os.Getenv(prefix + "CLIENT_ID")
+		if issuerURL == "" || clientID == "" {
+			log.Fatalf("%sISSUER_URL dan %sCLIENT_ID wajib di-set untuk provider OIDC %q!", prefix, prefix, name)
+		}
+
+		redirectURL := os.Getenv(prefix + "REDIRECT_URL")
+		if redirectURL == "" {
+			redirectURL = appBaseURL + "/auth/oidc/" + name + "/callback"
+		}
+
+		scopes := strings.Fields(strings.ReplaceAll(os.Getenv(prefix+"SCOPES"), ",", " "))
+		if len(scopes) == 0 {
+			scopes = []string{"openid", "email", "profile"} // Default scope OIDC dasar
+		}
+
+		providers = append(providers, OIDCProviderConfig{
+			Name:         name,
+			IssuerURL:    issuerURL,
+			ClientID:     clientID,
+			ClientSecret: os.Getenv(prefix + "CLIENT_SECRET"),
+			RedirectURL:  redirectURL,
+			Scopes:       scopes,
+		})
+	}
+	return providers
+}
+
+// parseCutOff menerima waktu RFC 3339 atau tanggal YYYY-MM-DD (awal hari, UTC).
+func parseCutOff(raw string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, raw); err == nil {
+		return t, nil
 	}
+	return time.Parse(time.DateOnly, raw)
 }
@@ -1,9 +1,13 @@
 package handler
 
 import (
+	"errors"
+	"math"
 	"net/http"
+	"strconv"
 	"time"
 
+	"github.com/Udean777/uang-bijak-go/internal/models"
 	"github.com/Udean777/uang-bijak-go/internal/service"
 	"github.com/gin-gonic/gin"
 )
@@ -12,11 +16,21 @@ type RegisterRequest struct {
 	Name     string `json:"name" binding:"required"`
 	Email    string `json:"email" binding:"required,email"`
 	Password string `json:"password" binding:"required,min=6"`
+	// Locale menentukan bahasa kategori bawaan (id/en)
+	Locale string `json:"locale" binding:"omitempty,oneof=id en"`
 }
 
 type LoginRequest struct {
 	Email    string `json:"email" binding:"required,email"`
 	Password string `json:"password" binding:"required"`
+	// Device adalah nama perangkat yang ditampilkan di daftar sesi (opsional)
+	Device string `json:"device" binding:"omitempty,max=100"`
+}
+
+type VerifyMFARequest struct {
+	MFAToken string `json:"mfa_token" binding:"required"`
+	Code     string `json:"code" binding:"required"`
+	Device   string `json:"device" binding:"omitempty,max=100"`
 }
 
 type RefreshRequest struct {
@@ -38,7 +52,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 		return
 	}
 
-	user, err := h.authService.Register(c.Request.Context(), req.Name, req.Email, req.Password)
+	user, err := h.authService.Register(c.Request.Context(), req.Name, req.Email, req.Password, req.Locale)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to register user"})
 		return
@@ -58,19 +72,62 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
-	accessToken, refreshToken, err := h.authService.Login(c.Request.Context(), req.Email, req.Password)
+	result, err := h.authService.Login(c.Request.Context(), req.Email, req.Password, sessionMetadata(c, req.Device))
 	if err != nil {
+		if respondTooManyAttempts(c, err) {
+			return
+		}
 		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
 		return
 	}
 
-	http.SetCookie(c.Writer, &http.Cookie{
-		Name:     "refresh_token",
-		Value:    refreshToken,
-		HttpOnly: true,
-		Path:     "/", // Cookie tersedia untuk seluruh domain
-		Expires:  time.Now().Add(7 * 24 * time.Hour),
+	respondLoginResult(c, result)
+}
+
+// respondLoginResult mengirim token hasil login, atau tantangan 2FA jika user masih harus
+// menyelesaikan langkah kedua.
+func respondLoginResult(c *gin.Context, result *models.LoginResult) {
+	// 2FA aktif: client harus menukar mfa_token di /auth/mfa/verify
+	if result.MFARequired {
+		c.JSON(http.StatusOK, gin.H{
+			"message":      "Two-factor authentication required",
+			"mfa_required": true,
+			"mfa_token":    result.MFAToken,
+		})
+		return
+	}
+
+	setRefreshCookie(c, result.RefreshToken)
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":       "Login successful",
+		"access_token":  result.AccessToken,
+		"refresh_token": result.RefreshToken,
 	})
+}
+
+// VerifyMFA adalah langkah kedua login untuk user dengan 2FA aktif.
+func (h *AuthHandler) VerifyMFA(c *gin.Context) {
+	var req VerifyMFARequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	accessToken, refreshToken, err := h.authService.VerifyMFA(c.Request.Context(), req.MFAToken, req.Code, sessionMetadata(c, req.Device))
+	if err != nil {
+		if respondTooManyAttempts(c, err) {
+			return
+		}
+		if errors.Is(err, service.ErrInvalidMFAToken) || errors.Is(err, service.ErrInvalidMFACode) {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to verify code"})
+		return
+	}
+
+	setRefreshCookie(c, refreshToken)
 
 	c.JSON(http.StatusOK, gin.H{
 		"message":       "Login successful",
@@ -79,6 +136,28 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	})
 }
 
+// respondTooManyAttempts mengirim 429 dengan header Retry-After (detik, dibulatkan ke atas)
+// jika err menandakan login sedang dikunci sementara.
+func respondTooManyAttempts(c *gin.Context, err error) bool {
+	var tooMany *service.TooManyAttemptsError
+	if !errors.As(err, &tooMany) {
+		return false
+	}
+
+	c.Header("Retry-After", strconv.Itoa(int(math.Ceil(tooMany.RetryAfter.Seconds()))))
+	c.JSON(http.StatusTooManyRequests, gin.H{"error": tooMany.Error()})
+	return true
+}
+
+// sessionMetadata mengumpulkan info perangkat dari request untuk dicatat di sesi.
+func sessionMetadata(c *gin.Context, device string) models.SessionMetadata {
+	return models.SessionMetadata{
+		Device:    device,
+		UserAgent: c.Request.UserAgent(),
+		IPAddress: c.ClientIP(),
+	}
+}
+
 func (h *AuthHandler) Refresh(c *gin.Context) {
 	var req RefreshRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -86,13 +165,78 @@ func (h *AuthHandler) Refresh(c *gin.Context) {
 		return
 	}
 
-	newAccessToken, err := h.authService.RefreshToken(c.Request.Context(), req.RefreshToken)
+	accessToken, refreshToken, err := h.authService.RefreshToken(c.Request.Context(), req.RefreshToken)
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		if errors.Is(err, service.ErrInvalidRefreshToken) || errors.Is(err, service.ErrRefreshTokenReused) {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to refresh token"})
 		return
 	}
 
+	setRefreshCookie(c, refreshToken)
+
 	c.JSON(http.StatusOK, gin.H{
-		"access_token": newAccessToken,
+		"access_token":  accessToken,
+		"refresh_token": refreshToken,
+	})
+}
+
+func (h *AuthHandler) Logout(c *gin.Context) {
+	var req RefreshRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	err := h.authService.Logout(c.Request.Context(), req.RefreshToken)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidRefreshToken) {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to logout"})
+		return
+	}
+
+	clearRefreshCookie(c)
+	c.JSON(http.StatusOK, gin.H{"message": "Logged out successfully"})
+}
+
+// LogoutAll mencabut semua sesi user; route ini membutuhkan access token.
+func (h *AuthHandler) LogoutAll(c *gin.Context) {
+	userID, err := getAuthenticatedUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	if err := h.authService.LogoutAll(c.Request.Context(), userID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to logout"})
+		return
+	}
+
+	clearRefreshCookie(c)
+	c.JSON(http.StatusOK, gin.H{"message": "Logged out from all devices"})
+}
+
+func setRefreshCookie(c *gin.Context, refreshToken string) {
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     "refresh_token",
+		Value:    refreshToken,
+		HttpOnly: true,
+		Path:     "/", // Cookie tersedia untuk seluruh domain
+		Expires:  time.Now().Add(7 * 24 * time.Hour),
+	})
+}
+
+func clearRefreshCookie(c *gin.Context) {
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     "refresh_token",
+		Value:    "",
+		HttpOnly: true,
+		Path:     "/",
+		MaxAge:   -1,
 	})
 }
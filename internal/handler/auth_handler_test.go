@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -13,6 +14,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/service"
 
 	mocks "github.com/Udean777/uang-bijak-go/internal/service/mocks"
 )
@@ -48,7 +50,7 @@ func TestAuthHandler_Register(t *testing.T) {
 
 		// Setup mock expectation
 		mockAuthService.EXPECT().
-			Register(mock.Anything, "Test User", "test@example.com", "password123").
+			Register(mock.Anything, "Test User", "test@example.com", "password123", "").
 			Return(mockUser, nil).
 			Once()
 
@@ -88,3 +90,248 @@ func TestAuthHandler_Register(t *testing.T) {
 		mockAuthService.AssertNotCalled(t, "Register")
 	})
 }
+
+func TestAuthHandler_Refresh(t *testing.T) {
+	mockAuthService := mocks.NewMockAuthService(t)
+	handler := NewAuthHandler(mockAuthService)
+
+	router := setupRouter()
+	router.POST("/refresh", handler.Refresh)
+
+	t.Run("Success - Returns Rotated Tokens", func(t *testing.T) {
+		// 1. Setup
+		mockAuthService.EXPECT().
+			RefreshToken(mock.Anything, "old-refresh").
+			Return("new-access", "new-refresh", nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/refresh", bytes.NewBufferString(`{"refresh_token": "old-refresh"}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Equal(t, "new-access", response["access_token"])
+		assert.Equal(t, "new-refresh", response["refresh_token"])
+		assert.Contains(t, w.Header().Get("Set-Cookie"), "refresh_token=new-refresh")
+	})
+
+	t.Run("Reused Token", func(t *testing.T) {
+		// 1. Setup
+		mockAuthService.EXPECT().
+			RefreshToken(mock.Anything, "rotated").
+			Return("", "", service.ErrRefreshTokenReused).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/refresh", bytes.NewBufferString(`{"refresh_token": "rotated"}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestAuthHandler_Logout(t *testing.T) {
+	mockAuthService := mocks.NewMockAuthService(t)
+	handler := NewAuthHandler(mockAuthService)
+	testUserID := uuid.New()
+
+	router := setupRouter()
+	router.POST("/logout", handler.Logout)
+	router.POST("/logout-all", func(c *gin.Context) {
+		setAuthContext(c, testUserID)
+		c.Next()
+	}, handler.LogoutAll)
+
+	t.Run("Success", func(t *testing.T) {
+		// 1. Setup
+		mockAuthService.EXPECT().Logout(mock.Anything, "refresh").Return(nil).Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/logout", bytes.NewBufferString(`{"refresh_token": "refresh"}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Header().Get("Set-Cookie"), "Max-Age=0")
+	})
+
+	t.Run("Invalid Token", func(t *testing.T) {
+		// 1. Setup
+		mockAuthService.EXPECT().Logout(mock.Anything, "unknown").Return(service.ErrInvalidRefreshToken).Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/logout", bytes.NewBufferString(`{"refresh_token": "unknown"}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("Logout All", func(t *testing.T) {
+		// 1. Setup
+		mockAuthService.EXPECT().LogoutAll(mock.Anything, testUserID).Return(nil).Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/logout-all", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func TestAuthHandler_Login(t *testing.T) {
+	mockAuthService := mocks.NewMockAuthService(t)
+	handler := NewAuthHandler(mockAuthService)
+
+	router := setupRouter()
+	router.POST("/login", handler.Login)
+
+	t.Run("Success - Records Session Metadata", func(t *testing.T) {
+		// 1. Setup
+		expectedMeta := models.SessionMetadata{Device: "Pixel 8", UserAgent: "okhttp/4.12", IPAddress: "10.0.0.1"}
+		mockAuthService.EXPECT().
+			Login(mock.Anything, "user@example.com", "password123", expectedMeta).
+			Return(&models.LoginResult{AccessToken: "access", RefreshToken: "refresh"}, nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		body := `{"email": "user@example.com", "password": "password123", "device": "Pixel 8"}`
+		req, _ := http.NewRequest(http.MethodPost, "/login", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("User-Agent", "okhttp/4.12")
+		req.RemoteAddr = "10.0.0.1:51234"
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Header().Get("Set-Cookie"), "refresh_token=refresh")
+	})
+}
+
+func TestAuthHandler_LoginWithMFA(t *testing.T) {
+	mockAuthService := mocks.NewMockAuthService(t)
+	handler := NewAuthHandler(mockAuthService)
+
+	router := setupRouter()
+	router.POST("/login", handler.Login)
+	router.POST("/mfa/verify", handler.VerifyMFA)
+
+	t.Run("Login Returns Challenge", func(t *testing.T) {
+		// 1. Setup
+		mockAuthService.EXPECT().
+			Login(mock.Anything, "user@example.com", "password123", mock.Anything).
+			Return(&models.LoginResult{MFARequired: true, MFAToken: "challenge"}, nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/login", bytes.NewBufferString(`{"email": "user@example.com", "password": "password123"}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Equal(t, true, response["mfa_required"])
+		assert.Equal(t, "challenge", response["mfa_token"])
+		assert.Nil(t, response["access_token"])
+		assert.Empty(t, w.Header().Get("Set-Cookie"))
+	})
+
+	t.Run("Verify Success", func(t *testing.T) {
+		// 1. Setup
+		mockAuthService.EXPECT().
+			VerifyMFA(mock.Anything, "challenge", "123456", mock.Anything).
+			Return("access", "refresh", nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/mfa/verify", bytes.NewBufferString(`{"mfa_token": "challenge", "code": "123456"}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"access_token":"access"`)
+	})
+
+	t.Run("Verify Wrong Code", func(t *testing.T) {
+		// 1. Setup
+		mockAuthService.EXPECT().
+			VerifyMFA(mock.Anything, "challenge", "000000", mock.Anything).
+			Return("", "", service.ErrInvalidMFACode).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/mfa/verify", bytes.NewBufferString(`{"mfa_token": "challenge", "code": "000000"}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestAuthHandler_LoginTooManyAttempts(t *testing.T) {
+	mockAuthService := mocks.NewMockAuthService(t)
+	handler := NewAuthHandler(mockAuthService)
+
+	router := setupRouter()
+	router.POST("/login", handler.Login)
+	router.POST("/mfa/verify", handler.VerifyMFA)
+
+	t.Run("Login Locked", func(t *testing.T) {
+		// 1. Setup
+		mockAuthService.EXPECT().
+			Login(mock.Anything, "user@example.com", "password123", mock.Anything).
+			Return(nil, &service.TooManyAttemptsError{RetryAfter: 89500 * time.Millisecond}).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/login", bytes.NewBufferString(`{"email": "user@example.com", "password": "password123"}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		assert.Equal(t, "90", w.Header().Get("Retry-After"))
+		assert.Contains(t, w.Body.String(), "too many attempts")
+	})
+
+	t.Run("MFA Verify Locked", func(t *testing.T) {
+		// 1. Setup
+		mockAuthService.EXPECT().
+			VerifyMFA(mock.Anything, "challenge", "123456", mock.Anything).
+			Return("", "", &service.TooManyAttemptsError{RetryAfter: time.Minute}).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/mfa/verify", bytes.NewBufferString(`{"mfa_token": "challenge", "code": "123456"}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		assert.Equal(t, "60", w.Header().Get("Retry-After"))
+	})
+}
@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type BillHandler struct {
+	billService service.BillService
+}
+
+func NewBillHandler(svc service.BillService) *BillHandler {
+	return &BillHandler{billService: svc}
+}
+
+func (h *BillHandler) CreateBill(c *gin.Context) {
+	userID, err := getAuthenticatedUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req models.CreateBillRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	bill, err := h.billService.CreateBill(c.Request.Context(), req, userID)
+	if err != nil {
+		respondBillError(c, err, "Failed to create bill")
+		return
+	}
+
+	c.JSON(http.StatusCreated, bill)
+}
+
+func (h *BillHandler) GetUserBills(c *gin.Context) {
+	userID, err := getAuthenticatedUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	bills, err := h.billService.GetUserBills(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve bills"})
+		return
+	}
+
+	c.JSON(http.StatusOK, bills)
+}
+
+// GetUpcomingBills mengembalikan jatuh tempo ?days= hari ke depan (default 30) beserta
+// perbandingannya dengan saldo dompet.
+func (h *BillHandler) GetUpcomingBills(c *gin.Context) {
+	userID, err := getAuthenticatedUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var query models.UpcomingBillsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters"})
+		return
+	}
+
+	report, err := h.billService.GetUpcomingBills(c.Request.Context(), userID, query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not fetch upcoming bills"})
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+func (h *BillHandler) UpdateBill(c *gin.Context) {
+	userID, err := getAuthenticatedUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	billID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid bill ID"})
+		return
+	}
+
+	var req models.UpdateBillRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	bill, err := h.billService.UpdateBill(c.Request.Context(), billID, req, userID)
+	if err != nil {
+		respondBillError(c, err, "Failed to update bill")
+		return
+	}
+
+	c.JSON(http.StatusOK, bill)
+}
+
+// PayBill menandai jatuh tempo berikutnya lunas dan mencatat transaksinya. Body opsional.
+func (h *BillHandler) PayBill(c *gin.Context) {
+	userID, err := getAuthenticatedUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	billID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid bill ID"})
+		return
+	}
+
+	var req models.PayBillRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	payment, err := h.billService.PayBill(c.Request.Context(), billID, req, userID)
+	if err != nil {
+		respondBillError(c, err, "Failed to pay bill")
+		return
+	}
+
+	c.JSON(http.StatusCreated, payment)
+}
+
+func (h *BillHandler) DeleteBill(c *gin.Context) {
+	userID, err := getAuthenticatedUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	billID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid bill ID"})
+		return
+	}
+
+	err = h.billService.DeleteBill(c.Request.Context(), billID, userID)
+	if err != nil {
+		if errors.Is(err, service.ErrForbidden) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "You are not allowed to delete this bill"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete bill"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Bill deleted successfully"})
+}
+
+func respondBillError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, service.ErrForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": "Invalid bill, wallet or category ID"})
+	case errors.Is(err, service.ErrCategoryKindMismatch):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Bills can only use expense categories"})
+	case errors.Is(err, service.ErrInvalidSchedule):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, service.ErrWalletArchived):
+		c.JSON(http.StatusConflict, gin.H{"error": "Wallet is archived"})
+	case errors.Is(err, service.ErrConflict):
+		c.JSON(http.StatusConflict, gin.H{"error": "Bill has no unpaid due date"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
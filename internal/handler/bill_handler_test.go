@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/service"
+	serviceMocks "github.com/Udean777/uang-bijak-go/internal/service/mocks"
+)
+
+func TestBillHandler_GetUpcomingBills(t *testing.T) {
+	mockService := serviceMocks.NewMockBillService(t)
+	handler := NewBillHandler(mockService)
+	testUserID := uuid.New()
+
+	router := setupRouter()
+	router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+	router.GET("/bills/upcoming", handler.GetUpcomingBills)
+
+	t.Run("Success", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().
+			GetUpcomingBills(mock.Anything, testUserID, models.UpcomingBillsQuery{Days: 14}).
+			Return(&models.UpcomingBillsReport{Bills: []models.UpcomingBill{}}, nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/bills/upcoming?days=14", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Bad Request - Range Too Long", func(t *testing.T) {
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/bills/upcoming?days=365", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestBillHandler_PayBill(t *testing.T) {
+	mockService := serviceMocks.NewMockBillService(t)
+	handler := NewBillHandler(mockService)
+	testUserID := uuid.New()
+
+	router := setupRouter()
+	router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+	router.POST("/bills/:id/pay", handler.PayBill)
+
+	t.Run("Success - Empty Body", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().
+			PayBill(mock.Anything, int64(4), models.PayBillRequest{}, testUserID).
+			Return(&models.BillPayment{Bill: &models.Bill{ID: 4}, Transaction: &models.Transaction{ID: 10}}, nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/bills/4/pay", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("Success - Custom Amount", func(t *testing.T) {
+		// 1. Setup
+		amount := int64(512000)
+		mockService.EXPECT().
+			PayBill(mock.Anything, int64(4), models.PayBillRequest{Amount: &amount}, testUserID).
+			Return(&models.BillPayment{Bill: &models.Bill{ID: 4}, Transaction: &models.Transaction{ID: 11, Amount: amount}}, nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/bills/4/pay", bytes.NewBufferString(`{"amount":512000}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("Conflict - Already Paid", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().
+			PayBill(mock.Anything, int64(5), models.PayBillRequest{}, testUserID).
+			Return(nil, fmt.Errorf("bill has no unpaid due date: %w", service.ErrConflict)).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/bills/5/pay", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type BudgetHandler struct {
+	budgetService service.BudgetService
+}
+
+func NewBudgetHandler(svc service.BudgetService) *BudgetHandler {
+	return &BudgetHandler{budgetService: svc}
+}
+
+func (h *BudgetHandler) CreateBudget(c *gin.Context) {
+	userID, err := getAuthenticatedUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req models.CreateBudgetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	budget, err := h.budgetService.CreateBudget(c.Request.Context(), req, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrForbidden):
+			c.JSON(http.StatusForbidden, gin.H{"error": "Invalid category ID"})
+		case errors.Is(err, service.ErrCategoryKindMismatch):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Budgets can only be set on expense categories"})
+		case errors.Is(err, service.ErrConflict):
+			c.JSON(http.StatusConflict, gin.H{"error": "Budget for this category and month already exists"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create budget"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, budget)
+}
+
+// GetUserBudgets mengembalikan anggaran untuk ?month=&year= (default: periode bulan keuangan berjalan).
+func (h *BudgetHandler) GetUserBudgets(c *gin.Context) {
+	userID, err := getAuthenticatedUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var query models.DashboardQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters"})
+		return
+	}
+
+	budgets, err := h.budgetService.GetUserBudgets(c.Request.Context(), userID, query.Year, query.Month)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve budgets"})
+		return
+	}
+
+	c.JSON(http.StatusOK, budgets)
+}
+
+// GetBudgetStatus mengembalikan anggaran vs. realisasi untuk ?month=&year= (default: periode bulan keuangan berjalan).
+func (h *BudgetHandler) GetBudgetStatus(c *gin.Context) {
+	userID, err := getAuthenticatedUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var query models.DashboardQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters"})
+		return
+	}
+
+	report, err := h.budgetService.GetBudgetStatus(c.Request.Context(), userID, query.Year, query.Month)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not fetch budget status"})
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+func (h *BudgetHandler) UpdateBudget(c *gin.Context) {
+	userID, err := getAuthenticatedUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	budgetID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid budget ID"})
+		return
+	}
+
+	var req models.UpdateBudgetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	err = h.budgetService.UpdateBudget(c.Request.Context(), budgetID, req, userID)
+	if err != nil {
+		if errors.Is(err, service.ErrForbidden) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "You are not allowed to update this budget"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update budget"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Budget updated successfully"})
+}
+
+func (h *BudgetHandler) DeleteBudget(c *gin.Context) {
+	userID, err := getAuthenticatedUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	budgetID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid budget ID"})
+		return
+	}
+
+	err = h.budgetService.DeleteBudget(c.Request.Context(), budgetID, userID)
+	if err != nil {
+		if errors.Is(err, service.ErrForbidden) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "You are not allowed to delete this budget"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete budget"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Budget deleted successfully"})
+}
@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/service"
+	serviceMocks "github.com/Udean777/uang-bijak-go/internal/service/mocks"
+)
+
+func TestBudgetHandler_CreateBudget(t *testing.T) {
+	mockService := serviceMocks.NewMockBudgetService(t)
+	handler := NewBudgetHandler(mockService)
+	testUserID := uuid.New()
+
+	router := setupRouter()
+	router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+	router.POST("/budgets", handler.CreateBudget)
+
+	reqBody := models.CreateBudgetRequest{CategoryID: 1, Year: 2025, Month: 10, Amount: 1500000}
+	jsonBody, _ := json.Marshal(reqBody)
+
+	t.Run("Success", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().
+			CreateBudget(mock.Anything, reqBody, testUserID).
+			Return(&models.Budget{ID: 1, CategoryID: 1, Year: 2025, Month: 10, Amount: 1500000}, nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/budgets", bytes.NewBuffer(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("Bad Request - Invalid Month", func(t *testing.T) {
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/budgets", bytes.NewBufferString(`{"category_id":1,"year":2025,"month":13,"amount":1000}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Conflict - Duplicate", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().
+			CreateBudget(mock.Anything, reqBody, testUserID).
+			Return(nil, service.ErrConflict).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/budgets", bytes.NewBuffer(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestBudgetHandler_GetBudgetStatus(t *testing.T) {
+	mockService := serviceMocks.NewMockBudgetService(t)
+	handler := NewBudgetHandler(mockService)
+	testUserID := uuid.New()
+
+	t.Run("Success - Dengan Query Parameter", func(t *testing.T) {
+		// 1. Setup
+		router := setupRouter()
+		router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+		router.GET("/budgets/status", handler.GetBudgetStatus)
+
+		mockService.EXPECT().
+			GetBudgetStatus(mock.Anything, testUserID, 2025, 10).
+			Return(&models.BudgetStatusReport{Year: 2025, Month: 10, TotalBudgeted: 500000, TotalSpent: 200000, TotalRemaining: 300000}, nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/budgets/status?month=10&year=2025", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resp models.BudgetStatusReport
+		json.Unmarshal(w.Body.Bytes(), &resp)
+		assert.Equal(t, int64(300000), resp.TotalRemaining)
+	})
+}
+
+func TestBudgetHandler_DeleteBudget(t *testing.T) {
+	mockService := serviceMocks.NewMockBudgetService(t)
+	handler := NewBudgetHandler(mockService)
+	testUserID := uuid.New()
+
+	router := setupRouter()
+	router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+	router.DELETE("/budgets/:id", handler.DeleteBudget)
+
+	t.Run("Forbidden - Not Owner", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().
+			DeleteBudget(mock.Anything, int64(9), testUserID).
+			Return(service.ErrForbidden).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/budgets/9", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("Bad Request - Invalid ID", func(t *testing.T) {
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/budgets/abc", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
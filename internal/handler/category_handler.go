@@ -46,6 +46,9 @@ func (h *CategoryHandler) CreateCategory(c *gin.Context) {
 
 	cat, err := h.categoryService.CreateCategory(c.Request.Context(), req, userID)
 	if err != nil {
+		if respondHierarchyError(c, err) {
+			return
+		}
 		c.JSON(http.StatusConflict, gin.H{"error": "Category with this name already exists"})
 		return
 	}
@@ -60,7 +63,13 @@ func (h *CategoryHandler) GetUserCategories(c *gin.Context) {
 		return
 	}
 
-	categories, err := h.categoryService.GetUserCategories(c.Request.Context(), userID)
+	var query models.CategoryQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters"})
+		return
+	}
+
+	categories, err := h.categoryService.GetUserCategories(c.Request.Context(), userID, query)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not fetch categories"})
 		return
@@ -69,6 +78,32 @@ func (h *CategoryHandler) GetUserCategories(c *gin.Context) {
 	c.JSON(http.StatusOK, categories)
 }
 
+// ApplyDefaultCategories menambahkan kategori bawaan yang belum dimiliki user (opt-in).
+func (h *CategoryHandler) ApplyDefaultCategories(c *gin.Context) {
+	userID, err := getAuthenticatedUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	// Body bersifat opsional; tanpa body template dipakai dalam default_locale
+	var req models.ApplyCategoryTemplateRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	created, err := h.categoryService.ApplyDefaultCategories(c.Request.Context(), userID, req.Locale)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not apply default categories"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Default categories applied successfully", "created": created})
+}
+
 func (h *CategoryHandler) UpdateCategory(c *gin.Context) {
 	userID, err := getAuthenticatedUserID(c)
 	if err != nil {
@@ -91,10 +126,17 @@ func (h *CategoryHandler) UpdateCategory(c *gin.Context) {
 
 	err = h.categoryService.UpdateCategory(c.Request.Context(), categoryID, req, userID)
 	if err != nil {
+		if respondHierarchyError(c, err) {
+			return
+		}
 		if errors.Is(err, service.ErrForbidden) {
 			c.JSON(http.StatusForbidden, gin.H{"error": "You are not allowed to update this category"})
 			return
 		}
+		if errors.Is(err, service.ErrConflict) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
 
 		c.JSON(http.StatusConflict, gin.H{"error": "Category with this name already exists"})
 		return
@@ -117,15 +159,100 @@ func (h *CategoryHandler) DeleteCategory(c *gin.Context) {
 		return
 	}
 
+	var query models.DeleteCategoryQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters"})
+		return
+	}
+
+	if query.ReassignTo != 0 {
+		moved, err := h.categoryService.MergeCategory(c.Request.Context(), categoryID, query.ReassignTo, userID)
+		if err != nil {
+			h.respondMergeError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"message": "Category deleted successfully", "moved_transactions": moved})
+		return
+	}
+
 	err = h.categoryService.DeleteCategory(c.Request.Context(), categoryID, userID)
 	if err != nil {
+		var inUse *service.CategoryInUseError
 		if errors.Is(err, service.ErrForbidden) {
 			c.JSON(http.StatusForbidden, gin.H{"error": "You are not allowed to delete this category"})
 			return
 		}
+		if errors.As(err, &inUse) {
+			c.JSON(http.StatusConflict, gin.H{
+				"error":             "Category still has transactions, recurring transactions, bills, budgets or an envelope balance, use reassign_to to move them",
+				"transaction_count": inUse.TransactionCount,
+				"recurring_count":   inUse.RecurringCount,
+				"bill_count":        inUse.BillCount,
+				"budget_count":      inUse.BudgetCount,
+				"envelope_balance":  inUse.EnvelopeBalance,
+			})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not delete category"})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{"message": "Category deleted successfully"})
 }
+
+func (h *CategoryHandler) MergeCategory(c *gin.Context) {
+	userID, err := getAuthenticatedUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	idParam := c.Param("id")
+	categoryID, err := strconv.ParseInt(idParam, 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid category ID"})
+		return
+	}
+
+	var req models.MergeCategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	moved, err := h.categoryService.MergeCategory(c.Request.Context(), categoryID, req.TargetID, userID)
+	if err != nil {
+		h.respondMergeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Category merged successfully", "moved_transactions": moved})
+}
+
+func (h *CategoryHandler) respondMergeError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": "Invalid source or target category ID"})
+	case errors.Is(err, service.ErrSameCategory), errors.Is(err, service.ErrCategoryKindMismatch),
+		errors.Is(err, service.ErrCategoryCycle), errors.Is(err, service.ErrCategoryTooDeep):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not merge category"})
+	}
+}
+
+// respondHierarchyError menangani error validasi parent_id; mengembalikan true jika response sudah ditulis.
+func respondHierarchyError(c *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, service.ErrCategoryCycle), errors.Is(err, service.ErrCategoryTooDeep):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, service.ErrInvalidParentCategory):
+		c.JSON(http.StatusForbidden, gin.H{"error": "Invalid parent category ID"})
+	case errors.Is(err, service.ErrCategoryKindMismatch):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Category kind must match its parent and sub-categories"})
+	default:
+		return false
+	}
+	return true
+}
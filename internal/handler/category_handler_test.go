@@ -157,6 +157,62 @@ func TestCategoryHandler_UpdateCategory(t *testing.T) {
 		assert.Contains(t, w.Body.String(), "not allowed")
 	})
 
+	// Menguji kasus di mana parent_id baru bukan milik pengguna.
+	t.Run("Forbidden - Invalid Parent", func(t *testing.T) {
+		router := setupCategoryTestRouter()
+		router.Use(func(c *gin.Context) {
+			setAuthContext(c, testUserID)
+			c.Next()
+		})
+		router.PUT("/categories/:id", handler.UpdateCategory)
+
+		parentID := int64(99)
+		reqBody := models.UpsertCategoryRequest{Name: "Transportasi", ParentID: &parentID}
+		jsonBody, _ := json.Marshal(reqBody)
+
+		mockService.EXPECT().
+			UpdateCategory(mock.Anything, categoryID, reqBody, testUserID).
+			Return(service.ErrInvalidParentCategory).
+			Once()
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPut, "/categories/"+strconv.FormatInt(categoryID, 10), bytes.NewBuffer(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Contains(t, w.Body.String(), "Invalid parent category ID")
+	})
+
+	// Menguji kasus di mana parent_id baru akan membentuk siklus.
+	t.Run("Bad Request - Cycle", func(t *testing.T) {
+		router := setupCategoryTestRouter()
+		router.Use(func(c *gin.Context) {
+			setAuthContext(c, testUserID)
+			c.Next()
+		})
+		router.PUT("/categories/:id", handler.UpdateCategory)
+
+		parentID := int64(2)
+		reqBody := models.UpsertCategoryRequest{Name: "Transportasi", ParentID: &parentID}
+		jsonBody, _ := json.Marshal(reqBody)
+
+		mockService.EXPECT().
+			UpdateCategory(mock.Anything, categoryID, reqBody, testUserID).
+			Return(service.ErrCategoryCycle).
+			Once()
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPut, "/categories/"+strconv.FormatInt(categoryID, 10), bytes.NewBuffer(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "ancestor")
+	})
+
 	// Menguji kasus di mana ID kategori yang diberikan di URL tidak valid.
 	t.Run("Bad Request - Invalid ID", func(t *testing.T) {
 		router := setupCategoryTestRouter()
@@ -180,3 +236,179 @@ func TestCategoryHandler_UpdateCategory(t *testing.T) {
 		mockService.AssertNotCalled(t, "UpdateCategory")
 	})
 }
+
+// TestCategoryHandler_DeleteCategory menguji penghapusan kategori beserta opsi reassign_to.
+func TestCategoryHandler_DeleteCategory(t *testing.T) {
+	mockService := mocks.NewMockCategoryService(t)
+	handler := NewCategoryHandler(mockService)
+
+	testUserID := uuid.New()
+
+	newRouter := func() *gin.Engine {
+		router := setupCategoryTestRouter()
+		router.Use(func(c *gin.Context) {
+			setAuthContext(c, testUserID)
+			c.Next()
+		})
+		router.DELETE("/categories/:id", handler.DeleteCategory)
+		return router
+	}
+
+	// Kategori masih dipakai dan tidak ada target: 409 beserta jumlah transaksi.
+	t.Run("Conflict - Has Transactions", func(t *testing.T) {
+		router := newRouter()
+
+		mockService.EXPECT().
+			DeleteCategory(mock.Anything, int64(1), testUserID).
+			Return(&service.CategoryInUseError{TransactionCount: 4}).
+			Once()
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/categories/1", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), `"transaction_count":4`)
+	})
+
+	// Dengan reassign_to, transaksi dipindahkan lalu kategori dihapus.
+	t.Run("Success - Reassign", func(t *testing.T) {
+		router := newRouter()
+
+		mockService.EXPECT().
+			MergeCategory(mock.Anything, int64(1), int64(2), testUserID).
+			Return(int64(4), nil).
+			Once()
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/categories/1?reassign_to=2", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"moved_transactions":4`)
+	})
+}
+
+// TestCategoryHandler_MergeCategory menguji endpoint penggabungan kategori.
+func TestCategoryHandler_MergeCategory(t *testing.T) {
+	mockService := mocks.NewMockCategoryService(t)
+	handler := NewCategoryHandler(mockService)
+
+	testUserID := uuid.New()
+
+	newRouter := func() *gin.Engine {
+		router := setupCategoryTestRouter()
+		router.Use(func(c *gin.Context) {
+			setAuthContext(c, testUserID)
+			c.Next()
+		})
+		router.POST("/categories/:id/merge", handler.MergeCategory)
+		return router
+	}
+
+	// Menguji kasus sukses penggabungan.
+	t.Run("Success", func(t *testing.T) {
+		router := newRouter()
+
+		mockService.EXPECT().
+			MergeCategory(mock.Anything, int64(3), int64(5), testUserID).
+			Return(int64(10), nil).
+			Once()
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/categories/3/merge", bytes.NewBufferString(`{"target_id": 5}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"moved_transactions":10`)
+	})
+
+	// Menguji kasus penggabungan ke kategori yang sama.
+	t.Run("Bad Request - Same Category", func(t *testing.T) {
+		router := newRouter()
+
+		mockService.EXPECT().
+			MergeCategory(mock.Anything, int64(3), int64(3), testUserID).
+			Return(int64(0), service.ErrSameCategory).
+			Once()
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/categories/3/merge", bytes.NewBufferString(`{"target_id": 3}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	// Menguji kasus target milik user lain.
+	t.Run("Forbidden - Foreign Target", func(t *testing.T) {
+		router := newRouter()
+
+		mockService.EXPECT().
+			MergeCategory(mock.Anything, int64(3), int64(99), testUserID).
+			Return(int64(0), service.ErrForbidden).
+			Once()
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/categories/3/merge", bytes.NewBufferString(`{"target_id": 99}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+// TestCategoryHandler_ApplyDefaultCategories menguji penerapan ulang template kategori bawaan.
+func TestCategoryHandler_ApplyDefaultCategories(t *testing.T) {
+	mockService := mocks.NewMockCategoryService(t)
+	handler := NewCategoryHandler(mockService)
+	testUserID := uuid.New()
+
+	router := setupCategoryTestRouter()
+	router.Use(func(c *gin.Context) {
+		setAuthContext(c, testUserID)
+		c.Next()
+	})
+	router.POST("/categories/defaults", handler.ApplyDefaultCategories)
+
+	t.Run("Success - Without Body", func(t *testing.T) {
+		mockService.EXPECT().
+			ApplyDefaultCategories(mock.Anything, testUserID, "").
+			Return([]models.Category{{ID: 1, Name: "Gaji"}}, nil).
+			Once()
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/categories/defaults", nil)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "Gaji")
+	})
+
+	t.Run("Success - English", func(t *testing.T) {
+		mockService.EXPECT().
+			ApplyDefaultCategories(mock.Anything, testUserID, "en").
+			Return([]models.Category{}, nil).
+			Once()
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/categories/defaults", bytes.NewBufferString(`{"locale":"en"}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Bad Request - Unsupported Locale", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/categories/defaults", bytes.NewBufferString(`{"locale":"fr"}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
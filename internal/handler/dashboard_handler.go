@@ -29,9 +29,7 @@ func (h *DashboardHandler) GetDashboardSummary(c *gin.Context) {
 		return
 	}
 
-	startTime, endTime := query.GetDateRange()
-
-	summary, err := h.dashboardService.GetDashboardSummary(c.Request.Context(), userID, startTime, endTime)
+	summary, err := h.dashboardService.GetDashboardSummary(c.Request.Context(), userID, query)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not fetch dashboard summary"})
 		return
@@ -39,3 +37,25 @@ func (h *DashboardHandler) GetDashboardSummary(c *gin.Context) {
 
 	c.JSON(http.StatusOK, summary)
 }
+
+func (h *DashboardHandler) GetCategoryBreakdown(c *gin.Context) {
+	userID, err := getAuthenticatedUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var query models.CategoryBreakdownQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters"})
+		return
+	}
+
+	breakdown, err := h.dashboardService.GetCategoryBreakdown(c.Request.Context(), userID, query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not fetch category breakdown"})
+		return
+	}
+
+	c.JSON(http.StatusOK, breakdown)
+}
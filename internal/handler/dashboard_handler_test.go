@@ -5,7 +5,6 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
-	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -36,10 +35,9 @@ func TestDashboardHandler_GetDashboardSummary(t *testing.T) {
 		router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
 		router.GET("/dashboard", handler.GetDashboardSummary)
 
-		// Harapkan panggilan service. startTime dan endTime akan
-		// di-resolve oleh GetDateRange(), jadi kita gunakan mock.Anything
+		// Periode kosong di-resolve service berdasarkan preferensi user
 		mockService.EXPECT().
-			GetDashboardSummary(mock.Anything, testUserID, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+			GetDashboardSummary(mock.Anything, testUserID, models.DashboardQuery{}).
 			Return(mockResponse, nil).
 			Once()
 
@@ -62,14 +60,9 @@ func TestDashboardHandler_GetDashboardSummary(t *testing.T) {
 		router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
 		router.GET("/dashboard", handler.GetDashboardSummary)
 
-		// Definisikan rentang waktu yang kita harapkan
-		loc, _ := time.LoadLocation("Asia/Jakarta")
-		expectedStart := time.Date(2025, time.October, 1, 0, 0, 0, 0, loc)
-		expectedEnd := time.Date(2025, time.October, 31, 23, 59, 59, 999999999, loc)
-
-		// Harapkan panggilan service dengan rentang waktu yang TEPAT
+		// Harapkan panggilan service dengan periode yang TEPAT
 		mockService.EXPECT().
-			GetDashboardSummary(mock.Anything, testUserID, expectedStart, expectedEnd).
+			GetDashboardSummary(mock.Anything, testUserID, models.DashboardQuery{Month: 10, Year: 2025}).
 			Return(mockResponse, nil).
 			Once()
 
@@ -87,3 +80,70 @@ func TestDashboardHandler_GetDashboardSummary(t *testing.T) {
 		assert.Equal(t, mockResponse.TotalIncome, resp.TotalIncome)
 	})
 }
+
+func TestDashboardHandler_GetCategoryBreakdown(t *testing.T) {
+	mockService := serviceMocks.NewMockDashboardService(t)
+	handler := NewDashboardHandler(mockService)
+	testUserID := uuid.New()
+
+	t.Run("Success - Rollup", func(t *testing.T) {
+		// 1. Setup
+		router := setupRouter()
+		router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+		router.GET("/dashboard/categories", handler.GetCategoryBreakdown)
+
+		mockResponse := []models.CategorySummary{
+			{CategoryID: 1, Name: "Transportasi", TotalExpense: 60000},
+		}
+
+		mockService.EXPECT().
+			GetCategoryBreakdown(mock.Anything, testUserID, models.CategoryBreakdownQuery{DashboardQuery: models.DashboardQuery{Month: 10, Year: 2025}, Rollup: true}).
+			Return(mockResponse, nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/dashboard/categories?month=10&year=2025&rollup=true", nil)
+
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resp []models.CategorySummary
+		json.Unmarshal(w.Body.Bytes(), &resp)
+		assert.Len(t, resp, 1)
+		assert.Equal(t, int64(60000), resp[0].TotalExpense)
+	})
+
+	t.Run("Bad Request - Invalid Period", func(t *testing.T) {
+		// 1. Setup
+		router := setupRouter()
+		router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+		router.GET("/dashboard/categories", handler.GetCategoryBreakdown)
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/dashboard/categories?period=week&date=10-10-2025", nil)
+
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Bad Request - Invalid Rollup", func(t *testing.T) {
+		// 1. Setup
+		router := setupRouter()
+		router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+		router.GET("/dashboard/categories", handler.GetCategoryBreakdown)
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/dashboard/categories?rollup=maybe", nil)
+
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
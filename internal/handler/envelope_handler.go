@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type EnvelopeHandler struct {
+	envelopeService service.EnvelopeService
+}
+
+func NewEnvelopeHandler(svc service.EnvelopeService) *EnvelopeHandler {
+	return &EnvelopeHandler{envelopeService: svc}
+}
+
+func (h *EnvelopeHandler) GetEnvelopes(c *gin.Context) {
+	userID, err := getAuthenticatedUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	summary, err := h.envelopeService.GetEnvelopes(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve envelopes"})
+		return
+	}
+
+	c.JSON(http.StatusOK, summary)
+}
+
+func (h *EnvelopeHandler) AssignEnvelope(c *gin.Context) {
+	userID, err := getAuthenticatedUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req models.AssignEnvelopeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	envelope, err := h.envelopeService.AssignEnvelope(c.Request.Context(), req, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrForbidden):
+			c.JSON(http.StatusForbidden, gin.H{"error": "Invalid category ID"})
+		case errors.Is(err, service.ErrCategoryKindMismatch):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Envelopes can only be used for expense categories"})
+		case errors.Is(err, service.ErrInsufficientFunds):
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to assign envelope"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, envelope)
+}
+
+func (h *EnvelopeHandler) DeleteEnvelope(c *gin.Context) {
+	userID, err := getAuthenticatedUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	envelopeID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid envelope ID"})
+		return
+	}
+
+	err = h.envelopeService.DeleteEnvelope(c.Request.Context(), envelopeID, userID)
+	if err != nil {
+		if errors.Is(err, service.ErrForbidden) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "You are not allowed to delete this envelope"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete envelope"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Envelope deleted successfully"})
+}
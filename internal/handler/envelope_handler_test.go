@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/service"
+	serviceMocks "github.com/Udean777/uang-bijak-go/internal/service/mocks"
+)
+
+func TestEnvelopeHandler_AssignEnvelope(t *testing.T) {
+	mockService := serviceMocks.NewMockEnvelopeService(t)
+	handler := NewEnvelopeHandler(mockService)
+	testUserID := uuid.New()
+
+	router := setupRouter()
+	router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+	router.POST("/envelopes/assign", handler.AssignEnvelope)
+
+	t.Run("Success", func(t *testing.T) {
+		// 1. Setup
+		req := models.AssignEnvelopeRequest{CategoryID: 1, Amount: 500000}
+		mockService.EXPECT().
+			AssignEnvelope(mock.Anything, req, testUserID).
+			Return(&models.Envelope{ID: 1, CategoryID: 1, Balance: 500000}, nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		httpReq, _ := http.NewRequest(http.MethodPost, "/envelopes/assign", bytes.NewBufferString(`{"category_id":1,"amount":500000}`))
+		httpReq.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, httpReq)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Bad Request - Zero Amount", func(t *testing.T) {
+		// 2. Act
+		w := httptest.NewRecorder()
+		httpReq, _ := http.NewRequest(http.MethodPost, "/envelopes/assign", bytes.NewBufferString(`{"category_id":1,"amount":0}`))
+		httpReq.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, httpReq)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Unprocessable - Insufficient Funds", func(t *testing.T) {
+		// 1. Setup
+		req := models.AssignEnvelopeRequest{CategoryID: 1, Amount: 900000}
+		mockService.EXPECT().
+			AssignEnvelope(mock.Anything, req, testUserID).
+			Return(nil, fmt.Errorf("only 100 is ready to assign: %w", service.ErrInsufficientFunds)).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		httpReq, _ := http.NewRequest(http.MethodPost, "/envelopes/assign", bytes.NewBufferString(`{"category_id":1,"amount":900000}`))
+		httpReq.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, httpReq)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Contains(t, w.Body.String(), "ready to assign")
+	})
+}
+
+func TestEnvelopeHandler_DeleteEnvelope(t *testing.T) {
+	mockService := serviceMocks.NewMockEnvelopeService(t)
+	handler := NewEnvelopeHandler(mockService)
+	testUserID := uuid.New()
+
+	router := setupRouter()
+	router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+	router.DELETE("/envelopes/:id", handler.DeleteEnvelope)
+
+	t.Run("Forbidden", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().DeleteEnvelope(mock.Anything, int64(7), testUserID).Return(service.ErrForbidden).Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/envelopes/7", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
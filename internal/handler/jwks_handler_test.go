@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Udean777/uang-bijak-go/internal/jwtkeys"
+)
+
+func TestJWKSHandler_GetJWKS(t *testing.T) {
+	// HS256 saja: tidak ada public key yang dipublikasikan, dan secret tidak pernah bocor
+	handler := NewJWKSHandler(jwtkeys.NewHMACKeySet("super-secret"))
+
+	router := setupRouter()
+	router.GET("/.well-known/jwks.json", handler.GetJWKS)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"keys": []}`, w.Body.String())
+	assert.NotContains(t, w.Body.String(), "super-secret")
+	assert.Contains(t, w.Header().Get("Cache-Control"), "max-age")
+}
@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/service"
+	serviceMocks "github.com/Udean777/uang-bijak-go/internal/service/mocks"
+)
+
+func TestMFAHandler(t *testing.T) {
+	mockService := serviceMocks.NewMockMFAService(t)
+	handler := NewMFAHandler(mockService)
+	testUserID := uuid.New()
+
+	router := setupRouter()
+	router.Use(func(c *gin.Context) {
+		setAuthContext(c, testUserID)
+	})
+	router.POST("/me/mfa/totp", handler.EnrollTOTP)
+	router.POST("/me/mfa/totp/confirm", handler.ConfirmTOTP)
+	router.POST("/me/mfa/totp/disable", handler.DisableTOTP)
+
+	t.Run("Enroll Success", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().
+			EnrollTOTP(mock.Anything, testUserID).
+			Return(&models.TOTPEnrollment{Secret: "SECRET", URI: "otpauth://totp/x"}, nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/me/mfa/totp", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"otpauth_uri":"otpauth://totp/x"`)
+	})
+
+	t.Run("Enroll Already Enabled", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().EnrollTOTP(mock.Anything, testUserID).Return(nil, service.ErrMFAAlreadyEnabled).Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/me/mfa/totp", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("Confirm Success Returns Recovery Codes", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().
+			ConfirmTOTP(mock.Anything, testUserID, "123456").
+			Return([]string{"aaaa-bbbb-cccc-dddd"}, nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/me/mfa/totp/confirm", bytes.NewBufferString(`{"code": "123456"}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Equal(t, []interface{}{"aaaa-bbbb-cccc-dddd"}, response["recovery_codes"])
+	})
+
+	t.Run("Confirm Invalid Code", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().ConfirmTOTP(mock.Anything, testUserID, "000000").Return(nil, service.ErrInvalidMFACode).Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/me/mfa/totp/confirm", bytes.NewBufferString(`{"code": "000000"}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Disable Success", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().DisableTOTP(mock.Anything, testUserID, "123456").Return(nil).Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/me/mfa/totp/disable", bytes.NewBufferString(`{"code": "123456"}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Disable Missing Code", func(t *testing.T) {
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/me/mfa/totp/disable", bytes.NewBufferString(`{}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/service"
+	serviceMocks "github.com/Udean777/uang-bijak-go/internal/service/mocks"
+)
+
+func TestNotificationHandler_GetNotifications(t *testing.T) {
+	mockService := serviceMocks.NewMockNotificationService(t)
+	handler := NewNotificationHandler(mockService)
+	testUserID := uuid.New()
+
+	router := setupRouter()
+	router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+	router.GET("/notifications", handler.GetNotifications)
+
+	t.Run("Success - Unread Only", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().
+			GetNotifications(mock.Anything, testUserID, models.NotificationQuery{Unread: true}).
+			Return([]models.Notification{{ID: 1, Type: models.NotificationBudgetThreshold, Message: "Makanan 80%"}}, nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/notifications?unread=true", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "budget_threshold")
+	})
+}
+
+func TestNotificationHandler_MarkAsRead(t *testing.T) {
+	mockService := serviceMocks.NewMockNotificationService(t)
+	handler := NewNotificationHandler(mockService)
+	testUserID := uuid.New()
+
+	router := setupRouter()
+	router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+	router.PUT("/notifications/:id/read", handler.MarkAsRead)
+
+	t.Run("Success", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().MarkAsRead(mock.Anything, int64(3), testUserID).Return(nil).Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPut, "/notifications/3/read", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().MarkAsRead(mock.Anything, int64(4), testUserID).Return(service.ErrForbidden).Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPut, "/notifications/4/read", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Udean777/uang-bijak-go/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type OIDCCallbackRequest struct {
+	Code  string `json:"code" binding:"required"`
+	State string `json:"state" binding:"required"`
+	// Device adalah nama perangkat yang ditampilkan di daftar sesi (opsional)
+	Device string `json:"device" binding:"omitempty,max=100"`
+}
+
+type OIDCHandler struct {
+	oidcService service.OIDCService
+}
+
+func NewOIDCHandler(svc service.OIDCService) *OIDCHandler {
+	return &OIDCHandler{oidcService: svc}
+}
+
+// respondOIDCError memetakan error login OIDC ke status HTTP.
+func respondOIDCError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, service.ErrUnknownOIDCProvider):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, service.ErrInvalidOIDCState):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, service.ErrOIDCLoginFailed):
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+	case errors.Is(err, service.ErrOIDCEmailNotVerified):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, service.ErrOIDCAccountNotLinkable):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, service.ErrOIDCProviderUnavailable):
+		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
+
+// StartLogin mengembalikan URL authorization provider. Client menyimpan state, mengarahkan
+// user ke URL tersebut, lalu mengirim code dan state dari redirect ke Callback.
+func (h *OIDCHandler) StartLogin(c *gin.Context) {
+	authorization, err := h.oidcService.StartLogin(c.Request.Context(), c.Param("provider"))
+	if err != nil {
+		respondOIDCError(c, err, "Failed to start login")
+		return
+	}
+
+	c.JSON(http.StatusOK, authorization)
+}
+
+// Callback menukar code dari identity provider dengan sesi, sama seperti Login.
+func (h *OIDCHandler) Callback(c *gin.Context) {
+	var req OIDCCallbackRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.oidcService.CompleteLogin(c.Request.Context(), c.Param("provider"), req.Code, req.State, sessionMetadata(c, req.Device))
+	if err != nil {
+		respondOIDCError(c, err, "Failed to complete login")
+		return
+	}
+
+	respondLoginResult(c, result)
+}
@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/service"
+	serviceMocks "github.com/Udean777/uang-bijak-go/internal/service/mocks"
+)
+
+func TestOIDCHandler(t *testing.T) {
+	mockService := serviceMocks.NewMockOIDCService(t)
+	handler := NewOIDCHandler(mockService)
+
+	router := setupRouter()
+	router.POST("/auth/oidc/:provider/authorize", handler.StartLogin)
+	router.POST("/auth/oidc/:provider/callback", handler.Callback)
+
+	t.Run("Start Login Success", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().
+			StartLogin(mock.Anything, "google").
+			Return(&models.OIDCAuthorization{AuthorizationURL: "https://accounts.example.com/auth?state=abc", State: "abc"}, nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/auth/oidc/google/authorize", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Equal(t, "https://accounts.example.com/auth?state=abc", response["authorization_url"])
+		assert.Equal(t, "abc", response["state"])
+	})
+
+	t.Run("Start Login Unknown Provider", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().StartLogin(mock.Anything, "myspace").Return(nil, service.ErrUnknownOIDCProvider).Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/auth/oidc/myspace/authorize", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("Callback Success Sets Refresh Cookie", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().
+			CompleteLogin(mock.Anything, "google", "code-1", "state-1", mock.MatchedBy(func(meta models.SessionMetadata) bool {
+				return meta.Device == "Laptop"
+			})).
+			Return(&models.LoginResult{AccessToken: "access", RefreshToken: "refresh"}, nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		body := `{"code": "code-1", "state": "state-1", "device": "Laptop"}`
+		req, _ := http.NewRequest(http.MethodPost, "/auth/oidc/google/callback", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"access_token":"access"`)
+		assert.Contains(t, w.Header().Get("Set-Cookie"), "refresh_token=refresh")
+	})
+
+	t.Run("Callback MFA Required", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().
+			CompleteLogin(mock.Anything, "google", "code-1", "state-1", mock.Anything).
+			Return(&models.LoginResult{MFARequired: true, MFAToken: "mfa-token"}, nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/auth/oidc/google/callback", bytes.NewBufferString(`{"code": "code-1", "state": "state-1"}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"mfa_token":"mfa-token"`)
+		assert.NotContains(t, w.Body.String(), "access_token")
+	})
+
+	t.Run("Callback Errors", func(t *testing.T) {
+		tests := []struct {
+			err    error
+			status int
+		}{
+			{service.ErrInvalidOIDCState, http.StatusBadRequest},
+			{service.ErrOIDCLoginFailed, http.StatusUnauthorized},
+			{service.ErrOIDCEmailNotVerified, http.StatusForbidden},
+			{service.ErrOIDCAccountNotLinkable, http.StatusConflict},
+			{service.ErrOIDCProviderUnavailable, http.StatusBadGateway},
+		}
+
+		for _, tt := range tests {
+			// 1. Setup
+			mockService.EXPECT().CompleteLogin(mock.Anything, "google", "code-1", "state-1", mock.Anything).Return(nil, tt.err).Once()
+
+			// 2. Act
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/auth/oidc/google/callback", bytes.NewBufferString(`{"code": "code-1", "state": "state-1"}`))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			// 3. Assert
+			assert.Equal(t, tt.status, w.Code, tt.err.Error())
+		}
+	})
+
+	t.Run("Callback Missing State", func(t *testing.T) {
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/auth/oidc/google/callback", bytes.NewBufferString(`{"code": "code-1"}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/service"
+	serviceMocks "github.com/Udean777/uang-bijak-go/internal/service/mocks"
+)
+
+func TestPasswordHandler_ChangePassword(t *testing.T) {
+	mockService := serviceMocks.NewMockPasswordService(t)
+	handler := NewPasswordHandler(mockService)
+	testUserID := uuid.New()
+	testSessionID := uuid.New()
+
+	router := setupRouter()
+	router.Use(func(c *gin.Context) {
+		setAuthContext(c, testUserID)
+		c.Set("sessionID", testSessionID)
+	})
+	router.PUT("/me/password", handler.ChangePassword)
+
+	t.Run("Success", func(t *testing.T) {
+		// 1. Setup
+		req := models.ChangePasswordRequest{CurrentPassword: "old-password", NewPassword: "new-password"}
+		mockService.EXPECT().ChangePassword(mock.Anything, testUserID, testSessionID, req).Return(nil).Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		body := `{"current_password": "old-password", "new_password": "new-password"}`
+		httpReq, _ := http.NewRequest(http.MethodPut, "/me/password", bytes.NewBufferString(body))
+		httpReq.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, httpReq)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Wrong Current Password", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().
+			ChangePassword(mock.Anything, testUserID, testSessionID, mock.Anything).
+			Return(service.ErrWrongPassword).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		body := `{"current_password": "wrong", "new_password": "new-password"}`
+		httpReq, _ := http.NewRequest(http.MethodPut, "/me/password", bytes.NewBufferString(body))
+		httpReq.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, httpReq)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("New Password Too Short", func(t *testing.T) {
+		// 2. Act
+		w := httptest.NewRecorder()
+		body := `{"current_password": "old-password", "new_password": "123"}`
+		httpReq, _ := http.NewRequest(http.MethodPut, "/me/password", bytes.NewBufferString(body))
+		httpReq.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, httpReq)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestPasswordHandler_ForgotPassword(t *testing.T) {
+	mockService := serviceMocks.NewMockPasswordService(t)
+	handler := NewPasswordHandler(mockService)
+
+	router := setupRouter()
+	router.POST("/auth/forgot-password", handler.ForgotPassword)
+
+	t.Run("Always OK", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().ForgotPassword(mock.Anything, "ghost@example.com").Return(nil).Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/auth/forgot-password", bytes.NewBufferString(`{"email": "ghost@example.com"}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func TestPasswordHandler_ResetPassword(t *testing.T) {
+	mockService := serviceMocks.NewMockPasswordService(t)
+	handler := NewPasswordHandler(mockService)
+
+	router := setupRouter()
+	router.POST("/auth/reset-password", handler.ResetPassword)
+
+	t.Run("Success", func(t *testing.T) {
+		// 1. Setup
+		req := models.ResetPasswordRequest{Token: "reset-token", NewPassword: "new-password"}
+		mockService.EXPECT().ResetPassword(mock.Anything, req).Return(nil).Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		httpReq, _ := http.NewRequest(http.MethodPost, "/auth/reset-password", bytes.NewBufferString(`{"token": "reset-token", "new_password": "new-password"}`))
+		httpReq.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, httpReq)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Invalid Token", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().ResetPassword(mock.Anything, mock.Anything).Return(service.ErrInvalidResetToken).Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		httpReq, _ := http.NewRequest(http.MethodPost, "/auth/reset-password", bytes.NewBufferString(`{"token": "used", "new_password": "new-password"}`))
+		httpReq.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, httpReq)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
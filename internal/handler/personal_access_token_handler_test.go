@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/service"
+	serviceMocks "github.com/Udean777/uang-bijak-go/internal/service/mocks"
+)
+
+func TestPersonalAccessTokenHandler(t *testing.T) {
+	mockService := serviceMocks.NewMockPersonalAccessTokenService(t)
+	handler := NewPersonalAccessTokenHandler(mockService)
+	testUserID := uuid.New()
+
+	router := setupRouter()
+	router.Use(func(c *gin.Context) {
+		setAuthContext(c, testUserID)
+	})
+	router.POST("/me/tokens", handler.CreateToken)
+	router.GET("/me/tokens", handler.GetTokens)
+	router.DELETE("/me/tokens/:id", handler.RevokeToken)
+
+	t.Run("Create Success Returns Token Once", func(t *testing.T) {
+		// 1. Setup
+		created := &models.PersonalAccessToken{ID: uuid.New(), Name: "ci", Scopes: []string{"read"}}
+		mockService.EXPECT().
+			CreateToken(mock.Anything, testUserID, models.CreatePersonalAccessTokenRequest{Name: "ci", Scopes: []string{"read"}}).
+			Return(created, "ubpat_plain", nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/me/tokens", bytes.NewBufferString(`{"name": "ci", "scopes": ["read"]}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Equal(t, "ubpat_plain", response["token"])
+		assert.Equal(t, "ci", response["name"])
+		assert.Equal(t, created.ID.String(), response["id"])
+	})
+
+	t.Run("Create Invalid Scope", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().
+			CreateToken(mock.Anything, testUserID, mock.Anything).
+			Return(nil, "", fmt.Errorf("%w: %q", service.ErrInvalidScope, "admin")).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/me/tokens", bytes.NewBufferString(`{"name": "ci", "scopes": ["admin"]}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Create Without Scopes", func(t *testing.T) {
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/me/tokens", bytes.NewBufferString(`{"name": "ci", "scopes": []}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("List Hides Hash", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().
+			GetTokens(mock.Anything, testUserID).
+			Return([]models.PersonalAccessToken{{ID: uuid.New(), Name: "ci", TokenHash: "secret-hash"}}, nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/me/tokens", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotContains(t, w.Body.String(), "secret-hash")
+	})
+
+	t.Run("Revoke Forbidden", func(t *testing.T) {
+		// 1. Setup
+		tokenID := uuid.New()
+		mockService.EXPECT().RevokeToken(mock.Anything, tokenID, testUserID).Return(service.ErrForbidden).Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/me/tokens/"+tokenID.String(), nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
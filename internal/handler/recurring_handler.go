@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type RecurringHandler struct {
+	recurringService service.RecurringService
+}
+
+func NewRecurringHandler(svc service.RecurringService) *RecurringHandler {
+	return &RecurringHandler{recurringService: svc}
+}
+
+func (h *RecurringHandler) CreateRecurring(c *gin.Context) {
+	userID, err := getAuthenticatedUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	var req models.CreateRecurringTransactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	recurring, err := h.recurringService.CreateRecurring(c.Request.Context(), req, userID)
+	if err != nil {
+		respondRecurringError(c, err, "Failed to create recurring transaction")
+		return
+	}
+
+	c.JSON(http.StatusCreated, recurring)
+}
+
+func (h *RecurringHandler) GetUserRecurring(c *gin.Context) {
+	userID, err := getAuthenticatedUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	templates, err := h.recurringService.GetUserRecurring(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve recurring transactions"})
+		return
+	}
+
+	c.JSON(http.StatusOK, templates)
+}
+
+func (h *RecurringHandler) UpdateRecurring(c *gin.Context) {
+	userID, err := getAuthenticatedUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	recurringID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid recurring transaction ID"})
+		return
+	}
+
+	var req models.UpdateRecurringTransactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	recurring, err := h.recurringService.UpdateRecurring(c.Request.Context(), recurringID, req, userID)
+	if err != nil {
+		respondRecurringError(c, err, "Failed to update recurring transaction")
+		return
+	}
+
+	c.JSON(http.StatusOK, recurring)
+}
+
+func (h *RecurringHandler) DeleteRecurring(c *gin.Context) {
+	userID, err := getAuthenticatedUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	recurringID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid recurring transaction ID"})
+		return
+	}
+
+	err = h.recurringService.DeleteRecurring(c.Request.Context(), recurringID, userID)
+	if err != nil {
+		if errors.Is(err, service.ErrForbidden) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "You are not allowed to delete this recurring transaction"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete recurring transaction"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Recurring transaction deleted successfully"})
+}
+
+func respondRecurringError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, service.ErrForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": "Invalid recurring transaction, wallet or category ID"})
+	case errors.Is(err, service.ErrCategoryKindMismatch):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Transaction type does not match category kind"})
+	case errors.Is(err, service.ErrInvalidSchedule):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, service.ErrWalletArchived):
+		c.JSON(http.StatusConflict, gin.H{"error": "Wallet is archived"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
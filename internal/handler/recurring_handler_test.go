@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/service"
+	serviceMocks "github.com/Udean777/uang-bijak-go/internal/service/mocks"
+)
+
+func TestRecurringHandler_CreateRecurring(t *testing.T) {
+	mockService := serviceMocks.NewMockRecurringService(t)
+	handler := NewRecurringHandler(mockService)
+	testUserID := uuid.New()
+
+	router := setupRouter()
+	router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+	router.POST("/recurring-transactions", handler.CreateRecurring)
+
+	body := `{"wallet_id":1,"category_id":2,"amount":1500000,"type":"expense","frequency":"monthly","start_date":"2026-01-05T00:00:00+07:00"}`
+
+	t.Run("Success", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().
+			CreateRecurring(mock.Anything, mock.AnythingOfType("models.CreateRecurringTransactionRequest"), testUserID).
+			Return(&models.RecurringTransaction{ID: 1, Schedule: models.Schedule{Frequency: models.FrequencyMonthly}}, nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/recurring-transactions", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("Bad Request - Unknown Frequency", func(t *testing.T) {
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/recurring-transactions",
+			bytes.NewBufferString(`{"wallet_id":1,"category_id":2,"amount":1000,"type":"expense","frequency":"hourly","start_date":"2026-01-05T00:00:00Z"}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Bad Request - End Date And Count", func(t *testing.T) {
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/recurring-transactions",
+			bytes.NewBufferString(`{"wallet_id":1,"category_id":2,"amount":1000,"type":"expense","frequency":"daily","start_date":"2026-01-05T00:00:00Z","end_date":"2026-02-05T00:00:00Z","count":3}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Bad Request - Invalid Schedule", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().
+			CreateRecurring(mock.Anything, mock.AnythingOfType("models.CreateRecurringTransactionRequest"), testUserID).
+			Return(nil, fmt.Errorf("end_date is before start_date: %w", service.ErrInvalidSchedule)).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/recurring-transactions", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "end_date")
+	})
+}
+
+func TestRecurringHandler_DeleteRecurring(t *testing.T) {
+	mockService := serviceMocks.NewMockRecurringService(t)
+	handler := NewRecurringHandler(mockService)
+	testUserID := uuid.New()
+
+	router := setupRouter()
+	router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+	router.DELETE("/recurring-transactions/:id", handler.DeleteRecurring)
+
+	t.Run("Forbidden", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().DeleteRecurring(mock.Anything, int64(9), testUserID).Return(service.ErrForbidden).Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/recurring-transactions/9", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
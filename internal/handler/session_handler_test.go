@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/service"
+	serviceMocks "github.com/Udean777/uang-bijak-go/internal/service/mocks"
+)
+
+func TestSessionHandler_GetSessions(t *testing.T) {
+	mockService := serviceMocks.NewMockSessionService(t)
+	handler := NewSessionHandler(mockService)
+	testUserID := uuid.New()
+	testSessionID := uuid.New()
+
+	router := setupRouter()
+	router.Use(func(c *gin.Context) {
+		setAuthContext(c, testUserID)
+		c.Set("sessionID", testSessionID)
+	})
+	router.GET("/me/sessions", handler.GetSessions)
+
+	t.Run("Success", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().
+			GetSessions(mock.Anything, testUserID, testSessionID).
+			Return([]models.Session{{ID: testSessionID, Device: "Pixel 8", Current: true}}, nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/me/sessions", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response []map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		assert.Len(t, response, 1)
+		assert.Equal(t, "Pixel 8", response[0]["device"])
+		assert.Equal(t, true, response[0]["current"])
+	})
+}
+
+func TestSessionHandler_RevokeSession(t *testing.T) {
+	mockService := serviceMocks.NewMockSessionService(t)
+	handler := NewSessionHandler(mockService)
+	testUserID := uuid.New()
+
+	router := setupRouter()
+	router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+	router.DELETE("/me/sessions/:id", handler.RevokeSession)
+
+	t.Run("Success", func(t *testing.T) {
+		// 1. Setup
+		sessionID := uuid.New()
+		mockService.EXPECT().RevokeSession(mock.Anything, sessionID, testUserID).Return(nil).Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/me/sessions/"+sessionID.String(), nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		// 1. Setup
+		sessionID := uuid.New()
+		mockService.EXPECT().RevokeSession(mock.Anything, sessionID, testUserID).Return(service.ErrForbidden).Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/me/sessions/"+sessionID.String(), nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("Invalid ID", func(t *testing.T) {
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/me/sessions/abc", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/Udean777/uang-bijak-go/internal/models"
 	"github.com/Udean777/uang-bijak-go/internal/service"
@@ -41,6 +42,14 @@ func (h *TransactionHandler) CreateTransaction(c *gin.Context) {
 			c.JSON(http.StatusForbidden, gin.H{"error": "Invalid wallet or category ID"})
 			return
 		}
+		if errors.Is(err, service.ErrCategoryKindMismatch) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Transaction type does not match category kind"})
+			return
+		}
+		if errors.Is(err, service.ErrWalletArchived) {
+			c.JSON(http.StatusConflict, gin.H{"error": "Wallet is archived"})
+			return
+		}
 
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create transaction"})
 		return
@@ -56,11 +65,98 @@ func (h *TransactionHandler) GetUserTransactions(c *gin.Context) {
 		return
 	}
 
-	transactions, err := h.trxService.GetUserTransactions(c.Request.Context(), userID)
+	var filter models.TransactionFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters"})
+		return
+	}
+
+	page, err := h.trxService.GetUserTransactions(c.Request.Context(), userID, filter)
 	if err != nil {
+		if errors.Is(err, service.ErrInvalidFilter) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not fetch transactions"})
 		return
 	}
 
-	c.JSON(http.StatusOK, transactions)
+	c.JSON(http.StatusOK, page)
+}
+
+func (h *TransactionHandler) UpdateTransaction(c *gin.Context) {
+	userID, err := getAuthenticatedUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	idParam := c.Param("id")
+	transactionID, err := strconv.ParseInt(idParam, 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid transaction ID"})
+		return
+	}
+
+	var req models.UpdateTransactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	trx, err := h.trxService.UpdateTransaction(c.Request.Context(), transactionID, req, userID)
+	if err != nil {
+		if errors.Is(err, service.ErrForbidden) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Invalid transaction, wallet or category ID"})
+			return
+		}
+		if errors.Is(err, service.ErrCategoryKindMismatch) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Transaction type does not match category kind"})
+			return
+		}
+		if errors.Is(err, service.ErrWalletArchived) {
+			c.JSON(http.StatusConflict, gin.H{"error": "Wallet is archived"})
+			return
+		}
+		if errors.Is(err, service.ErrManagedByTransfer) {
+			c.JSON(http.StatusConflict, gin.H{"error": "Transaction is a transfer fee; update the transfer instead"})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update transaction"})
+		return
+	}
+
+	c.JSON(http.StatusOK, trx)
+}
+
+func (h *TransactionHandler) DeleteTransaction(c *gin.Context) {
+	userID, err := getAuthenticatedUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	idParam := c.Param("id")
+	transactionID, err := strconv.ParseInt(idParam, 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid transaction ID"})
+		return
+	}
+
+	err = h.trxService.DeleteTransaction(c.Request.Context(), transactionID, userID)
+	if err != nil {
+		if errors.Is(err, service.ErrForbidden) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "You are not allowed to delete this transaction"})
+			return
+		}
+		if errors.Is(err, service.ErrManagedByTransfer) {
+			c.JSON(http.StatusConflict, gin.H{"error": "Transaction is a transfer fee; delete the transfer instead"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not delete transaction"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Transaction deleted successfully"})
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
 
 	"github.com/gin-gonic/gin"
@@ -118,4 +119,332 @@ func TestTransactionHandler_CreateTransaction(t *testing.T) {
 		assert.Equal(t, http.StatusForbidden, w.Code)
 		assert.Contains(t, w.Body.String(), "Invalid wallet or category ID")
 	})
+
+	t.Run("Bad Request - Category Kind Mismatch", func(t *testing.T) {
+		// 1. Setup
+		router := setupRouter()
+		router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+		router.POST("/transactions", handler.CreateTransaction)
+
+		reqBody := models.CreateTransactionRequest{
+			WalletID:   1,
+			CategoryID: 2, // Kategori "Gaji" (income)
+			Amount:     20000,
+			Type:       "expense",
+		}
+		jsonBody, _ := json.Marshal(reqBody)
+
+		mockService.EXPECT().
+			CreateTransaction(mock.Anything, reqBody, testUserID).
+			Return(nil, service.ErrCategoryKindMismatch).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/transactions", bytes.NewBuffer(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "does not match category kind")
+	})
+
+	t.Run("Conflict - Wallet Archived", func(t *testing.T) {
+		// 1. Setup
+		router := setupRouter()
+		router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+		router.POST("/transactions", handler.CreateTransaction)
+
+		reqBody := models.CreateTransactionRequest{
+			WalletID:   3,
+			CategoryID: 1,
+			Amount:     20000,
+			Type:       "expense",
+		}
+		jsonBody, _ := json.Marshal(reqBody)
+
+		mockService.EXPECT().
+			CreateTransaction(mock.Anything, reqBody, testUserID).
+			Return(nil, service.ErrWalletArchived).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/transactions", bytes.NewBuffer(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), "Wallet is archived")
+	})
+}
+
+func TestTransactionHandler_UpdateTransaction(t *testing.T) {
+	mockService := mocks.NewMockTransactionService(t)
+	handler := NewTransactionHandler(mockService)
+	testUserID := uuid.New()
+	transactionID := int64(10)
+
+	reqBody := models.UpdateTransactionRequest{
+		WalletID:   2,
+		CategoryID: 1,
+		Amount:     25000,
+		Type:       "expense",
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		// 1. Setup
+		router := setupRouter()
+		router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+		router.PUT("/transactions/:id", handler.UpdateTransaction)
+
+		jsonBody, _ := json.Marshal(reqBody)
+
+		mockResponse := &models.Transaction{
+			ID:         transactionID,
+			WalletID:   2,
+			CategoryID: 1,
+			Amount:     25000,
+			Type:       "expense",
+		}
+
+		mockService.EXPECT().
+			UpdateTransaction(mock.Anything, transactionID, reqBody, testUserID).
+			Return(mockResponse, nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPut, "/transactions/"+strconv.FormatInt(transactionID, 10), bytes.NewBuffer(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resp models.Transaction
+		json.Unmarshal(w.Body.Bytes(), &resp)
+		assert.Equal(t, int64(25000), resp.Amount)
+		assert.Equal(t, int64(2), resp.WalletID)
+	})
+
+	t.Run("Bad Request - Invalid ID", func(t *testing.T) {
+		// 1. Setup
+		router := setupRouter()
+		router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+		router.PUT("/transactions/:id", handler.UpdateTransaction)
+
+		jsonBody, _ := json.Marshal(reqBody)
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPut, "/transactions/abc", bytes.NewBuffer(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "UpdateTransaction")
+	})
+
+	t.Run("Forbidden - Not Owner", func(t *testing.T) {
+		// 1. Setup
+		router := setupRouter()
+		router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+		router.PUT("/transactions/:id", handler.UpdateTransaction)
+
+		jsonBody, _ := json.Marshal(reqBody)
+
+		mockService.EXPECT().
+			UpdateTransaction(mock.Anything, transactionID, reqBody, testUserID).
+			Return(nil, service.ErrForbidden).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPut, "/transactions/"+strconv.FormatInt(transactionID, 10), bytes.NewBuffer(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestTransactionHandler_DeleteTransaction(t *testing.T) {
+	mockService := mocks.NewMockTransactionService(t)
+	handler := NewTransactionHandler(mockService)
+	testUserID := uuid.New()
+	transactionID := int64(10)
+
+	t.Run("Success", func(t *testing.T) {
+		// 1. Setup
+		router := setupRouter()
+		router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+		router.DELETE("/transactions/:id", handler.DeleteTransaction)
+
+		mockService.EXPECT().
+			DeleteTransaction(mock.Anything, transactionID, testUserID).
+			Return(nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/transactions/"+strconv.FormatInt(transactionID, 10), nil)
+
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "Transaction deleted successfully")
+	})
+
+	t.Run("Forbidden - Not Owner", func(t *testing.T) {
+		// 1. Setup
+		router := setupRouter()
+		router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+		router.DELETE("/transactions/:id", handler.DeleteTransaction)
+
+		mockService.EXPECT().
+			DeleteTransaction(mock.Anything, transactionID, testUserID).
+			Return(service.ErrForbidden).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/transactions/"+strconv.FormatInt(transactionID, 10), nil)
+
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestTransactionHandler_GetUserTransactions(t *testing.T) {
+	mockService := mocks.NewMockTransactionService(t)
+	handler := NewTransactionHandler(mockService)
+	testUserID := uuid.New()
+
+	t.Run("Success - With Filters", func(t *testing.T) {
+		// 1. Setup
+		router := setupRouter()
+		router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+		router.GET("/transactions", handler.GetUserTransactions)
+
+		next := "abc"
+		mockService.EXPECT().
+			GetUserTransactions(mock.Anything, testUserID, mock.MatchedBy(func(f models.TransactionFilter) bool {
+				return f.Type == "expense" &&
+					len(f.WalletIDs) == 2 && f.WalletIDs[1] == 3 &&
+					f.StartDate != nil && f.StartDate.Day() == 1 &&
+					f.Search == "kopi" && f.Limit == 10
+			})).
+			Return(&models.TransactionPage{Data: []models.Transaction{{ID: 1}}, NextCursor: &next}, nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/transactions?type=expense&wallet_id=1&wallet_id=3&start_date=2025-10-01&q=kopi&limit=10", nil)
+
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resp models.TransactionPage
+		json.Unmarshal(w.Body.Bytes(), &resp)
+		assert.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, "abc", *resp.NextCursor)
+	})
+
+	t.Run("Success - Joined Names", func(t *testing.T) {
+		// 1. Setup
+		router := setupRouter()
+		router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+		router.GET("/transactions", handler.GetUserTransactions)
+
+		mockService.EXPECT().
+			GetUserTransactions(mock.Anything, testUserID, models.TransactionFilter{}).
+			Return(&models.TransactionPage{Data: []models.Transaction{{ID: 1, WalletName: "BCA", CategoryName: "Makan"}}}, nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/transactions", nil)
+
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"wallet_name":"BCA"`)
+		assert.Contains(t, w.Body.String(), `"category_name":"Makan"`)
+	})
+
+	t.Run("Success - IDs Only", func(t *testing.T) {
+		// 1. Setup
+		router := setupRouter()
+		router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+		router.GET("/transactions", handler.GetUserTransactions)
+
+		// Repository tidak melakukan join, sehingga nama kosong dan tidak ikut di-serialize
+		mockService.EXPECT().
+			GetUserTransactions(mock.Anything, testUserID, models.TransactionFilter{IDsOnly: true}).
+			Return(&models.TransactionPage{Data: []models.Transaction{{ID: 1, WalletID: 2, CategoryID: 3}}}, nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/transactions?ids_only=true", nil)
+
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotContains(t, w.Body.String(), "wallet_name")
+		assert.NotContains(t, w.Body.String(), "category_name")
+	})
+
+	t.Run("Bad Request - Limit Too Large", func(t *testing.T) {
+		// 1. Setup
+		router := setupRouter()
+		router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+		router.GET("/transactions", handler.GetUserTransactions)
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/transactions?limit=1000", nil)
+
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "GetUserTransactions")
+	})
+
+	t.Run("Bad Request - Invalid Cursor", func(t *testing.T) {
+		// 1. Setup
+		router := setupRouter()
+		router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+		router.GET("/transactions", handler.GetUserTransactions)
+
+		mockService.EXPECT().
+			GetUserTransactions(mock.Anything, testUserID, mock.Anything).
+			Return(nil, service.ErrInvalidFilter).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/transactions?cursor=rusak", nil)
+
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
 }
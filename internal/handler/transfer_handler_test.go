@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/service"
+
+	mocks "github.com/Udean777/uang-bijak-go/internal/service/mocks"
+)
+
+func TestTransferHandler_CreateTransfer(t *testing.T) {
+	mockService := mocks.NewMockTransferService(t)
+	handler := NewTransferHandler(mockService)
+	testUserID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// 1. Setup
+		router := setupRouter()
+		router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+		router.POST("/transfers", handler.CreateTransfer)
+
+		reqBody := models.UpsertTransferRequest{
+			FromWalletID: 1, // BCA
+			ToWalletID:   2, // GoPay
+			Amount:       100000,
+			Fee:          2500,
+		}
+		jsonBody, _ := json.Marshal(reqBody)
+
+		mockService.EXPECT().
+			CreateTransfer(mock.Anything, reqBody, testUserID).
+			Return(&models.Transfer{ID: 1, FromWalletID: 1, ToWalletID: 2, Amount: 100000, Fee: 2500}, nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/transfers", bytes.NewBuffer(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var resp models.Transfer
+		json.Unmarshal(w.Body.Bytes(), &resp)
+		assert.Equal(t, int64(2500), resp.Fee)
+	})
+
+	t.Run("Bad Request - Same Wallet", func(t *testing.T) {
+		// 1. Setup
+		router := setupRouter()
+		router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+		router.POST("/transfers", handler.CreateTransfer)
+
+		reqBody := `{"from_wallet_id": 1, "to_wallet_id": 1, "amount": 100}`
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/transfers", bytes.NewBufferString(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "CreateTransfer")
+	})
+
+	t.Run("Forbidden - Foreign Wallet", func(t *testing.T) {
+		// 1. Setup
+		router := setupRouter()
+		router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+		router.POST("/transfers", handler.CreateTransfer)
+
+		reqBody := models.UpsertTransferRequest{FromWalletID: 1, ToWalletID: 99, Amount: 100}
+		jsonBody, _ := json.Marshal(reqBody)
+
+		mockService.EXPECT().
+			CreateTransfer(mock.Anything, reqBody, testUserID).
+			Return(nil, service.ErrForbidden).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/transfers", bytes.NewBuffer(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestTransferHandler_GetUserTransfers(t *testing.T) {
+	mockService := mocks.NewMockTransferService(t)
+	handler := NewTransferHandler(mockService)
+	testUserID := uuid.New()
+
+	t.Run("Success - Paginated", func(t *testing.T) {
+		// 1. Setup
+		router := setupRouter()
+		router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+		router.GET("/transfers", handler.GetUserTransfers)
+
+		next := "abc"
+		mockService.EXPECT().
+			GetUserTransfers(mock.Anything, testUserID, models.TransferFilter{Cursor: "xyz", Limit: 10}).
+			Return(&models.TransferPage{Data: []models.Transfer{{ID: 1}}, NextCursor: &next}, nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/transfers?cursor=xyz&limit=10", nil)
+
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resp models.TransferPage
+		json.Unmarshal(w.Body.Bytes(), &resp)
+		assert.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, "abc", *resp.NextCursor)
+	})
+
+	t.Run("Bad Request - Invalid Cursor", func(t *testing.T) {
+		// 1. Setup
+		router := setupRouter()
+		router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+		router.GET("/transfers", handler.GetUserTransfers)
+
+		mockService.EXPECT().
+			GetUserTransfers(mock.Anything, testUserID, models.TransferFilter{Cursor: "bad"}).
+			Return(nil, service.ErrInvalidFilter).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/transfers?cursor=bad", nil)
+
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestTransferHandler_DeleteTransfer(t *testing.T) {
+	mockService := mocks.NewMockTransferService(t)
+	handler := NewTransferHandler(mockService)
+	testUserID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// 1. Setup
+		router := setupRouter()
+		router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+		router.DELETE("/transfers/:id", handler.DeleteTransfer)
+
+		mockService.EXPECT().
+			DeleteTransfer(mock.Anything, int64(5), testUserID).
+			Return(nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/transfers/5", nil)
+
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Forbidden - Not Owner", func(t *testing.T) {
+		// 1. Setup
+		router := setupRouter()
+		router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+		router.DELETE("/transfers/:id", handler.DeleteTransfer)
+
+		mockService.EXPECT().
+			DeleteTransfer(mock.Anything, int64(6), testUserID).
+			Return(service.ErrForbidden).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/transfers/6", nil)
+
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
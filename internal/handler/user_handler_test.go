@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/service"
+	serviceMocks "github.com/Udean777/uang-bijak-go/internal/service/mocks"
+)
+
+func TestUserHandler_UpdateMe(t *testing.T) {
+	mockService := serviceMocks.NewMockUserService(t)
+	handler := NewUserHandler(mockService)
+	testUserID := uuid.New()
+
+	router := setupRouter()
+	router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+	router.PATCH("/me", handler.UpdateMe)
+
+	t.Run("Success - Email Change", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().
+			UpdateProfile(mock.Anything, testUserID, mock.MatchedBy(func(req models.UpdateProfileRequest) bool {
+				return req.Name == nil && req.Email != nil && *req.Email == "budi.baru@example.com"
+			})).
+			Return(&models.User{ID: testUserID, Name: "Budi", Email: "budi.baru@example.com"}, nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/me", bytes.NewBufferString(`{"email": "budi.baru@example.com"}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resp map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &resp)
+		assert.Equal(t, "budi.baru@example.com", resp["Email"])
+		assert.Nil(t, resp["email_verified_at"])
+	})
+
+	t.Run("Invalid Email", func(t *testing.T) {
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/me", bytes.NewBufferString(`{"email": "bukan-email"}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Email Taken", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().UpdateProfile(mock.Anything, testUserID, mock.Anything).Return(nil, service.ErrEmailTaken).Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/me", bytes.NewBufferString(`{"email": "ani@example.com"}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestUserHandler_Preferences(t *testing.T) {
+	mockService := serviceMocks.NewMockUserService(t)
+	handler := NewUserHandler(mockService)
+	testUserID := uuid.New()
+
+	router := setupRouter()
+	router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+	router.GET("/me/preferences", handler.GetPreferences)
+	router.PATCH("/me/preferences", handler.UpdatePreferences)
+
+	t.Run("Get Success", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().GetPreferences(mock.Anything, testUserID).Return(models.DefaultUserPreferences(testUserID), nil).Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/me/preferences", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"currency":"IDR"`)
+		assert.Contains(t, w.Body.String(), `"month_start_day":1`)
+	})
+
+	t.Run("Update Success - Zero First Day Of Week Is Accepted", func(t *testing.T) {
+		// 1. Setup
+		updated := models.DefaultUserPreferences(testUserID)
+		updated.FirstDayOfWeek = 0
+		updated.MonthStartDay = 25
+		mockService.EXPECT().
+			UpdatePreferences(mock.Anything, testUserID, mock.MatchedBy(func(req models.UpdatePreferencesRequest) bool {
+				return req.FirstDayOfWeek != nil && *req.FirstDayOfWeek == 0 &&
+					req.MonthStartDay != nil && *req.MonthStartDay == 25 &&
+					req.Currency == nil
+			})).
+			Return(updated, nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/me/preferences", bytes.NewBufferString(`{"first_day_of_week": 0, "month_start_day": 25}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"month_start_day":25`)
+	})
+
+	t.Run("Update Validation Errors", func(t *testing.T) {
+		for _, body := range []string{
+			`{"currency": "XYZ"}`,
+			`{"timezone": "Mars/Olympus"}`,
+			`{"timezone": "Local"}`,
+			`{"locale": "fr"}`,
+			`{"first_day_of_week": 7}`,
+			`{"month_start_day": 31}`,
+		} {
+			// 2. Act
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPatch, "/me/preferences", bytes.NewBufferString(body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			// 3. Assert
+			assert.Equal(t, http.StatusBadRequest, w.Code, body)
+		}
+	})
+}
@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Udean777/uang-bijak-go/internal/service"
+	serviceMocks "github.com/Udean777/uang-bijak-go/internal/service/mocks"
+)
+
+func TestVerificationHandler_VerifyEmail(t *testing.T) {
+	mockService := serviceMocks.NewMockEmailVerificationService(t)
+	handler := NewVerificationHandler(mockService)
+
+	router := setupRouter()
+	router.POST("/auth/verify-email", handler.VerifyEmail)
+
+	t.Run("Success", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().VerifyEmail(mock.Anything, "verify-token").Return(nil).Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/auth/verify-email", bytes.NewBufferString(`{"token": "verify-token"}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Invalid Token", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().VerifyEmail(mock.Anything, "used").Return(service.ErrInvalidVerificationToken).Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/auth/verify-email", bytes.NewBufferString(`{"token": "used"}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestVerificationHandler_ResendVerification(t *testing.T) {
+	mockService := serviceMocks.NewMockEmailVerificationService(t)
+	handler := NewVerificationHandler(mockService)
+	testUserID := uuid.New()
+
+	router := setupRouter()
+	router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+	router.POST("/auth/resend-verification", handler.ResendVerification)
+
+	t.Run("Success", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().ResendVerification(mock.Anything, testUserID).Return(nil).Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/auth/resend-verification", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Cooldown", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().ResendVerification(mock.Anything, testUserID).Return(service.ErrVerificationCooldown).Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/auth/resend-verification", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	})
+
+	t.Run("Already Verified", func(t *testing.T) {
+		// 1. Setup
+		mockService.EXPECT().ResendVerification(mock.Anything, testUserID).Return(service.ErrEmailAlreadyVerified).Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/auth/resend-verification", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
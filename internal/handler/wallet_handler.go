@@ -104,13 +104,54 @@ func (h *WalletHandler) DeleteWallet(c *gin.Context) {
 		return
 	}
 
-	err = h.walletService.DeleteWallet(c.Request.Context(), walletID, userID)
+	var query models.DeleteWalletQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters"})
+		return
+	}
+	if query.Archive && query.ReassignTo != 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Use either reassign_to or archive, not both"})
+		return
+	}
+
+	switch {
+	case query.Archive:
+		err = h.walletService.ArchiveWallet(c.Request.Context(), walletID, userID)
+	case query.ReassignTo != 0:
+		err = h.walletService.ReassignAndDeleteWallet(c.Request.Context(), walletID, query.ReassignTo, userID)
+	default:
+		err = h.walletService.DeleteWallet(c.Request.Context(), walletID, userID)
+	}
+
 	if err != nil {
-		if errors.Is(err, service.ErrForbidden) {
+		var inUse *service.WalletInUseError
+		switch {
+		case errors.Is(err, service.ErrForbidden):
 			c.JSON(http.StatusForbidden, gin.H{"error": "You are not allowed to delete this wallet"})
-			return
+		case errors.As(err, &inUse):
+			c.JSON(http.StatusConflict, gin.H{
+				"error":             "Wallet still has transactions, recurring transactions or bills, use reassign_to or archive",
+				"transaction_count": inUse.TransactionCount,
+				"transfer_count":    inUse.TransferCount,
+				"recurring_count":   inUse.RecurringCount,
+				"bill_count":        inUse.BillCount,
+			})
+		case errors.Is(err, service.ErrWalletHasBalance):
+			c.JSON(http.StatusConflict, gin.H{"error": "Wallet still has a balance, use reassign_to to move it"})
+		case errors.Is(err, service.ErrConflict):
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		case errors.Is(err, service.ErrSameWallet):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case errors.Is(err, service.ErrWalletArchived):
+			c.JSON(http.StatusConflict, gin.H{"error": "Target wallet is archived"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not delete wallet"})
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not delete wallet"})
+		return
+	}
+
+	if query.Archive {
+		c.JSON(http.StatusOK, gin.H{"message": "Wallet archived successfully"})
 		return
 	}
 
@@ -142,3 +142,99 @@ func TestWalletHandler_UpdateWallet(t *testing.T) {
 		assert.Contains(t, w.Body.String(), "not allowed")
 	})
 }
+
+func TestWalletHandler_DeleteWallet(t *testing.T) {
+	mockService := mocks.NewMockWalletService(t)
+	handler := NewWalletHandler(mockService)
+	testUserID := uuid.New()
+
+	newRouter := func() *gin.Engine {
+		router := setupRouter()
+		router.Use(func(c *gin.Context) { setAuthContext(c, testUserID) })
+		router.DELETE("/wallets/:id", handler.DeleteWallet)
+		return router
+	}
+
+	t.Run("Success - Plain Delete", func(t *testing.T) {
+		// 1. Setup
+		router := newRouter()
+		mockService.EXPECT().
+			DeleteWallet(mock.Anything, int64(1), testUserID).
+			Return(nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/wallets/1", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Conflict - Still Has Transactions", func(t *testing.T) {
+		// 1. Setup
+		router := newRouter()
+		mockService.EXPECT().
+			DeleteWallet(mock.Anything, int64(2), testUserID).
+			Return(&service.WalletInUseError{TransactionCount: 5}).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/wallets/2", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), `"transaction_count":5`)
+	})
+
+	t.Run("Success - Reassign", func(t *testing.T) {
+		// 1. Setup
+		router := newRouter()
+		mockService.EXPECT().
+			ReassignAndDeleteWallet(mock.Anything, int64(2), int64(3), testUserID).
+			Return(nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/wallets/2?reassign_to=3", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Success - Archive", func(t *testing.T) {
+		// 1. Setup
+		router := newRouter()
+		mockService.EXPECT().
+			ArchiveWallet(mock.Anything, int64(2), testUserID).
+			Return(nil).
+			Once()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/wallets/2?archive=true", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "archived")
+	})
+
+	t.Run("Bad Request - Both Options", func(t *testing.T) {
+		// 1. Setup
+		router := newRouter()
+
+		// 2. Act
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/wallets/2?archive=true&reassign_to=3", nil)
+		router.ServeHTTP(w, req)
+
+		// 3. Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,184 @@
+package jwtkeys
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	AlgHS256 = "HS256"
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+
+	// minRSABits adalah ukuran kunci RSA terkecil yang diterima
+	minRSABits = 2048
+)
+
+var (
+	ErrUnknownKey        = errors.New("unknown signing key")
+	ErrAlgorithmMismatch = errors.New("token algorithm does not match key")
+	ErrKeyExpired        = errors.New("signing key is no longer accepted")
+)
+
+// Key adalah satu kunci di KeySet. Kunci tanpa signingKey hanya dipakai untuk verifikasi.
+// notAfter yang diisi membuat token dari kunci ini ditolak mulai waktu tersebut.
+type Key struct {
+	ID         string
+	Algorithm  string
+	signingKey interface{}
+	verifyKey  interface{}
+	notAfter   time.Time
+}
+
+// LegacyHMAC mengaktifkan verifikasi token HS256 lama (tanpa kid) selama migrasi ke kunci
+// asimetris. Zero value berarti token HS256 ditolak. Until yang diisi adalah batas akhir
+// masa transisi; zero berarti tanpa batas.
+type LegacyHMAC struct {
+	Secret string
+	Until  time.Time
+}
+
+// KeySet menandatangani token dengan satu kunci aktif dan memverifikasi token dari semua
+// kunci yang dikenal, dipilih berdasarkan header "kid". Rotasi dilakukan bertahap:
+//  1. tambahkan kunci baru ke set (sudah dipublikasikan di JWKS, belum dipakai menandatangani)
+//  2. setelah cache JWKS di service lain diperbarui, jadikan kunci baru sebagai kunci aktif
+//  3. setelah masa berlaku access token terlama habis, buang kunci lama
+//
+// Kunci HMAC hanya untuk verifikasi lokal dan tidak pernah dipublikasikan di JWKS.
+type KeySet struct {
+	active *Key
+	keys   map[string]*Key
+	now    func() time.Time
+}
+
+// NewHMACKeySet membuat KeySet HS256 dari satu secret. Token yang diterbitkan tidak memakai
+// header kid, sama seperti sebelum dukungan key set.
+func NewHMACKeySet(secret string) *KeySet {
+	key := hmacKey(secret)
+	return &KeySet{active: key, keys: map[string]*Key{key.ID: key}, now: time.Now}
+}
+
+// LoadDir memuat kunci PEM dari dir; nama file tanpa ekstensi .pem menjadi kid. File private
+// key (PKCS#8 atau PKCS#1 untuk RSA) bisa dipakai menandatangani, file public key (PKIX)
+// hanya untuk verifikasi kunci yang sudah pensiun. activeKeyID harus berupa private key.
+// Token HS256 lama tanpa kid hanya diterima jika legacy.Secret diisi, dan hanya sampai legacy.Until.
+func LoadDir(dir, activeKeyID string, legacy LegacyHMAC) (*KeySet, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	set := &KeySet{keys: make(map[string]*Key), now: time.Now}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		kid := strings.TrimSuffix(filepath.Base(path), ".pem")
+		if kid == "" {
+			// kid kosong adalah milik kunci HMAC lama; kunci asimetris tidak boleh memakainya
+			return nil, fmt.Errorf("%s: key file name must not be empty", path)
+		}
+		key, err := ParseKeyPEM(kid, data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		set.keys[kid] = key
+	}
+
+	active, ok := set.keys[activeKeyID]
+	if !ok {
+		return nil, fmt.Errorf("active key %q not found in %s", activeKeyID, dir)
+	}
+	if active.signingKey == nil {
+		return nil, fmt.Errorf("active key %q is not a private key", activeKeyID)
+	}
+	set.active = active
+
+	if legacy.Secret != "" {
+		key := hmacKey(legacy.Secret)
+		key.notAfter = legacy.Until
+		set.keys[key.ID] = key
+	}
+	return set, nil
+}
+
+// hmacKey memakai kid kosong karena token HS256 diterbitkan tanpa header kid.
+func hmacKey(secret string) *Key {
+	return &Key{ID: "", Algorithm: AlgHS256, signingKey: []byte(secret), verifyKey: []byte(secret)}
+}
+
+// Sign menandatangani claims dengan kunci aktif.
+func (s *KeySet) Sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(s.active.Algorithm), claims)
+	if s.active.ID != "" {
+		token.Header["kid"] = s.active.ID
+	}
+	return token.SignedString(s.active.signingKey)
+}
+
+// Keyfunc dipakai jwt.Parse: memilih kunci verifikasi dari header kid dan menolak token yang
+// algoritmanya tidak sesuai dengan kunci tersebut (mencegah algorithm confusion).
+func (s *KeySet) Keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	if token.Method.Alg() != key.Algorithm {
+		return nil, ErrAlgorithmMismatch
+	}
+	if !key.notAfter.IsZero() && !s.now().Before(key.notAfter) {
+		return nil, ErrKeyExpired
+	}
+	return key.verifyKey, nil
+}
+
+// ValidMethods adalah algoritma yang dipakai kunci-kunci di set, untuk jwt.WithValidMethods.
+func (s *KeySet) ValidMethods() []string {
+	seen := make(map[string]bool)
+	var methods []string
+	for _, key := range s.keys {
+		if !seen[key.Algorithm] {
+			seen[key.Algorithm] = true
+			methods = append(methods, key.Algorithm)
+		}
+	}
+	sort.Strings(methods)
+	return methods
+}
+
+// ActiveKeyID adalah kid kunci yang sedang dipakai menandatangani (kosong untuk HS256).
+func (s *KeySet) ActiveKeyID() string {
+	return s.active.ID
+}
+
+func newRSAKey(kid string, private *rsa.PrivateKey, public *rsa.PublicKey) (*Key, error) {
+	if public.N.BitLen() < minRSABits {
+		return nil, fmt.Errorf("RSA key must be at least %d bits", minRSABits)
+	}
+	key := &Key{ID: kid, Algorithm: AlgRS256, verifyKey: public}
+	if private != nil {
+		key.signingKey = private
+	}
+	return key, nil
+}
+
+func newEd25519Key(kid string, private ed25519.PrivateKey, public ed25519.PublicKey) *Key {
+	key := &Key{ID: kid, Algorithm: AlgEdDSA, verifyKey: public}
+	if private != nil {
+		key.signingKey = private
+	}
+	return key
+}
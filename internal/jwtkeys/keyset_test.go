@@ -0,0 +1,215 @@
+package jwtkeys
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func writePrivateKey(t *testing.T, dir, kid string, key interface{}) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	assert.NoError(t, err)
+	data := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, kid+".pem"), data, 0o600))
+}
+
+func writePublicKey(t *testing.T, dir, kid string, key interface{}) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	assert.NoError(t, err)
+	data := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, kid+".pem"), data, 0o644))
+}
+
+func parse(set *KeySet, token string) (*jwt.Token, error) {
+	return jwt.Parse(token, set.Keyfunc, jwt.WithValidMethods(set.ValidMethods()))
+}
+
+func testClaims() jwt.MapClaims {
+	return jwt.MapClaims{"sub": "user", "exp": time.Now().Add(time.Minute).Unix()}
+}
+
+func TestKeySet_RotationOverlap(t *testing.T) {
+	dir := t.TempDir()
+	oldKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	_, newKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	writePrivateKey(t, dir, "2025-01", oldKey)
+	writePrivateKey(t, dir, "2025-02", newKey)
+
+	// Sebelum rotasi: kunci lama aktif, kunci baru sudah dikenal
+	before, err := LoadDir(dir, "2025-01", LegacyHMAC{})
+	assert.NoError(t, err)
+	oldToken, err := before.Sign(testClaims())
+	assert.NoError(t, err)
+
+	parsed, err := parse(before, oldToken)
+	assert.NoError(t, err)
+	assert.Equal(t, "2025-01", parsed.Header["kid"])
+	assert.Equal(t, AlgRS256, parsed.Method.Alg())
+
+	// Setelah rotasi: token baru memakai EdDSA, token lama masih valid
+	after, err := LoadDir(dir, "2025-02", LegacyHMAC{})
+	assert.NoError(t, err)
+	newToken, err := after.Sign(testClaims())
+	assert.NoError(t, err)
+
+	parsed, err = parse(after, newToken)
+	assert.NoError(t, err)
+	assert.Equal(t, AlgEdDSA, parsed.Method.Alg())
+	_, err = parse(after, oldToken)
+	assert.NoError(t, err)
+
+	// Kunci lama dipensiunkan menjadi public key saja: tetap bisa memverifikasi
+	assert.NoError(t, os.Remove(filepath.Join(dir, "2025-01.pem")))
+	writePublicKey(t, dir, "2025-01", &oldKey.PublicKey)
+	retired, err := LoadDir(dir, "2025-02", LegacyHMAC{})
+	assert.NoError(t, err)
+	_, err = parse(retired, oldToken)
+	assert.NoError(t, err)
+
+	// Public key tidak bisa menjadi kunci aktif
+	_, err = LoadDir(dir, "2025-01", LegacyHMAC{})
+	assert.Error(t, err)
+
+	// Setelah kunci lama dibuang, tokennya ditolak
+	assert.NoError(t, os.Remove(filepath.Join(dir, "2025-01.pem")))
+	final, err := LoadDir(dir, "2025-02", LegacyHMAC{})
+	assert.NoError(t, err)
+	_, err = parse(final, oldToken)
+	assert.Error(t, err)
+}
+
+func TestKeySet_RejectsAlgorithmConfusion(t *testing.T) {
+	dir := t.TempDir()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	writePrivateKey(t, dir, "main", rsaKey)
+
+	set, err := LoadDir(dir, "main", LegacyHMAC{})
+	assert.NoError(t, err)
+
+	// Penyerang menandatangani HS256 memakai public key (yang dipublikasikan) sebagai secret
+	publicDER, _ := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	forged := jwt.NewWithClaims(jwt.SigningMethodHS256, testClaims())
+	forged.Header["kid"] = "main"
+	forgedToken, err := forged.SignedString(publicDER)
+	assert.NoError(t, err)
+
+	_, err = parse(set, forgedToken)
+	assert.Error(t, err)
+
+	// Tanpa legacy secret, token HS256 tanpa kid juga ditolak
+	legacy, _ := NewHMACKeySet("secret").Sign(testClaims())
+	_, err = parse(set, legacy)
+	assert.Error(t, err)
+}
+
+func TestKeySet_LegacyHMACDuringMigration(t *testing.T) {
+	dir := t.TempDir()
+	_, edKey, _ := ed25519.GenerateKey(rand.Reader)
+	writePrivateKey(t, dir, "ed-1", edKey)
+
+	legacyToken, err := NewHMACKeySet("old-secret").Sign(testClaims())
+	assert.NoError(t, err)
+
+	set, err := LoadDir(dir, "ed-1", LegacyHMAC{Secret: "old-secret"})
+	assert.NoError(t, err)
+
+	_, err = parse(set, legacyToken)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{AlgEdDSA, AlgHS256}, set.ValidMethods())
+
+	// Secret HMAC tidak pernah muncul di JWKS
+	jwks := set.JWKS()
+	assert.Len(t, jwks.Keys, 1)
+	assert.Equal(t, "ed-1", jwks.Keys[0].KeyID)
+}
+
+func TestKeySet_JWKS(t *testing.T) {
+	dir := t.TempDir()
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	edPublic, edKey, _ := ed25519.GenerateKey(rand.Reader)
+	writePrivateKey(t, dir, "a-rsa", rsaKey)
+	writePrivateKey(t, dir, "b-ed", edKey)
+
+	set, err := LoadDir(dir, "a-rsa", LegacyHMAC{})
+	assert.NoError(t, err)
+
+	jwks := set.JWKS()
+	assert.Len(t, jwks.Keys, 2)
+
+	rsaJWK := jwks.Keys[0]
+	assert.Equal(t, "RSA", rsaJWK.KeyType)
+	assert.Equal(t, AlgRS256, rsaJWK.Algorithm)
+	assert.Equal(t, "sig", rsaJWK.Use)
+	n, _ := base64.RawURLEncoding.DecodeString(rsaJWK.N)
+	e, _ := base64.RawURLEncoding.DecodeString(rsaJWK.E)
+	assert.Equal(t, rsaKey.N, new(big.Int).SetBytes(n))
+	assert.Equal(t, int64(rsaKey.E), new(big.Int).SetBytes(e).Int64())
+
+	edJWK := jwks.Keys[1]
+	assert.Equal(t, "OKP", edJWK.KeyType)
+	assert.Equal(t, "Ed25519", edJWK.Curve)
+	x, _ := base64.RawURLEncoding.DecodeString(edJWK.X)
+	assert.Equal(t, []byte(edPublic), x)
+}
+
+func TestLoadDir_RejectsWeakRSAKey(t *testing.T) {
+	dir := t.TempDir()
+	weak, _ := rsa.GenerateKey(rand.Reader, 1024)
+	writePrivateKey(t, dir, "weak", weak)
+
+	_, err := LoadDir(dir, "weak", LegacyHMAC{})
+	assert.Error(t, err)
+}
+
+func TestLoadDir_RejectsEmptyKeyID(t *testing.T) {
+	dir := t.TempDir()
+	_, edKey, _ := ed25519.GenerateKey(rand.Reader)
+	writePrivateKey(t, dir, "ed-1", edKey)
+	// ".pem" akan menjadi kid kosong, yang sama dengan kid kunci HMAC lama
+	writePrivateKey(t, dir, "", edKey)
+
+	_, err := LoadDir(dir, "ed-1", LegacyHMAC{Secret: "old-secret"})
+	assert.Error(t, err)
+}
+
+func TestKeySet_LegacyHMACCutOff(t *testing.T) {
+	dir := t.TempDir()
+	_, edKey, _ := ed25519.GenerateKey(rand.Reader)
+	writePrivateKey(t, dir, "ed-1", edKey)
+
+	cutOff := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	set, err := LoadDir(dir, "ed-1", LegacyHMAC{Secret: "old-secret", Until: cutOff})
+	assert.NoError(t, err)
+
+	legacyToken, err := NewHMACKeySet("old-secret").Sign(testClaims())
+	assert.NoError(t, err)
+
+	// Sebelum batas akhir: token HS256 lama masih diterima
+	set.now = func() time.Time { return cutOff.Add(-time.Minute) }
+	_, err = parse(set, legacyToken)
+	assert.NoError(t, err)
+
+	// Mulai batas akhir: ditolak, sedangkan token kunci aktif tetap valid
+	set.now = func() time.Time { return cutOff }
+	_, err = parse(set, legacyToken)
+	assert.ErrorIs(t, err, ErrKeyExpired)
+
+	current, err := set.Sign(testClaims())
+	assert.NoError(t, err)
+	_, err = parse(set, current)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,59 @@
+package jwtkeys
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+// ParseKeyPEM membaca private key RSA/Ed25519 (PKCS#8, atau PKCS#1 untuk RSA) maupun public
+// key PKIX menjadi Key dengan kid yang diberikan.
+func ParseKeyPEM(kid string, data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		private, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return newRSAKey(kid, private, &private.PublicKey)
+
+	case "PRIVATE KEY":
+		parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		switch private := parsed.(type) {
+		case *rsa.PrivateKey:
+			return newRSAKey(kid, private, &private.PublicKey)
+		case ed25519.PrivateKey:
+			return newEd25519Key(kid, private, private.Public().(ed25519.PublicKey)), nil
+		default:
+			return nil, fmt.Errorf("unsupported private key type %T", parsed)
+		}
+
+	case "PUBLIC KEY":
+		parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		switch public := parsed.(type) {
+		case *rsa.PublicKey:
+			return newRSAKey(kid, nil, public)
+		case ed25519.PublicKey:
+			return newEd25519Key(kid, nil, public), nil
+		default:
+			return nil, fmt.Errorf("unsupported public key type %T", parsed)
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+}
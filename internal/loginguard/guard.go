@@ -0,0 +1,123 @@
+package loginguard
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+// maxBackoffShift membatasi eksponen backoff agar perkalian durasi tidak overflow.
+const maxBackoffShift = 20
+
+// Policy mengatur kapan backoff mulai berlaku. Setelah AccountAttempts kegagalan berturut-turut
+// untuk satu email (atau IPAttempts untuk satu IP), percobaan berikutnya ditolak selama
+// BaseDelay, lalu dua kali lipat setiap kegagalan tambahan sampai MaxDelay. Hitungan dilupakan
+// jika tidak ada kegagalan baru selama Window.
+type Policy struct {
+	AccountAttempts int
+	IPAttempts      int
+	BaseDelay       time.Duration
+	MaxDelay        time.Duration
+	Window          time.Duration
+}
+
+// Guard melacak login gagal per akun dan per IP. Akun diidentifikasi dari email yang diketik,
+// bukan dari user di database, sehingga email yang tidak terdaftar diperlakukan sama persis
+// dan respons tidak membocorkan apakah email tersebut ada.
+type Guard struct {
+	store  Store
+	policy Policy
+	now    func() time.Time
+}
+
+func New(store Store, policy Policy) *Guard {
+	return &Guard{store: store, policy: policy, now: time.Now}
+}
+
+type guardKey struct {
+	key      string
+	attempts int
+}
+
+// Check mengembalikan sisa waktu tunggu sebelum login untuk pasangan email dan IP ini boleh
+// dicoba lagi; nol berarti boleh.
+func (g *Guard) Check(ctx context.Context, email, ip string) (time.Duration, error) {
+	now := g.now()
+
+	var wait time.Duration
+	for _, k := range g.keys(email, ip) {
+		rec, err := g.store.Get(ctx, k.key)
+		if err != nil {
+			return 0, err
+		}
+		if d := g.remaining(rec, k.attempts, now); d > wait {
+			wait = d
+		}
+	}
+	return wait, nil
+}
+
+// RecordFailure mencatat satu kegagalan untuk akun dan IP.
+func (g *Guard) RecordFailure(ctx context.Context, email, ip string) error {
+	now := g.now()
+	for _, k := range g.keys(email, ip) {
+		if _, err := g.store.Increment(ctx, k.key, now, now.Add(-g.policy.Window)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RecordSuccess mereset hitungan akun. Hitungan IP sengaja tidak direset: penyerang yang
+// punya akun sendiri tidak boleh bisa menghapus jejak percobaannya dengan login ke akun itu.
+func (g *Guard) RecordSuccess(ctx context.Context, email string) error {
+	return g.store.Reset(ctx, accountKey(email))
+}
+
+// Prune membuang hitungan yang sudah melewati Window; dijalankan oleh worker berkala.
+func (g *Guard) Prune(ctx context.Context, now time.Time) (int, error) {
+	return g.store.DeleteBefore(ctx, now.Add(-g.policy.Window))
+}
+
+func (g *Guard) keys(email, ip string) []guardKey {
+	keys := []guardKey{{key: accountKey(email), attempts: g.policy.AccountAttempts}}
+	if ip != "" {
+		keys = append(keys, guardKey{key: hashKey("ip:" + ip), attempts: g.policy.IPAttempts})
+	}
+	return keys
+}
+
+// remaining menghitung sisa lockout dari record: nol selama kegagalan masih di bawah batas.
+func (g *Guard) remaining(rec Record, attempts int, now time.Time) time.Duration {
+	if rec.Failures < attempts || now.Sub(rec.LastFailureAt) > g.policy.Window {
+		return 0
+	}
+
+	shift := rec.Failures - attempts
+	if shift > maxBackoffShift {
+		shift = maxBackoffShift
+	}
+	delay := g.policy.BaseDelay << shift
+	if delay > g.policy.MaxDelay {
+		delay = g.policy.MaxDelay
+	}
+
+	until := rec.LastFailureAt.Add(delay)
+	if !now.Before(until) {
+		return 0
+	}
+	return until.Sub(now)
+}
+
+// accountKey menyeragamkan email lalu meng-hash-nya agar tabel tidak menyimpan alamat email
+// (termasuk salah ketik) dalam bentuk teks.
+func accountKey(email string) string {
+	return hashKey("account:" + strings.ToLower(strings.TrimSpace(email)))
+}
+
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,125 @@
+package loginguard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testPolicy = Policy{
+	AccountAttempts: 3,
+	IPAttempts:      5,
+	BaseDelay:       30 * time.Second,
+	MaxDelay:        2 * time.Minute,
+	Window:          time.Hour,
+}
+
+func newTestGuard(now *time.Time) *Guard {
+	g := New(NewMemoryStore(), testPolicy)
+	g.now = func() time.Time { return *now }
+	return g
+}
+
+func TestGuard_ExponentialBackoff(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	g := newTestGuard(&now)
+
+	// Di bawah batas belum ada penundaan
+	for i := 0; i < testPolicy.AccountAttempts-1; i++ {
+		assert.NoError(t, g.RecordFailure(ctx, "user@example.com", ""))
+	}
+	wait, err := g.Check(ctx, "user@example.com", "")
+	assert.NoError(t, err)
+	assert.Zero(t, wait)
+
+	// Kegagalan ke-3, 4, 5: 30 detik, 1 menit, lalu dibatasi MaxDelay 2 menit
+	for _, expected := range []time.Duration{30 * time.Second, time.Minute, 2 * time.Minute, 2 * time.Minute} {
+		assert.NoError(t, g.RecordFailure(ctx, "user@example.com", ""))
+		wait, err := g.Check(ctx, "user@example.com", "")
+		assert.NoError(t, err)
+		assert.Equal(t, expected, wait)
+	}
+
+	// Sisa tunggu berkurang seiring waktu dan habis setelah masa lockout
+	now = now.Add(90 * time.Second)
+	wait, _ = g.Check(ctx, "user@example.com", "")
+	assert.Equal(t, 30*time.Second, wait)
+
+	now = now.Add(30 * time.Second)
+	wait, _ = g.Check(ctx, "user@example.com", "")
+	assert.Zero(t, wait)
+}
+
+func TestGuard_AccountKeyIsNormalised(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	g := newTestGuard(&now)
+
+	for i := 0; i < testPolicy.AccountAttempts; i++ {
+		g.RecordFailure(ctx, "User@Example.com", "")
+	}
+
+	wait, _ := g.Check(ctx, " user@example.com ", "")
+	assert.Equal(t, testPolicy.BaseDelay, wait)
+}
+
+func TestGuard_PerIPLimit(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	g := newTestGuard(&now)
+
+	// Menyebar percobaan ke banyak email dari satu IP tetap terkena batas IP
+	emails := []string{"a@example.com", "b@example.com", "c@example.com", "d@example.com", "e@example.com"}
+	for _, email := range emails {
+		assert.NoError(t, g.RecordFailure(ctx, email, "203.0.113.7"))
+	}
+
+	wait, _ := g.Check(ctx, "fresh@example.com", "203.0.113.7")
+	assert.Equal(t, testPolicy.BaseDelay, wait)
+
+	wait, _ = g.Check(ctx, "fresh@example.com", "198.51.100.1")
+	assert.Zero(t, wait)
+}
+
+func TestGuard_SuccessResetsAccountOnly(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	g := newTestGuard(&now)
+
+	for i := 0; i < testPolicy.IPAttempts; i++ {
+		g.RecordFailure(ctx, "user@example.com", "203.0.113.7")
+	}
+	assert.NoError(t, g.RecordSuccess(ctx, "user@example.com"))
+
+	wait, _ := g.Check(ctx, "user@example.com", "")
+	assert.Zero(t, wait)
+
+	// Hitungan IP tidak ikut direset
+	wait, _ = g.Check(ctx, "user@example.com", "203.0.113.7")
+	assert.Equal(t, testPolicy.BaseDelay, wait)
+}
+
+func TestGuard_WindowForgetsOldFailures(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	g := newTestGuard(&now)
+
+	for i := 0; i < testPolicy.AccountAttempts-1; i++ {
+		g.RecordFailure(ctx, "user@example.com", "")
+	}
+
+	// Kegagalan berikutnya setelah Window dihitung dari awal lagi
+	now = now.Add(testPolicy.Window + time.Minute)
+	g.RecordFailure(ctx, "user@example.com", "")
+	wait, _ := g.Check(ctx, "user@example.com", "")
+	assert.Zero(t, wait)
+
+	// Prune membuang catatan yang sudah kedaluwarsa
+	now = now.Add(testPolicy.Window + time.Minute)
+	deleted, err := g.Prune(ctx, now)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+}
@@ -0,0 +1,59 @@
+package loginguard
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type memoryStore struct {
+	mu      sync.Mutex
+	records map[string]Record
+}
+
+// NewMemoryStore menyimpan hitungan di memori proses. Hitungan hilang saat restart dan tidak
+// dibagi antar instance.
+func NewMemoryStore() Store {
+	return &memoryStore{records: make(map[string]Record)}
+}
+
+func (s *memoryStore) Get(ctx context.Context, key string) (Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.records[key], nil
+}
+
+func (s *memoryStore) Increment(ctx context.Context, key string, now, staleBefore time.Time) (Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec := s.records[key]
+	if rec.LastFailureAt.Before(staleBefore) {
+		rec.Failures = 0
+	}
+	rec.Failures++
+	rec.LastFailureAt = now
+	s.records[key] = rec
+	return rec, nil
+}
+
+func (s *memoryStore) Reset(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.records, key)
+	return nil
+}
+
+func (s *memoryStore) DeleteBefore(ctx context.Context, t time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	deleted := 0
+	for key, rec := range s.records {
+		if rec.LastFailureAt.Before(t) {
+			delete(s.records, key)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
@@ -0,0 +1,59 @@
+package loginguard
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type postgresStore struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresStore menyimpan hitungan di tabel login_attempts sehingga batas berlaku di
+// seluruh instance yang memakai database yang sama.
+func NewPostgresStore(db *pgxpool.Pool) Store {
+	return &postgresStore{db: db}
+}
+
+func (s *postgresStore) Get(ctx context.Context, key string) (Record, error) {
+	query := `SELECT failures, last_failure_at FROM login_attempts WHERE key = $1`
+
+	var rec Record
+	err := s.db.QueryRow(ctx, query, key).Scan(&rec.Failures, &rec.LastFailureAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Record{}, nil
+	}
+	return rec, err
+}
+
+// Increment memakai satu upsert agar request paralel tidak saling menimpa hitungan.
+func (s *postgresStore) Increment(ctx context.Context, key string, now, staleBefore time.Time) (Record, error) {
+	query := `INSERT INTO login_attempts (key, failures, last_failure_at)
+	          VALUES ($1, 1, $2)
+	          ON CONFLICT (key) DO UPDATE SET
+	              failures = CASE WHEN login_attempts.last_failure_at < $3 THEN 1
+	                              ELSE login_attempts.failures + 1 END,
+	              last_failure_at = EXCLUDED.last_failure_at
+	          RETURNING failures, last_failure_at`
+
+	var rec Record
+	err := s.db.QueryRow(ctx, query, key, now, staleBefore).Scan(&rec.Failures, &rec.LastFailureAt)
+	return rec, err
+}
+
+func (s *postgresStore) Reset(ctx context.Context, key string) error {
+	_, err := s.db.Exec(ctx, `DELETE FROM login_attempts WHERE key = $1`, key)
+	return err
+}
+
+func (s *postgresStore) DeleteBefore(ctx context.Context, t time.Time) (int, error) {
+	tag, err := s.db.Exec(ctx, `DELETE FROM login_attempts WHERE last_failure_at < $1`, t)
+	if err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), nil
+}
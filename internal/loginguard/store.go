@@ -0,0 +1,26 @@
+package loginguard
+
+import (
+	"context"
+	"time"
+)
+
+// Record adalah jumlah percobaan gagal berturut-turut untuk sebuah key beserta waktu gagal
+// terakhir. Record kosong (Failures == 0) berarti belum pernah gagal.
+type Record struct {
+	Failures      int
+	LastFailureAt time.Time
+}
+
+// Store menyimpan hitungan percobaan gagal. MemoryStore cukup untuk satu instance; untuk
+// beberapa instance di belakang load balancer gunakan PostgresStore agar hitungan dibagi.
+type Store interface {
+	// Get mengembalikan Record kosong jika key belum pernah gagal.
+	Get(ctx context.Context, key string) (Record, error)
+	// Increment menambah hitungan secara atomik. Hitungan dimulai ulang dari 1 jika kegagalan
+	// terakhir terjadi sebelum staleBefore.
+	Increment(ctx context.Context, key string, now, staleBefore time.Time) (Record, error)
+	Reset(ctx context.Context, key string) error
+	// DeleteBefore membuang record yang kegagalan terakhirnya sebelum t.
+	DeleteBefore(ctx context.Context, t time.Time) (int, error)
+}
@@ -0,0 +1,46 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+)
+
+type logMailer struct {
+	dir  string
+	from string
+	seq  atomic.Int64
+}
+
+// NewLogMailer tidak mengirim email sungguhan: setiap email dicatat ke log dan, jika dir
+// diisi, disimpan sebagai file .eml di dir. Cocok untuk development dan pengujian.
+func NewLogMailer(dir, from string) Mailer {
+	return &logMailer{dir: dir, from: from}
+}
+
+func (m *logMailer) Send(ctx context.Context, msg Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if m.dir == "" {
+		log.Printf("Email ke %s: %s\n%s", msg.To, msg.Subject, msg.Body)
+		return nil
+	}
+
+	if err := os.MkdirAll(m.dir, 0o755); err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%d-%d.eml", time.Now().UnixNano(), m.seq.Add(1))
+	path := filepath.Join(m.dir, name)
+	if err := os.WriteFile(path, buildMessage(m.from, msg), 0o644); err != nil {
+		return err
+	}
+
+	log.Printf("Email ke %s disimpan di %s", msg.To, path)
+	return nil
+}
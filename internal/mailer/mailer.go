@@ -0,0 +1,15 @@
+package mailer
+
+import "context"
+
+// Message adalah email teks biasa yang dikirim ke satu penerima.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer mengirim email transaksional (reset password, verifikasi, dll).
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
@@ -0,0 +1,46 @@
+package mailer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogMailer_Send(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Writes Message To Directory", func(t *testing.T) {
+		dir := t.TempDir()
+		m := NewLogMailer(dir, "noreply@uangbijak.test")
+
+		err := m.Send(ctx, Message{To: "user@example.com", Subject: "Reset password", Body: "Line 1\nLine 2"})
+		assert.NoError(t, err)
+
+		files, _ := filepath.Glob(filepath.Join(dir, "*.eml"))
+		assert.Len(t, files, 1)
+
+		content, _ := os.ReadFile(files[0])
+		assert.Contains(t, string(content), "From: noreply@uangbijak.test\r\n")
+		assert.Contains(t, string(content), "To: user@example.com\r\n")
+		assert.Contains(t, string(content), "Subject: Reset password\r\n")
+		assert.Contains(t, string(content), "Line 1\r\nLine 2")
+	})
+
+	t.Run("Log Only", func(t *testing.T) {
+		m := NewLogMailer("", "noreply@uangbijak.test")
+
+		err := m.Send(ctx, Message{To: "user@example.com", Subject: "Hi", Body: "Hello"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Cancelled Context", func(t *testing.T) {
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+
+		err := NewLogMailer(t.TempDir(), "noreply@uangbijak.test").Send(cancelled, Message{To: "user@example.com"})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
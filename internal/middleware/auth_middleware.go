@@ -1,15 +1,31 @@
 package middleware
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/golang-jwt/jwt/v5"
-	"github.com/google/uuid"
+
+	"github.com/Udean777/uang-bijak-go/internal/authtoken"
+	"github.com/Udean777/uang-bijak-go/internal/models"
 )
 
-func AuthMiddleware(jwtSecret string) gin.HandlerFunc {
+// TokenAuthenticator memvalidasi personal access token. Mengembalikan nil tanpa error jika
+// token tidak dikenal, sudah dicabut, atau kedaluwarsa.
+type TokenAuthenticator interface {
+	AuthenticateToken(ctx context.Context, token string) (*models.TokenPrincipal, error)
+}
+
+// AuthMiddleware memvalidasi access token lewat verifier (sama dengan yang dipakai
+// AuthService) dan memastikan sesinya belum dicabut. Status sesi di-cache selama
+// sessionCacheTTL. Bearer token berawalan models.PersonalAccessTokenPrefix divalidasi lewat
+// tokens; scope-nya disimpan di context untuk diperiksa RequireScope.
+func AuthMiddleware(verifier authtoken.Verifier, sessions SessionChecker, sessionCacheTTL time.Duration, tokens TokenAuthenticator) gin.HandlerFunc {
+	cache := newSessionCache(sessions, sessionCacheTTL)
+
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -25,41 +41,52 @@ func AuthMiddleware(jwtSecret string) gin.HandlerFunc {
 
 		tokenString := parts[1]
 
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, http.ErrAbortHandler
-			}
-			return []byte(jwtSecret), nil
-		})
+		if strings.HasPrefix(tokenString, models.PersonalAccessTokenPrefix) {
+			authenticatePersonalAccessToken(c, tokens, tokenString)
+			return
+		}
 
+		claims, err := verifier.Verify(tokenString, authtoken.TypeAccess)
+		if errors.Is(err, authtoken.ErrWrongTokenType) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token type, expected 'access' token"})
+			return
+		}
 		if err != nil {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
 			return
 		}
 
-		if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-			tokenType, ok := claims["token_type"].(string)
-			if !ok || tokenType != "access" {
-				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token type, expected 'access' token"})
-				return
-			}
-
-			userIDStr, ok := claims["sub"].(string)
-			if !ok {
-				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token claims (sub)"})
-				return
-			}
+		sessionID := claims.Session()
+		active, err := cache.IsSessionActive(c.Request.Context(), sessionID)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Could not verify session"})
+			return
+		}
+		if !active {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Session has been revoked"})
+			return
+		}
 
-			userID, err := uuid.Parse(userIDStr)
-			if err != nil {
-				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid user ID in token"})
-				return
-			}
+		c.Set("userID", claims.UserID())
+		c.Set("sessionID", sessionID)
+		c.Set("emailVerified", claims.EmailVerified)
+		c.Next()
+	}
+}
 
-			c.Set("userID", userID)
-			c.Next()
-		} else {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
-		}
+func authenticatePersonalAccessToken(c *gin.Context, tokens TokenAuthenticator, tokenString string) {
+	principal, err := tokens.AuthenticateToken(c.Request.Context(), tokenString)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Could not verify token"})
+		return
 	}
+	if principal == nil {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+		return
+	}
+
+	c.Set("userID", principal.UserID)
+	c.Set("emailVerified", principal.EmailVerified)
+	c.Set(tokenScopesKey, principal.Scopes)
+	c.Next()
 }
@@ -1,8 +1,18 @@
 package middleware
 
 import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -10,18 +20,54 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/Udean777/uang-bijak-go/internal/authtoken"
+	"github.com/Udean777/uang-bijak-go/internal/authtoken/authtokentest"
+	"github.com/Udean777/uang-bijak-go/internal/jwtkeys"
+	"github.com/Udean777/uang-bijak-go/internal/models"
 )
 
+// fakeSessionChecker menganggap sesi aktif kecuali terdaftar di revoked
+type fakeSessionChecker struct {
+	revoked map[uuid.UUID]bool
+	calls   int
+	err     error
+}
+
+func (f *fakeSessionChecker) IsSessionActive(ctx context.Context, sessionID uuid.UUID) (bool, error) {
+	f.calls++
+	if f.err != nil {
+		return false, f.err
+	}
+	return !f.revoked[sessionID], nil
+}
+
+// fakeTokenAuthenticator mengenali personal access token yang terdaftar di tokens
+type fakeTokenAuthenticator struct {
+	tokens map[string]*models.TokenPrincipal
+	err    error
+}
+
+func (f *fakeTokenAuthenticator) AuthenticateToken(ctx context.Context, token string) (*models.TokenPrincipal, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.tokens[token], nil
+}
+
+// newTestVerifier membuat verifier HS256 dengan issuer/audience milik suite token bersama
+func newTestVerifier(secret string) *authtoken.Manager {
+	return authtoken.NewManager(jwtkeys.NewHMACKeySet(secret), authtokentest.Issuer, authtokentest.Audience)
+}
+
 // Helper untuk membuat token
-func generateTestToken(t *testing.T, secret string, userID uuid.UUID, tokenType string, ttl time.Duration) string {
-	claims := jwt.MapClaims{
-		"sub":        userID.String(),
-		"iat":        time.Now().Unix(),
-		"exp":        time.Now().Add(ttl).Unix(),
-		"token_type": tokenType,
+func generateTestToken(t *testing.T, secret string, userID uuid.UUID, sessionID uuid.UUID, tokenType string, ttl time.Duration) string {
+	claims := authtoken.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: userID.String()},
+		SessionID:        sessionID.String(),
+		TokenType:        tokenType,
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	signedToken, err := token.SignedString([]byte(secret))
+	signedToken, err := newTestVerifier(secret).Issue(claims, ttl)
 	assert.NoError(t, err)
 	return signedToken
 }
@@ -29,10 +75,13 @@ func generateTestToken(t *testing.T, secret string, userID uuid.UUID, tokenType
 func TestAuthMiddleware(t *testing.T) {
 	testSecret := "my-secret-key"
 	testUserID := uuid.New()
+	testSessionID := uuid.New()
+	revokedSessionID := uuid.New()
+	checker := &fakeSessionChecker{revoked: map[uuid.UUID]bool{revokedSessionID: true}}
 
 	// Setup router dengan middleware
 	router := gin.Default()
-	router.Use(AuthMiddleware(testSecret))
+	router.Use(AuthMiddleware(newTestVerifier(testSecret), checker, time.Minute, &fakeTokenAuthenticator{}))
 	// Buat dummy handler yang hanya bisa diakses jika middleware lolos
 	router.GET("/protected", func(c *gin.Context) {
 		// Cek apakah userID di-set di context
@@ -44,7 +93,7 @@ func TestAuthMiddleware(t *testing.T) {
 	})
 
 	t.Run("Success - Valid Access Token", func(t *testing.T) {
-		token := generateTestToken(t, testSecret, testUserID, "access", time.Minute*15)
+		token := generateTestToken(t, testSecret, testUserID, testSessionID, "access", time.Minute*15)
 
 		w := httptest.NewRecorder()
 		req, _ := http.NewRequest("GET", "/protected", nil)
@@ -77,7 +126,7 @@ func TestAuthMiddleware(t *testing.T) {
 
 	t.Run("Fail - Expired Token", func(t *testing.T) {
 		// Buat token yang sudah kadaluarsa 1 jam lalu
-		token := generateTestToken(t, testSecret, testUserID, "access", -time.Hour)
+		token := generateTestToken(t, testSecret, testUserID, testSessionID, "access", -time.Hour)
 
 		w := httptest.NewRecorder()
 		req, _ := http.NewRequest("GET", "/protected", nil)
@@ -90,7 +139,7 @@ func TestAuthMiddleware(t *testing.T) {
 
 	t.Run("Fail - Wrong Token Type (Refresh Token)", func(t *testing.T) {
 		// Gunakan 'refresh' token untuk akses
-		token := generateTestToken(t, testSecret, testUserID, "refresh", time.Hour)
+		token := generateTestToken(t, testSecret, testUserID, testSessionID, "refresh", time.Hour)
 
 		w := httptest.NewRecorder()
 		req, _ := http.NewRequest("GET", "/protected", nil)
@@ -101,4 +150,173 @@ func TestAuthMiddleware(t *testing.T) {
 		assert.Equal(t, http.StatusUnauthorized, w.Code)
 		assert.Contains(t, w.Body.String(), "expected 'access' token")
 	})
+
+	t.Run("Fail - Revoked Session", func(t *testing.T) {
+		token := generateTestToken(t, testSecret, testUserID, revokedSessionID, "access", time.Minute*15)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/protected", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Contains(t, w.Body.String(), "Session has been revoked")
+	})
+
+	t.Run("Fail - Missing Session Claim", func(t *testing.T) {
+		claims := authtoken.Claims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: testUserID.String()},
+			TokenType:        authtoken.TypeAccess,
+		}
+		token, _ := newTestVerifier(testSecret).Issue(claims, time.Minute)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/protected", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestAuthMiddleware_SessionCheckError(t *testing.T) {
+	testSecret := "my-secret-key"
+	checker := &fakeSessionChecker{err: errors.New("db down")}
+
+	router := gin.Default()
+	router.Use(AuthMiddleware(newTestVerifier(testSecret), checker, time.Minute, &fakeTokenAuthenticator{}))
+	router.GET("/protected", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"message": "WELCOME_BACK"})
+	})
+
+	token := generateTestToken(t, testSecret, uuid.New(), uuid.New(), "access", time.Minute)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/protected", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestAuthMiddleware_PersonalAccessToken(t *testing.T) {
+	testUserID := uuid.New()
+	validToken := models.PersonalAccessTokenPrefix + "valid"
+	tokens := &fakeTokenAuthenticator{tokens: map[string]*models.TokenPrincipal{
+		validToken: {UserID: testUserID, TokenID: uuid.New(), Scopes: []string{"read"}, EmailVerified: true},
+	}}
+	checker := &fakeSessionChecker{}
+
+	router := gin.Default()
+	router.Use(AuthMiddleware(newTestVerifier("my-secret-key"), checker, time.Minute, tokens))
+	router.GET("/protected", func(c *gin.Context) {
+		assert.Equal(t, testUserID, c.MustGet("userID"))
+		assert.Equal(t, []string{"read"}, c.MustGet(tokenScopesKey))
+		assert.True(t, c.GetBool("emailVerified"))
+		_, hasSession := c.Get("sessionID")
+		assert.False(t, hasSession)
+		c.Status(http.StatusOK)
+	})
+
+	serve := func(token string) int {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/protected", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, serve(validToken))
+		// Token tidak melewati pengecekan sesi
+		assert.Zero(t, checker.calls)
+	})
+
+	t.Run("Fail - Unknown Or Revoked Token", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, serve(models.PersonalAccessTokenPrefix+"revoked"))
+	})
+
+	t.Run("Fail - Lookup Error", func(t *testing.T) {
+		tokens.err = errors.New("db down")
+		defer func() { tokens.err = nil }()
+		assert.Equal(t, http.StatusInternalServerError, serve(validToken))
+	})
+}
+
+func TestAuthMiddleware_AsymmetricKeys(t *testing.T) {
+	dir := t.TempDir()
+	_, edKey, _ := ed25519.GenerateKey(rand.Reader)
+	der, _ := x509.MarshalPKCS8PrivateKey(edKey)
+	os.WriteFile(filepath.Join(dir, "ed-1.pem"), pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600)
+
+	keys, err := jwtkeys.LoadDir(dir, "ed-1", jwtkeys.LegacyHMAC{Secret: "legacy-secret"})
+	assert.NoError(t, err)
+	verifier := authtoken.NewManager(keys, authtokentest.Issuer, authtokentest.Audience)
+
+	router := gin.Default()
+	router.Use(AuthMiddleware(verifier, &fakeSessionChecker{}, time.Minute, &fakeTokenAuthenticator{}))
+	router.GET("/protected", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	serve := func(token string) int {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/protected", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	claims := authtoken.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: uuid.NewString()},
+		SessionID:        uuid.NewString(),
+		TokenType:        authtoken.TypeAccess,
+	}
+
+	// Token EdDSA dengan kid dari key set
+	signed, err := verifier.Issue(claims, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, serve(signed))
+
+	// Token HS256 lama masih diterima selama legacy secret dikonfigurasi
+	legacy := generateTestToken(t, "legacy-secret", uuid.New(), uuid.New(), "access", time.Minute)
+	assert.Equal(t, http.StatusOK, serve(legacy))
+
+	// Token yang ditandatangani secret lain ditolak
+	forged := generateTestToken(t, "other-secret", uuid.New(), uuid.New(), "access", time.Minute)
+	assert.Equal(t, http.StatusUnauthorized, serve(forged))
+}
+
+// TestAuthMiddleware_SharedTokenSuite menjalankan suite token yang sama dengan AuthService.
+func TestAuthMiddleware_SharedTokenSuite(t *testing.T) {
+	router := gin.Default()
+	router.Use(AuthMiddleware(authtokentest.NewManager(), &fakeSessionChecker{}, time.Minute, &fakeTokenAuthenticator{}))
+	router.GET("/protected", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"user_id": c.MustGet("userID"), "session_id": c.MustGet("sessionID")})
+	})
+
+	authtokentest.Run(t, func(t *testing.T, token string, expectedType string) (*authtoken.Claims, error) {
+		if expectedType != authtoken.TypeAccess {
+			t.Skip("middleware hanya menerima access token")
+		}
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/protected", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		router.ServeHTTP(w, req)
+
+		if w.Code != http.StatusOK {
+			return nil, fmt.Errorf("status %d: %s", w.Code, w.Body.String())
+		}
+		var body struct {
+			UserID    string `json:"user_id"`
+			SessionID string `json:"session_id"`
+		}
+		json.Unmarshal(w.Body.Bytes(), &body)
+		return &authtoken.Claims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: body.UserID},
+			SessionID:        body.SessionID,
+		}, nil
+	})
 }
@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// UnverifiedPolicy menentukan apa yang boleh dilakukan user yang emailnya belum diverifikasi.
+type UnverifiedPolicy string
+
+const (
+	// UnverifiedAllow: tidak ada pembatasan
+	UnverifiedAllow UnverifiedPolicy = "allow"
+	// UnverifiedReadOnly: hanya request baca (GET/HEAD/OPTIONS)
+	UnverifiedReadOnly UnverifiedPolicy = "read_only"
+	// UnverifiedBlock: semua request API ditolak sampai email diverifikasi
+	UnverifiedBlock UnverifiedPolicy = "block"
+)
+
+// ParseUnverifiedPolicy memvalidasi nilai konfigurasi; string kosong berarti read_only.
+func ParseUnverifiedPolicy(value string) (UnverifiedPolicy, error) {
+	switch policy := UnverifiedPolicy(value); policy {
+	case "":
+		return UnverifiedReadOnly, nil
+	case UnverifiedAllow, UnverifiedReadOnly, UnverifiedBlock:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("unknown unverified user policy %q", value)
+	}
+}
+
+// RequireVerifiedEmail menerapkan policy untuk user yang belum verifikasi. Harus dipasang
+// setelah AuthMiddleware, yang mengisi "emailVerified" di context.
+func RequireVerifiedEmail(policy UnverifiedPolicy) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if policy == UnverifiedAllow || c.GetBool("emailVerified") {
+			c.Next()
+			return
+		}
+
+		if policy == UnverifiedReadOnly && isReadOnlyMethod(c.Request.Method) {
+			c.Next()
+			return
+		}
+
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Email address is not verified"})
+	}
+}
+
+func isReadOnlyMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseUnverifiedPolicy(t *testing.T) {
+	policy, err := ParseUnverifiedPolicy("")
+	assert.NoError(t, err)
+	assert.Equal(t, UnverifiedReadOnly, policy)
+
+	policy, err = ParseUnverifiedPolicy("block")
+	assert.NoError(t, err)
+	assert.Equal(t, UnverifiedBlock, policy)
+
+	_, err = ParseUnverifiedPolicy("whatever")
+	assert.Error(t, err)
+}
+
+func TestRequireVerifiedEmail(t *testing.T) {
+	newRouter := func(policy UnverifiedPolicy, verified bool) *gin.Engine {
+		router := gin.Default()
+		router.Use(func(c *gin.Context) { c.Set("emailVerified", verified) })
+		router.Use(RequireVerifiedEmail(policy))
+		router.GET("/wallets", func(c *gin.Context) { c.Status(http.StatusOK) })
+		router.POST("/wallets", func(c *gin.Context) { c.Status(http.StatusCreated) })
+		return router
+	}
+
+	serve := func(router *gin.Engine, method string) int {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(method, "/wallets", nil)
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	t.Run("Read Only - Unverified Can Read But Not Write", func(t *testing.T) {
+		router := newRouter(UnverifiedReadOnly, false)
+		assert.Equal(t, http.StatusOK, serve(router, http.MethodGet))
+		assert.Equal(t, http.StatusForbidden, serve(router, http.MethodPost))
+	})
+
+	t.Run("Read Only - Verified Can Write", func(t *testing.T) {
+		router := newRouter(UnverifiedReadOnly, true)
+		assert.Equal(t, http.StatusCreated, serve(router, http.MethodPost))
+	})
+
+	t.Run("Block - Unverified Cannot Read", func(t *testing.T) {
+		router := newRouter(UnverifiedBlock, false)
+		assert.Equal(t, http.StatusForbidden, serve(router, http.MethodGet))
+	})
+
+	t.Run("Allow - No Restriction", func(t *testing.T) {
+		router := newRouter(UnverifiedAllow, false)
+		assert.Equal(t, http.StatusCreated, serve(router, http.MethodPost))
+	})
+}
@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+)
+
+// tokenScopesKey diisi AuthMiddleware hanya untuk request yang memakai personal access token.
+// Request dengan JWT dari login interaktif tidak dibatasi scope.
+const tokenScopesKey = "tokenScopes"
+
+// RequireScope membatasi personal access token pada sebuah resource: request baca butuh scope
+// "read", selain itu butuh "<resource>:write". Dipasang setelah AuthMiddleware.
+func RequireScope(resource string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		value, ok := c.Get(tokenScopesKey)
+		if !ok {
+			c.Next()
+			return
+		}
+		scopes, _ := value.([]string)
+
+		required := resource + ":write"
+		if isReadOnlyMethod(c.Request.Method) {
+			required = models.ScopeRead
+		}
+
+		if !slices.Contains(scopes, required) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"error":          "Token does not have the required scope",
+				"required_scope": required,
+			})
+			return
+		}
+		c.Next()
+	}
+}
+
+// RejectPersonalAccessTokens menolak personal access token untuk pengelolaan akun (password,
+// 2FA, sesi, token) yang hanya boleh dilakukan dari login interaktif.
+func RejectPersonalAccessTokens() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, ok := c.Get(tokenScopesKey); ok {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "This endpoint requires an interactive login"})
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequireScope(t *testing.T) {
+	newRouter := func(scopes []string) *gin.Engine {
+		router := gin.Default()
+		if scopes != nil {
+			router.Use(func(c *gin.Context) { c.Set(tokenScopesKey, scopes) })
+		}
+		group := router.Group("/transactions", RequireScope("transactions"))
+		group.GET("", func(c *gin.Context) { c.Status(http.StatusOK) })
+		group.POST("", func(c *gin.Context) { c.Status(http.StatusCreated) })
+		return router
+	}
+
+	serve := func(router *gin.Engine, method string) int {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(method, "/transactions", nil)
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	// Login interaktif (tanpa scope di context) tidak dibatasi
+	router := newRouter(nil)
+	assert.Equal(t, http.StatusOK, serve(router, http.MethodGet))
+	assert.Equal(t, http.StatusCreated, serve(router, http.MethodPost))
+
+	router = newRouter([]string{"read"})
+	assert.Equal(t, http.StatusOK, serve(router, http.MethodGet))
+	assert.Equal(t, http.StatusForbidden, serve(router, http.MethodPost))
+
+	// Scope tulis tidak otomatis memberi akses baca
+	router = newRouter([]string{"transactions:write"})
+	assert.Equal(t, http.StatusForbidden, serve(router, http.MethodGet))
+	assert.Equal(t, http.StatusCreated, serve(router, http.MethodPost))
+
+	router = newRouter([]string{"read", "wallets:write"})
+	assert.Equal(t, http.StatusForbidden, serve(router, http.MethodPost))
+}
+
+func TestRejectPersonalAccessTokens(t *testing.T) {
+	router := gin.Default()
+	router.Use(func(c *gin.Context) {
+		if c.GetHeader("X-Test-PAT") != "" {
+			c.Set(tokenScopesKey, []string{"read"})
+		}
+	})
+	router.GET("/me/sessions", RejectPersonalAccessTokens(), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/me/sessions", nil)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest(http.MethodGet, "/me/sessions", nil)
+	req.Header.Set("X-Test-PAT", "1")
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SessionChecker memeriksa apakah sesi login masih aktif (belum dicabut).
+type SessionChecker interface {
+	IsSessionActive(ctx context.Context, sessionID uuid.UUID) (bool, error)
+}
+
+type sessionCacheEntry struct {
+	active    bool
+	expiresAt time.Time
+}
+
+// sessionCache menyimpan hasil SessionChecker selama ttl agar middleware tidak menanyakan
+// database di setiap request. Konsekuensinya, sesi yang dicabut baru ditolak paling lambat
+// setelah ttl berlalu.
+type sessionCache struct {
+	checker SessionChecker
+	ttl     time.Duration
+	now     func() time.Time
+
+	mu        sync.Mutex
+	entries   map[uuid.UUID]sessionCacheEntry
+	nextSweep time.Time
+}
+
+func newSessionCache(checker SessionChecker, ttl time.Duration) *sessionCache {
+	return &sessionCache{
+		checker: checker,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[uuid.UUID]sessionCacheEntry),
+	}
+}
+
+func (c *sessionCache) IsSessionActive(ctx context.Context, sessionID uuid.UUID) (bool, error) {
+	now := c.now()
+
+	c.mu.Lock()
+	entry, ok := c.entries[sessionID]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.active, nil
+	}
+
+	active, err := c.checker.IsSessionActive(ctx, sessionID)
+	if err != nil {
+		return false, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[sessionID] = sessionCacheEntry{active: active, expiresAt: now.Add(c.ttl)}
+
+	// Buang entri kedaluwarsa secara berkala supaya map tidak tumbuh tanpa batas
+	if now.After(c.nextSweep) {
+		for id, e := range c.entries {
+			if !now.Before(e.expiresAt) {
+				delete(c.entries, id)
+			}
+		}
+		c.nextSweep = now.Add(c.ttl)
+	}
+
+	return active, nil
+}
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionCache(t *testing.T) {
+	ctx := context.Background()
+	sessionID := uuid.New()
+
+	t.Run("Caches Result Until TTL Expires", func(t *testing.T) {
+		checker := &fakeSessionChecker{revoked: map[uuid.UUID]bool{}}
+		cache := newSessionCache(checker, time.Minute)
+		now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+		cache.now = func() time.Time { return now }
+
+		active, err := cache.IsSessionActive(ctx, sessionID)
+		assert.NoError(t, err)
+		assert.True(t, active)
+
+		// Sesi dicabut, tapi hasil lama masih dipakai selama TTL
+		checker.revoked[sessionID] = true
+		now = now.Add(30 * time.Second)
+		active, _ = cache.IsSessionActive(ctx, sessionID)
+		assert.True(t, active)
+		assert.Equal(t, 1, checker.calls)
+
+		// Setelah TTL lewat, status terbaru diambil ulang
+		now = now.Add(time.Minute)
+		active, _ = cache.IsSessionActive(ctx, sessionID)
+		assert.False(t, active)
+		assert.Equal(t, 2, checker.calls)
+	})
+
+	t.Run("Sweeps Expired Entries", func(t *testing.T) {
+		checker := &fakeSessionChecker{}
+		cache := newSessionCache(checker, time.Minute)
+		now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+		cache.now = func() time.Time { return now }
+
+		cache.IsSessionActive(ctx, uuid.New())
+		now = now.Add(2 * time.Minute)
+		cache.IsSessionActive(ctx, sessionID)
+
+		assert.Len(t, cache.entries, 1)
+	})
+}
@@ -0,0 +1,127 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultBillRemindDays adalah jumlah hari sebelum jatuh tempo pengingat dikirim
+	DefaultBillRemindDays = 3
+	// DefaultUpcomingBillDays adalah rentang default /bills/upcoming
+	DefaultUpcomingBillDays = 30
+)
+
+// Bill adalah tagihan (PLN, internet, kartu kredit) yang TIDAK dicatat otomatis: user harus
+// menandainya lunas. StartDate pada Schedule adalah jatuh tempo pertama; tagihan sekali
+// bayar memakai FrequencyOnce. PaidCount adalah jumlah jatuh tempo yang sudah dibayar dan
+// DueDate adalah jatuh tempo berikutnya yang belum dibayar (nil jika semuanya lunas).
+type Bill struct {
+	ID         int64     `json:"id"`
+	UserID     uuid.UUID `json:"-"`
+	WalletID   int64     `json:"wallet_id"`
+	CategoryID int64     `json:"category_id"`
+	Name       string    `json:"name"`
+	Amount     int64     `json:"amount"`
+	Schedule
+	RemindDaysBefore int        `json:"remind_days_before"`
+	PaidCount        int        `json:"paid_count"`
+	DueDate          *time.Time `json:"due_date"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+
+	// Data join
+	CategoryName string `json:"category_name,omitempty"`
+	WalletName   string `json:"wallet_name,omitempty"`
+}
+
+// NextDueDate mengembalikan jatuh tempo yang belum dibayar, atau nil jika semuanya lunas.
+func (b Bill) NextDueDate(loc *time.Location) *time.Time {
+	if !b.HasOccurrence(b.PaidCount, loc) {
+		return nil
+	}
+	due := b.Occurrence(b.PaidCount, loc)
+	return &due
+}
+
+// CreateBillRequest: Frequency kosong berarti sekali bayar; RemindDaysBefore nil berarti
+// DefaultBillRemindDays.
+type CreateBillRequest struct {
+	WalletID         int64      `json:"wallet_id" binding:"required,gt=0"`
+	CategoryID       int64      `json:"category_id" binding:"required,gt=0"`
+	Name             string     `json:"name" binding:"required,min=3,max=100"`
+	Amount           int64      `json:"amount" binding:"required,gt=0"`
+	Frequency        string     `json:"frequency" binding:"omitempty,oneof=once daily weekly monthly yearly"`
+	Interval         int        `json:"interval" binding:"omitempty,min=1,max=365"`
+	DueDate          time.Time  `json:"due_date" binding:"required"`
+	EndDate          *time.Time `json:"end_date" binding:"omitempty,excluded_with=Count"`
+	Count            *int       `json:"count" binding:"omitempty,min=1,max=1000"`
+	RemindDaysBefore *int       `json:"remind_days_before" binding:"omitempty,min=0,max=30"`
+}
+
+// UpdateBillRequest mengganti detail tagihan dan batas akhir jadwal. Frekuensi dan jatuh
+// tempo pertama tidak bisa diubah; hapus lalu buat tagihan baru. RemindDaysBefore nil berarti
+// pengingat tetap memakai nilai yang sekarang.
+type UpdateBillRequest struct {
+	WalletID         int64      `json:"wallet_id" binding:"required,gt=0"`
+	CategoryID       int64      `json:"category_id" binding:"required,gt=0"`
+	Name             string     `json:"name" binding:"required,min=3,max=100"`
+	Amount           int64      `json:"amount" binding:"required,gt=0"`
+	EndDate          *time.Time `json:"end_date" binding:"omitempty,excluded_with=Count"`
+	Count            *int       `json:"count" binding:"omitempty,min=1,max=1000"`
+	RemindDaysBefore *int       `json:"remind_days_before" binding:"omitempty,min=0,max=30"`
+}
+
+// PayBillRequest: semua field opsional. Default-nya dompet & nominal tagihan dan waktu sekarang,
+// karena tagihan seperti PLN atau kartu kredit sering berbeda nominal setiap bulan.
+type PayBillRequest struct {
+	WalletID *int64     `json:"wallet_id" binding:"omitempty,gt=0"`
+	Amount   *int64     `json:"amount" binding:"omitempty,gt=0"`
+	PaidAt   *time.Time `json:"paid_at"`
+}
+
+type BillPayment struct {
+	Bill        *Bill        `json:"bill"`
+	Transaction *Transaction `json:"transaction"`
+}
+
+// UpcomingBillsQuery: Days adalah rentang ke depan dari hari ini (default 30).
+type UpcomingBillsQuery struct {
+	Days int `form:"days" binding:"omitempty,min=1,max=90"`
+}
+
+// UpcomingBill adalah satu jatuh tempo yang belum dibayar. ProjectedBalance adalah saldo dompet
+// setelah tagihan ini dan tagihan sebelumnya (di dompet yang sama) dibayar.
+type UpcomingBill struct {
+	BillID           int64     `json:"bill_id"`
+	Name             string    `json:"name"`
+	WalletID         int64     `json:"wallet_id"`
+	WalletName       string    `json:"wallet_name"`
+	CategoryID       int64     `json:"category_id"`
+	CategoryName     string    `json:"category_name"`
+	Amount           int64     `json:"amount"`
+	DueDate          time.Time `json:"due_date"`
+	Overdue          bool      `json:"overdue"`
+	ProjectedBalance int64     `json:"projected_balance"`
+}
+
+// WalletBillCoverage membandingkan saldo dompet dengan total tagihan yang akan dibayar darinya.
+// Shortfall adalah kekurangan dana (0 jika saldo cukup).
+type WalletBillCoverage struct {
+	WalletID   int64  `json:"wallet_id"`
+	WalletName string `json:"wallet_name"`
+	Balance    int64  `json:"balance"`
+	TotalDue   int64  `json:"total_due"`
+	Shortfall  int64  `json:"shortfall"`
+}
+
+type UpcomingBillsReport struct {
+	StartDate      time.Time            `json:"start_date"`
+	EndDate        time.Time            `json:"end_date"`
+	TotalDue       int64                `json:"total_due"`
+	TotalBalance   int64                `json:"total_balance"`
+	TotalShortfall int64                `json:"total_shortfall"`
+	Bills          []UpcomingBill       `json:"bills"`
+	Wallets        []WalletBillCoverage `json:"wallets"`
+}
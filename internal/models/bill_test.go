@@ -0,0 +1,50 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBill_NextDueDate(t *testing.T) {
+	loc, _ := time.LoadLocation("Asia/Jakarta")
+	due := time.Date(2026, time.May, 20, 0, 0, 0, 0, loc)
+
+	t.Run("One-off Bill", func(t *testing.T) {
+		b := Bill{Schedule: Schedule{Frequency: FrequencyOnce, StartDate: due}}
+
+		assert.Equal(t, due, *b.NextDueDate(loc))
+
+		b.PaidCount = 1
+		assert.Nil(t, b.NextDueDate(loc))
+	})
+
+	t.Run("Monthly Bill Advances After Payment", func(t *testing.T) {
+		b := Bill{Schedule: Schedule{Frequency: FrequencyMonthly, StartDate: due}, PaidCount: 2}
+
+		assert.Equal(t, time.Date(2026, time.July, 20, 0, 0, 0, 0, loc), *b.NextDueDate(loc))
+	})
+}
+
+func TestNewBillReminderNotification(t *testing.T) {
+	loc, _ := time.LoadLocation("Asia/Jakarta")
+	b := Bill{
+		ID:        3,
+		Name:      "Listrik PLN",
+		Amount:    450000,
+		Schedule:  Schedule{Frequency: FrequencyMonthly, StartDate: time.Date(2026, time.January, 20, 0, 0, 0, 0, loc)},
+		PaidCount: 1,
+	}
+
+	n := NewBillReminderNotification(b, loc)
+
+	assert.Equal(t, NotificationBillReminder, n.Type)
+	assert.Equal(t, "bill:3:1", n.DedupKey)
+	assert.Equal(t, int64(3), *n.BillID)
+	assert.Contains(t, n.Message, "2026-02-20")
+
+	// Pemilik tagihan di zona UTC: 20 Feb 00:00 WIB masih 19 Feb
+	n = NewBillReminderNotification(b, time.UTC)
+	assert.Contains(t, n.Message, "2026-02-19")
+}
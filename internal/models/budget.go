@@ -0,0 +1,108 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxRolloverMonths membatasi berapa bulan ke belakang sisa anggaran ikut dihitung
+const MaxRolloverMonths = 12
+
+// DefaultAlertThresholds adalah persentase pemakaian anggaran yang memicu notifikasi
+var DefaultAlertThresholds = []int{80, 100}
+
+// Budget adalah batas pengeluaran sebuah kategori untuk satu bulan keuangan user: (Year, Month)
+// dimulai pada MonthStartDay di zona waktu preferensi user (lihat UserPreferences.MonthRange).
+// Jika Rollover aktif, sisa (atau kelebihan) bulan sebelumnya dibawa ke bulan ini.
+// AlertThresholds berisi persentase (terurut naik) yang memicu notifikasi saat terlampaui.
+type Budget struct {
+	ID              int64     `json:"id"`
+	UserID          uuid.UUID `json:"-"`
+	CategoryID      int64     `json:"category_id"`
+	CategoryName    string    `json:"category_name,omitempty"`
+	Year            int       `json:"year"`
+	Month           int       `json:"month"`
+	Amount          int64     `json:"amount"`
+	Rollover        bool      `json:"rollover"`
+	AlertThresholds []int     `json:"alert_thresholds"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+type CreateBudgetRequest struct {
+	CategoryID int64 `json:"category_id" binding:"required,gt=0"`
+	Year       int   `json:"year" binding:"required,min=2000,max=2100"`
+	Month      int   `json:"month" binding:"required,min=1,max=12"`
+	Amount     int64 `json:"amount" binding:"required,gt=0"`
+	Rollover   bool  `json:"rollover"`
+	// AlertThresholds nil berarti memakai DefaultAlertThresholds; [] mematikan notifikasi
+	AlertThresholds []int `json:"alert_thresholds" binding:"omitempty,max=5,dive,min=1,max=1000"`
+}
+
+// UpdateBudgetRequest: Rollover/AlertThresholds nil berarti pengaturannya tidak berubah.
+type UpdateBudgetRequest struct {
+	Amount          int64 `json:"amount" binding:"required,gt=0"`
+	Rollover        *bool `json:"rollover"`
+	AlertThresholds []int `json:"alert_thresholds" binding:"omitempty,max=5,dive,min=1,max=1000"`
+}
+
+// PeriodIndex mengubah (tahun, bulan) menjadi angka berurutan agar mudah mencari bulan sebelumnya.
+func PeriodIndex(year int, month int) int {
+	return year*12 + month - 1
+}
+
+// BudgetStatus membandingkan anggaran dengan pengeluaran aktual. Spent sudah termasuk
+// pengeluaran seluruh sub-kategori; Available = Budgeted + CarryOver; Remaining bernilai
+// negatif jika anggaran terlampaui.
+type BudgetStatus struct {
+	BudgetID     int64  `json:"budget_id"`
+	CategoryID   int64  `json:"category_id"`
+	CategoryName string `json:"category_name"`
+	Budgeted     int64  `json:"budgeted"`
+	CarryOver    int64  `json:"carry_over"`
+	Available    int64  `json:"available"`
+	Spent        int64  `json:"spent"`
+	Remaining    int64  `json:"remaining"`
+}
+
+// BudgetUsage adalah anggaran beserta total pengeluaran periodenya (termasuk sub-kategori)
+// dan sisa rollover yang dibawa ke periode itu, dipakai untuk mengecek ambang notifikasi.
+type BudgetUsage struct {
+	Budget
+	CarryOver int64
+	Spent     int64
+}
+
+// Available adalah dana anggaran periode ini termasuk rollover, sama dengan BudgetStatus.Available.
+func (u BudgetUsage) Available() int64 {
+	return u.Amount + u.CarryOver
+}
+
+// CrossedThreshold mengembalikan ambang tertinggi yang sudah tercapai (Spent >= ambang% x Available),
+// atau 0 jika belum ada.
+func (u BudgetUsage) CrossedThreshold() int {
+	if u.Spent <= 0 {
+		return 0
+	}
+
+	crossed := 0
+	for _, threshold := range u.AlertThresholds {
+		if u.Spent*100 >= int64(threshold)*u.Available() && threshold > crossed {
+			crossed = threshold
+		}
+	}
+	return crossed
+}
+
+type BudgetStatusReport struct {
+	Year           int            `json:"year"`
+	Month          int            `json:"month"`
+	StartDate      time.Time      `json:"start_date"`
+	EndDate        time.Time      `json:"end_date"`
+	TotalBudgeted  int64          `json:"total_budgeted"`
+	TotalCarryOver int64          `json:"total_carry_over"`
+	TotalSpent     int64          `json:"total_spent"`
+	TotalRemaining int64          `json:"total_remaining"`
+	Budgets        []BudgetStatus `json:"budgets"`
+}
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBudgetUsage_CrossedThreshold(t *testing.T) {
+	usage := BudgetUsage{Budget: Budget{Amount: 1000000, AlertThresholds: []int{80, 100}}}
+
+	usage.Spent = 799999
+	assert.Equal(t, 0, usage.CrossedThreshold())
+
+	usage.Spent = 800000
+	assert.Equal(t, 80, usage.CrossedThreshold())
+
+	// Melewati dua ambang sekaligus hanya melaporkan yang tertinggi
+	usage.Spent = 1200000
+	assert.Equal(t, 100, usage.CrossedThreshold())
+
+	// Ambang kosong berarti notifikasi dimatikan
+	usage.AlertThresholds = []int{}
+	assert.Equal(t, 0, usage.CrossedThreshold())
+}
+
+func TestBudgetUsage_CrossedThreshold_Rollover(t *testing.T) {
+	usage := BudgetUsage{Budget: Budget{Amount: 1000000, AlertThresholds: []int{80, 100}, Rollover: true}}
+
+	// Sisa 500.000 dari bulan lalu: 850.000 baru 56% dari 1.500.000
+	usage.CarryOver = 500000
+	usage.Spent = 850000
+	assert.Equal(t, 0, usage.CrossedThreshold())
+
+	// Kelebihan 500.000 bulan lalu: 400.000 sudah 80% dari 500.000
+	usage.CarryOver = -500000
+	usage.Spent = 400000
+	assert.Equal(t, 80, usage.CrossedThreshold())
+
+	// Dana habis terbawa kelebihan; belum ada pengeluaran berarti belum ada notifikasi
+	usage.CarryOver = -1000000
+	usage.Spent = 0
+	assert.Equal(t, 0, usage.CrossedThreshold())
+}
+
+func TestNewBudgetThresholdNotification(t *testing.T) {
+	usage := BudgetUsage{
+		Budget: Budget{ID: 9, CategoryName: "Makanan", Year: 2026, Month: 3, Amount: 1000000},
+		Spent:  850000,
+	}
+
+	n := NewBudgetThresholdNotification(usage, 80)
+
+	assert.Equal(t, NotificationBudgetThreshold, n.Type)
+	assert.Equal(t, "budget:9:80", n.DedupKey)
+	assert.Equal(t, int64(9), *n.BudgetID)
+	assert.Equal(t, 80, *n.Threshold)
+	assert.Contains(t, n.Message, "Makanan")
+	assert.Contains(t, n.Message, "80%")
+}
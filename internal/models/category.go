@@ -6,14 +6,69 @@ import (
 	"github.com/google/uuid"
 )
 
+// MaxCategoryDepth membatasi kedalaman hierarki, contoh: "Transportasi > Bensin" = 2 level
+const MaxCategoryDepth = 3
+
 type Category struct {
-	ID        int64     `json:"id"`
-	UserID    uuid.UUID `json:"-"`
-	Name      string    `json:"name"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID       int64     `json:"id"`
+	UserID   uuid.UUID `json:"-"`
+	ParentID *int64    `json:"parent_id"`
+	Name     string    `json:"name"`
+	// Kind menentukan tipe transaksi yang boleh memakai kategori ini (income/expense)
+	Kind      TransactionType `json:"kind"`
+	Children  []Category      `json:"children,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
 }
 
+// UpsertCategoryRequest: Kind kosong berarti 'expense' saat create dan tidak berubah saat update.
 type UpsertCategoryRequest struct {
-	Name string `json:"name" binding:"required,min=3,max=100"`
+	Name     string `json:"name" binding:"required,min=3,max=100"`
+	ParentID *int64 `json:"parent_id" binding:"omitempty,gt=0"`
+	Kind     string `json:"kind" binding:"omitempty,oneof=expense income"`
+}
+
+// CategoryQuery adalah filter untuk GET /categories.
+type CategoryQuery struct {
+	Kind string `form:"kind" binding:"omitempty,oneof=expense income"`
+}
+
+// DeleteCategoryQuery: jika ReassignTo diisi, transaksi dipindahkan ke kategori tersebut sebelum dihapus.
+type DeleteCategoryQuery struct {
+	ReassignTo int64 `form:"reassign_to" binding:"omitempty,gt=0"`
+}
+
+type MergeCategoryRequest struct {
+	TargetID int64 `json:"target_id" binding:"required,gt=0"`
+}
+
+// BuildCategoryTree menyusun daftar kategori datar menjadi pohon. Urutan input dipertahankan
+// di setiap level; kategori yang induknya tidak ada di daftar diperlakukan sebagai root.
+func BuildCategoryTree(categories []Category) []Category {
+	ids := make(map[int64]bool, len(categories))
+	for _, c := range categories {
+		ids[c.ID] = true
+	}
+
+	childrenOf := make(map[int64][]Category)
+	var roots []Category
+	for _, c := range categories {
+		if c.ParentID != nil && ids[*c.ParentID] {
+			childrenOf[*c.ParentID] = append(childrenOf[*c.ParentID], c)
+		} else {
+			roots = append(roots, c)
+		}
+	}
+
+	var attach func(nodes []Category) []Category
+	attach = func(nodes []Category) []Category {
+		for i := range nodes {
+			if children, ok := childrenOf[nodes[i].ID]; ok {
+				nodes[i].Children = attach(children)
+			}
+		}
+		return nodes
+	}
+
+	return attach(roots)
 }
@@ -0,0 +1,148 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+)
+
+var ErrInvalidCategoryTemplate = errors.New("invalid category template")
+
+// SupportedLocales adalah bahasa yang didukung oleh template kategori
+var SupportedLocales = []string{"id", "en"}
+
+// CategoryTemplate adalah daftar kategori bawaan yang dibuat untuk user baru.
+// Nama setiap kategori dilokalisasi per bahasa, contoh: {"id": "Gaji", "en": "Salary"}.
+type CategoryTemplate struct {
+	DefaultLocale string                 `json:"default_locale"`
+	Categories    []CategoryTemplateItem `json:"categories"`
+}
+
+type CategoryTemplateItem struct {
+	Names    map[string]string      `json:"names"`
+	Kind     TransactionType        `json:"kind"`
+	Children []CategoryTemplateItem `json:"children,omitempty"`
+}
+
+// ApplyCategoryTemplateRequest: Locale kosong berarti memakai DefaultLocale template.
+type ApplyCategoryTemplateRequest struct {
+	Locale string `json:"locale" binding:"omitempty,oneof=id en"`
+}
+
+func expenseTemplate(id, en string, children ...CategoryTemplateItem) CategoryTemplateItem {
+	return CategoryTemplateItem{Names: map[string]string{"id": id, "en": en}, Kind: TransactionExpense, Children: children}
+}
+
+func incomeTemplate(id, en string) CategoryTemplateItem {
+	return CategoryTemplateItem{Names: map[string]string{"id": id, "en": en}, Kind: TransactionIncome}
+}
+
+// DefaultCategoryTemplate dipakai jika tidak ada file template yang dikonfigurasi
+var DefaultCategoryTemplate = CategoryTemplate{
+	DefaultLocale: "id",
+	Categories: []CategoryTemplateItem{
+		incomeTemplate("Gaji", "Salary"),
+		incomeTemplate("Bonus", "Bonus"),
+		incomeTemplate("Hasil Investasi", "Investment Returns"),
+		incomeTemplate("Pemasukan Lain", "Other Income"),
+		expenseTemplate("Makanan & Minuman", "Food & Drinks",
+			expenseTemplate("Belanja Dapur", "Groceries"),
+			expenseTemplate("Makan di Luar", "Dining Out"),
+		),
+		expenseTemplate("Transportasi", "Transportation",
+			expenseTemplate("Bensin", "Fuel"),
+			expenseTemplate("Parkir", "Parking"),
+			expenseTemplate("Ojek Online", "Ride Hailing"),
+		),
+		expenseTemplate("Tagihan", "Bills",
+			expenseTemplate("Listrik", "Electricity"),
+			expenseTemplate("Internet & Pulsa", "Internet & Phone"),
+			expenseTemplate("Air PDAM", "Water"),
+		),
+		expenseTemplate("Belanja", "Shopping"),
+		expenseTemplate("Kesehatan", "Health"),
+		expenseTemplate("Pendidikan", "Education"),
+		expenseTemplate("Hiburan", "Entertainment"),
+		expenseTemplate("Pengeluaran Lain", "Other Expenses"),
+	},
+}
+
+// LoadCategoryTemplate membaca template kategori dari file JSON dan memvalidasinya.
+func LoadCategoryTemplate(path string) (CategoryTemplate, error) {
+	var template CategoryTemplate
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return template, err
+	}
+	if err := json.Unmarshal(data, &template); err != nil {
+		return template, fmt.Errorf("%w: %v", ErrInvalidCategoryTemplate, err)
+	}
+	if err := template.Validate(); err != nil {
+		return template, err
+	}
+	return template, nil
+}
+
+// Validate memastikan setiap kategori memiliki nama untuk DefaultLocale, kind yang valid,
+// dan kedalaman yang tidak melebihi MaxCategoryDepth.
+func (t CategoryTemplate) Validate() error {
+	if !isSupportedLocale(t.DefaultLocale) {
+		return fmt.Errorf("%w: unsupported default_locale %q", ErrInvalidCategoryTemplate, t.DefaultLocale)
+	}
+
+	var validate func(items []CategoryTemplateItem, depth int) error
+	validate = func(items []CategoryTemplateItem, depth int) error {
+		if depth > MaxCategoryDepth {
+			return fmt.Errorf("%w: deeper than %d levels", ErrInvalidCategoryTemplate, MaxCategoryDepth)
+		}
+		for _, item := range items {
+			name := item.Names[t.DefaultLocale]
+			if len(name) < 3 || len(name) > 100 {
+				return fmt.Errorf("%w: category name %q must be 3-100 characters", ErrInvalidCategoryTemplate, name)
+			}
+			if item.Kind != TransactionExpense && item.Kind != TransactionIncome {
+				return fmt.Errorf("%w: category %q has invalid kind %q", ErrInvalidCategoryTemplate, name, item.Kind)
+			}
+			if err := validate(item.Children, depth+1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return validate(t.Categories, 1)
+}
+
+// Build menghasilkan pohon kategori dalam bahasa locale. Jika nama untuk locale
+// tersebut tidak ada, nama DefaultLocale yang dipakai.
+func (t CategoryTemplate) Build(locale string) []Category {
+	if !isSupportedLocale(locale) {
+		locale = t.DefaultLocale
+	}
+
+	var build func(items []CategoryTemplateItem) []Category
+	build = func(items []CategoryTemplateItem) []Category {
+		categories := make([]Category, 0, len(items))
+		for _, item := range items {
+			name, ok := item.Names[locale]
+			if !ok || name == "" {
+				name = item.Names[t.DefaultLocale]
+			}
+			categories = append(categories, Category{
+				Name:     name,
+				Kind:     item.Kind,
+				Children: build(item.Children),
+			})
+		}
+		return categories
+	}
+
+	return build(t.Categories)
+}
+
+func isSupportedLocale(locale string) bool {
+	return slices.Contains(SupportedLocales, locale)
+}
@@ -0,0 +1,106 @@
+package models
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultCategoryTemplate_IsValid(t *testing.T) {
+	assert.NoError(t, DefaultCategoryTemplate.Validate())
+}
+
+func TestCategoryTemplate_Build(t *testing.T) {
+	template := CategoryTemplate{
+		DefaultLocale: "id",
+		Categories: []CategoryTemplateItem{
+			{Names: map[string]string{"id": "Gaji", "en": "Salary"}, Kind: TransactionIncome},
+			{
+				Names: map[string]string{"id": "Transportasi"},
+				Kind:  TransactionExpense,
+				Children: []CategoryTemplateItem{
+					{Names: map[string]string{"id": "Bensin", "en": "Fuel"}, Kind: TransactionExpense},
+				},
+			},
+		},
+	}
+
+	t.Run("English", func(t *testing.T) {
+		categories := template.Build("en")
+
+		assert.Len(t, categories, 2)
+		assert.Equal(t, "Salary", categories[0].Name)
+		assert.Equal(t, TransactionIncome, categories[0].Kind)
+		// Tidak ada nama "en", kembali ke default_locale
+		assert.Equal(t, "Transportasi", categories[1].Name)
+		assert.Equal(t, "Fuel", categories[1].Children[0].Name)
+	})
+
+	t.Run("Unsupported Locale Falls Back", func(t *testing.T) {
+		categories := template.Build("fr")
+
+		assert.Equal(t, "Gaji", categories[0].Name)
+	})
+}
+
+func TestCategoryTemplate_Validate(t *testing.T) {
+	leaf := func(name string) CategoryTemplateItem {
+		return CategoryTemplateItem{Names: map[string]string{"id": name}, Kind: TransactionExpense}
+	}
+
+	t.Run("Invalid Kind", func(t *testing.T) {
+		template := CategoryTemplate{DefaultLocale: "id", Categories: []CategoryTemplateItem{
+			{Names: map[string]string{"id": "Makanan"}, Kind: "transfer"},
+		}}
+
+		assert.ErrorIs(t, template.Validate(), ErrInvalidCategoryTemplate)
+	})
+
+	t.Run("Missing Default Locale Name", func(t *testing.T) {
+		template := CategoryTemplate{DefaultLocale: "id", Categories: []CategoryTemplateItem{
+			{Names: map[string]string{"en": "Food"}, Kind: TransactionExpense},
+		}}
+
+		assert.ErrorIs(t, template.Validate(), ErrInvalidCategoryTemplate)
+	})
+
+	t.Run("Too Deep", func(t *testing.T) {
+		level3 := leaf("Level Tiga")
+		level3.Children = []CategoryTemplateItem{leaf("Level Empat")}
+		level2 := leaf("Level Dua")
+		level2.Children = []CategoryTemplateItem{level3}
+		level1 := leaf("Level Satu")
+		level1.Children = []CategoryTemplateItem{level2}
+
+		template := CategoryTemplate{DefaultLocale: "id", Categories: []CategoryTemplateItem{level1}}
+
+		assert.ErrorIs(t, template.Validate(), ErrInvalidCategoryTemplate)
+	})
+}
+
+func TestLoadCategoryTemplate(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("Success", func(t *testing.T) {
+		path := filepath.Join(dir, "categories.json")
+		content := `{"default_locale":"en","categories":[{"names":{"en":"Salary","id":"Gaji"},"kind":"income"}]}`
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+		template, err := LoadCategoryTemplate(path)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "en", template.DefaultLocale)
+		assert.Equal(t, "Salary", template.Build("")[0].Name)
+	})
+
+	t.Run("Invalid JSON", func(t *testing.T) {
+		path := filepath.Join(dir, "broken.json")
+		assert.NoError(t, os.WriteFile(path, []byte(`{`), 0o600))
+
+		_, err := LoadCategoryTemplate(path)
+
+		assert.ErrorIs(t, err, ErrInvalidCategoryTemplate)
+	})
+}
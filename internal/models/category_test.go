@@ -0,0 +1,33 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildCategoryTree(t *testing.T) {
+	transport := int64(1)
+	bensin := int64(2)
+
+	flat := []Category{
+		{ID: 2, Name: "Bensin", ParentID: &transport},
+		{ID: 3, Name: "Makan"},
+		{ID: 4, Name: "Pertamax", ParentID: &bensin},
+		{ID: 1, Name: "Transportasi"},
+		{ID: 5, Name: "Yatim", ParentID: func() *int64 { v := int64(99); return &v }()},
+	}
+
+	tree := BuildCategoryTree(flat)
+
+	// Root: Makan, Transportasi, dan Yatim (induknya tidak ditemukan)
+	assert.Equal(t, 3, len(tree))
+	assert.Equal(t, "Makan", tree[0].Name)
+	assert.Equal(t, "Transportasi", tree[1].Name)
+	assert.Equal(t, "Yatim", tree[2].Name)
+
+	// Transportasi > Bensin > Pertamax
+	assert.Equal(t, 1, len(tree[1].Children))
+	assert.Equal(t, "Bensin", tree[1].Children[0].Name)
+	assert.Equal(t, "Pertamax", tree[1].Children[0].Children[0].Name)
+}
@@ -6,22 +6,45 @@ import (
 )
 
 type DashboardSummary struct {
-	TotalBalance int64 `json:"total_balance"`
-	TotalIncome  int64 `json:"total_income"`
-	TotalExpense int64 `json:"total_expense"`
+	TotalBalance int64     `json:"total_balance"`
+	TotalIncome  int64     `json:"total_income"`
+	TotalExpense int64     `json:"total_expense"`
+	Currency     string    `json:"currency"`
+	PeriodStart  time.Time `json:"period_start"`
+	PeriodEnd    time.Time `json:"period_end"`
 }
 
+// CategorySummary adalah total pemasukan/pengeluaran per kategori dalam satu periode.
+// Pada mode rollup, total sudah termasuk seluruh sub-kategori di Children.
+type CategorySummary struct {
+	CategoryID   int64             `json:"category_id"`
+	ParentID     *int64            `json:"parent_id"`
+	Name         string            `json:"name"`
+	TotalIncome  int64             `json:"total_income"`
+	TotalExpense int64             `json:"total_expense"`
+	Children     []CategorySummary `json:"children,omitempty"`
+}
+
+// DashboardQuery memilih periode laporan. Period "week" memakai minggu yang memuat Date
+// (default hari ini); selain itu dipakai bulan Month/Year (default periode berjalan).
 type DashboardQuery struct {
-	Month int `form:"month"`
-	Year  int `form:"year"`
+	Month  int    `form:"month"`
+	Year   int    `form:"year"`
+	Period string `form:"period" binding:"omitempty,oneof=month week"`
+	Date   string `form:"date" binding:"omitempty,datetime=2006-01-02"`
 }
 
-func (q *DashboardQuery) GetDateRange() (time.Time, time.Time) {
+func reportLocation() *time.Location {
 	loc, err := time.LoadLocation("Asia/Jakarta")
 	if err != nil {
 		log.Println("Peringatan: Gagal load timezone, menggunakan UTC")
 		loc = time.UTC
 	}
+	return loc
+}
+
+func (q *DashboardQuery) GetDateRange() (time.Time, time.Time) {
+	loc := reportLocation()
 
 	now := time.Now().In(loc)
 	year := q.Year
@@ -39,3 +62,62 @@ func (q *DashboardQuery) GetDateRange() (time.Time, time.Time) {
 
 	return startTime, endTime
 }
+
+// RangeFor mengembalikan rentang waktu query menurut preferensi user: zona waktu, tanggal
+// awal bulan keuangan dan hari pertama minggu.
+func (q *DashboardQuery) RangeFor(prefs *UserPreferences, now time.Time) (time.Time, time.Time) {
+	if q.Period == "week" {
+		anchor := now
+		if q.Date != "" {
+			if date, err := time.ParseInLocation("2006-01-02", q.Date, prefs.Location()); err == nil {
+				anchor = date
+			}
+		}
+		return prefs.WeekRange(anchor)
+	}
+
+	return prefs.MonthRange(prefs.ResolvePeriod(q.Year, q.Month, now))
+}
+
+// CategoryBreakdownQuery: Rollup = true menjumlahkan pengeluaran sub-kategori ke induknya.
+type CategoryBreakdownQuery struct {
+	DashboardQuery
+	Rollup bool `form:"rollup"`
+}
+
+// RollupCategorySummaries menyusun ringkasan datar menjadi pohon dan menjumlahkan total
+// setiap node dengan total seluruh keturunannya. Ringkasan tanpa induk di daftar menjadi root.
+func RollupCategorySummaries(summaries []CategorySummary) []CategorySummary {
+	ids := make(map[int64]bool, len(summaries))
+	for _, s := range summaries {
+		ids[s.CategoryID] = true
+	}
+
+	childrenOf := make(map[int64][]CategorySummary)
+	var roots []CategorySummary
+	for _, s := range summaries {
+		if s.ParentID != nil && ids[*s.ParentID] {
+			childrenOf[*s.ParentID] = append(childrenOf[*s.ParentID], s)
+		} else {
+			roots = append(roots, s)
+		}
+	}
+
+	var rollup func(nodes []CategorySummary) []CategorySummary
+	rollup = func(nodes []CategorySummary) []CategorySummary {
+		for i := range nodes {
+			children, ok := childrenOf[nodes[i].CategoryID]
+			if !ok {
+				continue
+			}
+			nodes[i].Children = rollup(children)
+			for _, child := range nodes[i].Children {
+				nodes[i].TotalIncome += child.TotalIncome
+				nodes[i].TotalExpense += child.TotalExpense
+			}
+		}
+		return nodes
+	}
+
+	return rollup(roots)
+}
@@ -42,3 +42,29 @@ func TestDashboardQuery_GetDateRange(t *testing.T) {
 		assert.Equal(t, expectedEnd, end)
 	})
 }
+
+func TestRollupCategorySummaries(t *testing.T) {
+	transport := int64(1)
+	fuel := int64(2)
+
+	summaries := []CategorySummary{
+		{CategoryID: 3, Name: "Gaji", TotalIncome: 10000000},
+		{CategoryID: 2, ParentID: &transport, Name: "Bensin", TotalExpense: 300000},
+		{CategoryID: 4, ParentID: &fuel, Name: "Pertamax", TotalExpense: 50000},
+		{CategoryID: 1, Name: "Transportasi", TotalExpense: 20000},
+		{CategoryID: 5, ParentID: &transport, Name: "Parkir", TotalExpense: 10000},
+	}
+
+	roots := RollupCategorySummaries(summaries)
+
+	assert.Len(t, roots, 2)
+	assert.Equal(t, "Gaji", roots[0].Name)
+	assert.Equal(t, int64(10000000), roots[0].TotalIncome)
+
+	// Transportasi = 20.000 + Bensin (300.000 + Pertamax 50.000) + Parkir 10.000
+	assert.Equal(t, "Transportasi", roots[1].Name)
+	assert.Equal(t, int64(380000), roots[1].TotalExpense)
+	assert.Len(t, roots[1].Children, 2)
+	assert.Equal(t, int64(350000), roots[1].Children[0].TotalExpense)
+	assert.Equal(t, int64(10000), roots[1].Children[1].TotalExpense)
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Envelope menampung uang yang sudah dialokasikan dari saldo dompet untuk satu kategori
+// pengeluaran. Saldo berkurang setiap ada pengeluaran di kategori tersebut (atau sub-kategorinya)
+// dan terbawa terus antar bulan; nilai negatif berarti amplop sudah terlampaui.
+type Envelope struct {
+	ID           int64     `json:"id"`
+	UserID       uuid.UUID `json:"-"`
+	CategoryID   int64     `json:"category_id"`
+	CategoryName string    `json:"category_name,omitempty"`
+	Balance      int64     `json:"balance"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// AssignEnvelopeRequest: Amount positif mengisi amplop, negatif mengembalikan dana ke saldo bebas.
+type AssignEnvelopeRequest struct {
+	CategoryID int64 `json:"category_id" binding:"required,gt=0"`
+	Amount     int64 `json:"amount" binding:"required,ne=0"`
+}
+
+// EnvelopeSummary: ReadyToAssign = total saldo dompet - total saldo positif seluruh amplop.
+type EnvelopeSummary struct {
+	ReadyToAssign int64      `json:"ready_to_assign"`
+	Envelopes     []Envelope `json:"envelopes"`
+}
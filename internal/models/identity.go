@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserIdentity menautkan akun di identity provider eksternal (OIDC) ke user. Subject adalah
+// claim "sub" dari provider dan tidak pernah berubah, berbeda dengan email.
+type UserIdentity struct {
+	ID          int64      `json:"id"`
+	UserID      uuid.UUID  `json:"-"`
+	Provider    string     `json:"provider"`
+	Subject     string     `json:"-"`
+	Email       string     `json:"email"`
+	LastLoginAt *time.Time `json:"last_login_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// OIDCLoginState adalah login OIDC yang sedang berjalan: hash state dari authorization
+// request beserta nonce dan code_verifier PKCE yang baru dipakai saat callback.
+type OIDCLoginState struct {
+	StateHash    string
+	Provider     string
+	Nonce        string
+	CodeVerifier string
+	ExpiresAt    time.Time
+	CreatedAt    time.Time
+}
+
+// OIDCAuthorization dikembalikan saat memulai login OIDC. Client mengarahkan user ke
+// AuthorizationURL dan wajib mencocokkan State dengan parameter state di callback sebelum
+// mengirimkannya ke API.
+type OIDCAuthorization struct {
+	AuthorizationURL string `json:"authorization_url"`
+	State            string `json:"state"`
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TOTPCredential adalah secret TOTP milik user. Selama EnabledAt kosong, secret masih dalam
+// tahap pendaftaran dan belum diwajibkan saat login. LastStep menyimpan time-step kode terakhir
+// yang diterima agar kode yang sama tidak bisa dipakai ulang.
+type TOTPCredential struct {
+	UserID    uuid.UUID
+	Secret    string
+	EnabledAt *time.Time
+	LastStep  *int64
+	CreatedAt time.Time
+}
+
+func (c *TOTPCredential) Enabled() bool {
+	return c != nil && c.EnabledAt != nil
+}
+
+// TOTPEnrollment dikembalikan saat pendaftaran; URI dipakai sebagai isi QR code.
+type TOTPEnrollment struct {
+	Secret string `json:"secret"`
+	URI    string `json:"otpauth_uri"`
+}
+
+type MFACodeRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+// LoginResult adalah hasil Login: token akses/refresh, atau MFAToken jika user masih harus
+// menyelesaikan langkah kedua di /auth/mfa/verify.
+type LoginResult struct {
+	AccessToken  string
+	RefreshToken string
+	MFARequired  bool
+	MFAToken     string
+}
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NotificationType string
+
+const (
+	NotificationBudgetThreshold NotificationType = "budget_threshold"
+	NotificationBillReminder    NotificationType = "bill_reminder"
+)
+
+// Notification adalah pemberitahuan untuk user. DedupKey unik per user sehingga kejadian
+// yang sama (mis. ambang 80% anggaran bulan ini) hanya tercatat sekali.
+type Notification struct {
+	ID        int64            `json:"id"`
+	UserID    uuid.UUID        `json:"-"`
+	Type      NotificationType `json:"type"`
+	Message   string           `json:"message"`
+	BudgetID  *int64           `json:"budget_id,omitempty"`
+	Threshold *int             `json:"threshold,omitempty"`
+	BillID    *int64           `json:"bill_id,omitempty"`
+	DedupKey  string           `json:"-"`
+	ReadAt    *time.Time       `json:"read_at"`
+	CreatedAt time.Time        `json:"created_at"`
+}
+
+// NotificationQuery: Unread = true hanya mengembalikan notifikasi yang belum dibaca.
+type NotificationQuery struct {
+	Unread bool `form:"unread"`
+}
+
+// NewBudgetThresholdNotification membuat notifikasi ambang anggaran. Karena anggaran berlaku
+// untuk satu bulan, kunci (budget, ambang) sudah cukup untuk deduplikasi per periode.
+func NewBudgetThresholdNotification(usage BudgetUsage, threshold int) *Notification {
+	budgetID := usage.ID
+	return &Notification{
+		UserID: usage.UserID,
+		Type:   NotificationBudgetThreshold,
+		Message: fmt.Sprintf("Spending on %s reached %d%% of the %02d/%d budget (%d of %d)",
+			usage.CategoryName, threshold, usage.Month, usage.Year, usage.Spent, usage.Available()),
+		BudgetID:  &budgetID,
+		Threshold: &threshold,
+		DedupKey:  fmt.Sprintf("budget:%d:%d", usage.ID, threshold),
+	}
+}
+
+// BillReminderDedupKey unik per jatuh tempo (tagihan, urutan pembayaran), sehingga setiap
+// jatuh tempo hanya diingatkan sekali.
+func BillReminderDedupKey(billID int64, paidCount int) string {
+	return fmt.Sprintf("bill:%d:%d", billID, paidCount)
+}
+
+// NewBillReminderNotification membuat pengingat untuk jatuh tempo berikutnya dari tagihan;
+// tanggal ditulis dalam zona waktu loc (zona preferensi pemilik tagihan).
+func NewBillReminderNotification(bill Bill, loc *time.Location) *Notification {
+	billID := bill.ID
+	due := bill.Occurrence(bill.PaidCount, loc)
+	return &Notification{
+		UserID:   bill.UserID,
+		Type:     NotificationBillReminder,
+		Message:  fmt.Sprintf("%s (%d) is due on %s", bill.Name, bill.Amount, due.Format("2006-01-02")),
+		BillID:   &billID,
+		DedupKey: BillReminderDedupKey(bill.ID, bill.PaidCount),
+	}
+}
@@ -0,0 +1,62 @@
+package models
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PersonalAccessTokenPrefix membedakan personal access token dari JWT di header Authorization.
+const PersonalAccessTokenPrefix = "ubpat_"
+
+// ScopeRead memberi akses baca (GET) ke semua resource. Scope "<resource>:write" memberi akses
+// mengubah satu resource saja dan tidak otomatis termasuk akses baca.
+const ScopeRead = "read"
+
+// PersonalAccessTokenScopes adalah daftar scope yang boleh diminta saat membuat token.
+var PersonalAccessTokenScopes = []string{
+	ScopeRead,
+	"wallets:write",
+	"categories:write",
+	"transactions:write",
+	"transfers:write",
+	"budgets:write",
+	"envelopes:write",
+	"recurring:write",
+	"bills:write",
+	"notifications:write",
+}
+
+func IsValidScope(scope string) bool {
+	return slices.Contains(PersonalAccessTokenScopes, scope)
+}
+
+// PersonalAccessToken adalah token berumur panjang untuk script dan integrasi. Yang disimpan
+// hanya hash-nya; token aslinya hanya ditampilkan sekali saat dibuat.
+type PersonalAccessToken struct {
+	ID         uuid.UUID  `json:"id"`
+	UserID     uuid.UUID  `json:"-"`
+	Name       string     `json:"name"`
+	TokenHash  string     `json:"-"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"-"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// CreatePersonalAccessTokenRequest: ExpiresInDays nil berarti token tidak kedaluwarsa.
+type CreatePersonalAccessTokenRequest struct {
+	Name          string   `json:"name" binding:"required,max=100"`
+	Scopes        []string `json:"scopes" binding:"required,min=1,dive,required"`
+	ExpiresInDays *int     `json:"expires_in_days" binding:"omitempty,min=1,max=3650"`
+}
+
+// TokenPrincipal adalah identitas hasil autentikasi personal access token.
+type TokenPrincipal struct {
+	UserID        uuid.UUID
+	TokenID       uuid.UUID
+	Scopes        []string
+	EmailVerified bool
+}
@@ -0,0 +1,118 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserPreferences adalah pengaturan tampilan dan periode laporan milik user. MonthStartDay
+// adalah tanggal awal bulan keuangan (mis. 25 untuk yang menghitung dari hari gajian);
+// periode diberi label bulan tempat periode itu dimulai, jadi periode "Maret" dengan
+// MonthStartDay 25 adalah 25 Maret sampai 24 April. FirstDayOfWeek: 0 = Minggu, 1 = Senin.
+type UserPreferences struct {
+	UserID         uuid.UUID `json:"-"`
+	Currency       string    `json:"currency"`
+	Timezone       string    `json:"timezone"`
+	Locale         string    `json:"locale"`
+	FirstDayOfWeek int       `json:"first_day_of_week"`
+	MonthStartDay  int       `json:"month_start_day"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// DefaultUserPreferences adalah preferensi user yang belum pernah mengubahnya, sama dengan
+// perilaku sebelum preferensi ada (Rupiah, Asia/Jakarta, bulan kalender).
+func DefaultUserPreferences(userID uuid.UUID) *UserPreferences {
+	return &UserPreferences{
+		UserID:         userID,
+		Currency:       "IDR",
+		Timezone:       "Asia/Jakarta",
+		Locale:         "id",
+		FirstDayOfWeek: int(time.Monday),
+		MonthStartDay:  1,
+	}
+}
+
+// UpdatePreferencesRequest: field yang tidak dikirim tidak diubah.
+type UpdatePreferencesRequest struct {
+	Currency       *string `json:"currency" binding:"omitempty,iso4217"`
+	Timezone       *string `json:"timezone" binding:"omitempty,timezone"`
+	Locale         *string `json:"locale" binding:"omitempty,oneof=id en"`
+	FirstDayOfWeek *int    `json:"first_day_of_week" binding:"omitempty,min=0,max=6"`
+	MonthStartDay  *int    `json:"month_start_day" binding:"omitempty,min=1,max=28"`
+}
+
+// Apply menerapkan field yang dikirim ke p.
+func (r UpdatePreferencesRequest) Apply(p *UserPreferences) {
+	if r.Currency != nil {
+		p.Currency = *r.Currency
+	}
+	if r.Timezone != nil {
+		p.Timezone = *r.Timezone
+	}
+	if r.Locale != nil {
+		p.Locale = *r.Locale
+	}
+	if r.FirstDayOfWeek != nil {
+		p.FirstDayOfWeek = *r.FirstDayOfWeek
+	}
+	if r.MonthStartDay != nil {
+		p.MonthStartDay = *r.MonthStartDay
+	}
+}
+
+// Location mengembalikan zona waktu preferensi; jika tidak bisa dimuat, dipakai zona laporan
+// bawaan.
+func (p *UserPreferences) Location() *time.Location {
+	loc, err := time.LoadLocation(p.Timezone)
+	if err != nil || p.Timezone == "" {
+		return reportLocation()
+	}
+	return loc
+}
+
+// MonthRange mengembalikan awal dan akhir periode bulan keuangan (year, month).
+func (p *UserPreferences) MonthRange(year, month int) (time.Time, time.Time) {
+	start := time.Date(year, time.Month(month), p.monthStartDay(), 0, 0, 0, 0, p.Location())
+	end := start.AddDate(0, 1, 0).Add(-1 * time.Nanosecond)
+	return start, end
+}
+
+// PeriodOf mengembalikan (tahun, bulan) periode bulan keuangan tempat t berada.
+func (p *UserPreferences) PeriodOf(t time.Time) (int, int) {
+	local := t.In(p.Location())
+	year, month := local.Year(), local.Month()
+	if local.Day() < p.monthStartDay() {
+		previous := time.Date(year, month-1, 1, 0, 0, 0, 0, p.Location())
+		year, month = previous.Year(), previous.Month()
+	}
+	return year, int(month)
+}
+
+// ResolvePeriod mengisi year/month yang kosong (0) dengan periode bulan keuangan yang memuat now.
+func (p *UserPreferences) ResolvePeriod(year, month int, now time.Time) (int, int) {
+	currentYear, currentMonth := p.PeriodOf(now)
+	if year == 0 {
+		year = currentYear
+	}
+	if month == 0 {
+		month = currentMonth
+	}
+	return year, month
+}
+
+// WeekRange mengembalikan minggu yang memuat t, dimulai dari FirstDayOfWeek.
+func (p *UserPreferences) WeekRange(t time.Time) (time.Time, time.Time) {
+	local := t.In(p.Location())
+	offset := (int(local.Weekday()) - p.FirstDayOfWeek + 7) % 7
+	start := time.Date(local.Year(), local.Month(), local.Day()-offset, 0, 0, 0, 0, p.Location())
+	end := start.AddDate(0, 0, 7).Add(-1 * time.Nanosecond)
+	return start, end
+}
+
+func (p *UserPreferences) monthStartDay() int {
+	if p.MonthStartDay < 1 || p.MonthStartDay > 28 {
+		return 1
+	}
+	return p.MonthStartDay
+}
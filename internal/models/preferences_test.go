@@ -0,0 +1,92 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserPreferences_PeriodOf(t *testing.T) {
+	prefs := DefaultUserPreferences(uuid.New())
+	prefs.MonthStartDay = 25
+	loc := prefs.Location()
+
+	tests := []struct {
+		name  string
+		at    time.Time
+		year  int
+		month int
+	}{
+		{"Sebelum gajian masih periode bulan lalu", time.Date(2025, time.March, 24, 23, 59, 0, 0, loc), 2025, 2},
+		{"Hari gajian memulai periode baru", time.Date(2025, time.March, 25, 0, 0, 0, 0, loc), 2025, 3},
+		{"Awal Januari termasuk periode Desember tahun lalu", time.Date(2025, time.January, 3, 0, 0, 0, 0, loc), 2024, 12},
+		// 24 Maret 20:00 UTC sudah 25 Maret 03:00 WIB
+		{"Batas periode mengikuti zona waktu user", time.Date(2025, time.March, 24, 20, 0, 0, 0, time.UTC), 2025, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			year, month := prefs.PeriodOf(tt.at)
+			assert.Equal(t, tt.year, year)
+			assert.Equal(t, tt.month, month)
+		})
+	}
+}
+
+func TestUserPreferences_MonthRange(t *testing.T) {
+	prefs := DefaultUserPreferences(uuid.New())
+	prefs.MonthStartDay = 25
+	loc := prefs.Location()
+
+	start, end := prefs.MonthRange(2025, 12)
+
+	assert.Equal(t, time.Date(2025, time.December, 25, 0, 0, 0, 0, loc), start)
+	assert.Equal(t, time.Date(2026, time.January, 24, 23, 59, 59, 999999999, loc), end)
+}
+
+func TestUserPreferences_WeekRange(t *testing.T) {
+	prefs := DefaultUserPreferences(uuid.New())
+	loc := prefs.Location()
+	// Minggu, 12 Oktober 2025
+	sunday := time.Date(2025, time.October, 12, 10, 0, 0, 0, loc)
+
+	t.Run("Minggu Mulai Senin", func(t *testing.T) {
+		start, end := prefs.WeekRange(sunday)
+		assert.Equal(t, time.Date(2025, time.October, 6, 0, 0, 0, 0, loc), start)
+		assert.Equal(t, time.Date(2025, time.October, 12, 23, 59, 59, 999999999, loc), end)
+	})
+
+	t.Run("Minggu Mulai Minggu", func(t *testing.T) {
+		sundayFirst := *prefs
+		sundayFirst.FirstDayOfWeek = int(time.Sunday)
+
+		start, _ := sundayFirst.WeekRange(sunday)
+		assert.Equal(t, time.Date(2025, time.October, 12, 0, 0, 0, 0, loc), start)
+	})
+}
+
+func TestDashboardQuery_RangeFor(t *testing.T) {
+	prefs := DefaultUserPreferences(uuid.New())
+	loc := prefs.Location()
+	now := time.Date(2025, time.October, 10, 12, 0, 0, 0, loc)
+
+	t.Run("Default Sama Dengan GetDateRange", func(t *testing.T) {
+		q := DashboardQuery{Month: 10, Year: 2025}
+		expectedStart, expectedEnd := q.GetDateRange()
+
+		start, end := q.RangeFor(prefs, now)
+
+		assert.Equal(t, expectedStart, start)
+		assert.Equal(t, expectedEnd, end)
+	})
+
+	t.Run("Minggu Dari Tanggal", func(t *testing.T) {
+		q := DashboardQuery{Period: "week", Date: "2025-10-01"}
+
+		start, _ := q.RangeFor(prefs, now)
+
+		assert.Equal(t, time.Date(2025, time.September, 29, 0, 0, 0, 0, loc), start)
+	})
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RecurringTransaction adalah template transaksi berulang dengan jadwal ala RRULE.
+// OccurrenceCount adalah jumlah kemunculan yang sudah dibuat; NextRunAt nil berarti selesai.
+type RecurringTransaction struct {
+	ID          int64           `json:"id"`
+	UserID      uuid.UUID       `json:"-"`
+	WalletID    int64           `json:"wallet_id"`
+	CategoryID  int64           `json:"category_id"`
+	Amount      int64           `json:"amount"`
+	Type        TransactionType `json:"type"`
+	Description *string         `json:"description,omitempty"`
+	Schedule
+	OccurrenceCount int        `json:"occurrence_count"`
+	NextRunAt       *time.Time `json:"next_run_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+
+	// Data join
+	CategoryName string `json:"category_name,omitempty"`
+	WalletName   string `json:"wallet_name,omitempty"`
+}
+
+// CreateRecurringTransactionRequest: Interval kosong berarti 1. EndDate dan Count opsional
+// dan tidak boleh diisi bersamaan (sama seperti UNTIL/COUNT pada RRULE).
+type CreateRecurringTransactionRequest struct {
+	WalletID    int64      `json:"wallet_id" binding:"required,gt=0"`
+	CategoryID  int64      `json:"category_id" binding:"required,gt=0"`
+	Amount      int64      `json:"amount" binding:"required,gt=0"`
+	Type        string     `json:"type" binding:"required,oneof=expense income"`
+	Description *string    `json:"description"`
+	Frequency   string     `json:"frequency" binding:"required,oneof=daily weekly monthly yearly"`
+	Interval    int        `json:"interval" binding:"omitempty,min=1,max=365"`
+	StartDate   time.Time  `json:"start_date" binding:"required"`
+	EndDate     *time.Time `json:"end_date" binding:"omitempty,excluded_with=Count"`
+	Count       *int       `json:"count" binding:"omitempty,min=1,max=1000"`
+}
+
+// UpdateRecurringTransactionRequest mengganti isi transaksi dan batas akhir jadwal.
+// Frekuensi dan tanggal mulai tidak bisa diubah; hapus lalu buat template baru.
+type UpdateRecurringTransactionRequest struct {
+	WalletID    int64      `json:"wallet_id" binding:"required,gt=0"`
+	CategoryID  int64      `json:"category_id" binding:"required,gt=0"`
+	Amount      int64      `json:"amount" binding:"required,gt=0"`
+	Type        string     `json:"type" binding:"required,oneof=expense income"`
+	Description *string    `json:"description"`
+	EndDate     *time.Time `json:"end_date" binding:"omitempty,excluded_with=Count"`
+	Count       *int       `json:"count" binding:"omitempty,min=1,max=1000"`
+}
+
+// NextOccurrence mengembalikan jadwal kemunculan berikutnya yang belum dibuat, atau nil jika selesai.
+func (r RecurringTransaction) NextOccurrence(loc *time.Location) *time.Time {
+	if !r.HasOccurrence(r.OccurrenceCount, loc) {
+		return nil
+	}
+	next := r.Occurrence(r.OccurrenceCount, loc)
+	return &next
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RefreshToken adalah refresh token yang tersimpan di server. Token asli tidak pernah
+// disimpan, hanya hash SHA-256-nya. Setiap rotasi menghasilkan token baru dengan FamilyID
+// yang sama; FamilyID sekaligus ID Session, sehingga mencabut sesi mencabut seluruh rantainya.
+type RefreshToken struct {
+	ID        int64
+	UserID    uuid.UUID
+	FamilyID  uuid.UUID
+	TokenHash string
+	ExpiresAt time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
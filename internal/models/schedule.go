@@ -0,0 +1,89 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+type RecurrenceFrequency string
+
+const (
+	// FrequencyOnce hanya dipakai tagihan: satu kali jatuh tempo pada StartDate
+	FrequencyOnce    RecurrenceFrequency = "once"
+	FrequencyDaily   RecurrenceFrequency = "daily"
+	FrequencyWeekly  RecurrenceFrequency = "weekly"
+	FrequencyMonthly RecurrenceFrequency = "monthly"
+	FrequencyYearly  RecurrenceFrequency = "yearly"
+)
+
+// Schedule adalah jadwal berulang ala RRULE (FREQ, INTERVAL, UNTIL, COUNT) dengan DTSTART = StartDate.
+// Kemunculan ke-n dihitung langsung dari StartDate sehingga tanggal tidak bergeser setelah
+// di-clamp: jadwal tanggal 31 jatuh pada 28/29 Feb lalu kembali ke 31 Mar.
+type Schedule struct {
+	Frequency RecurrenceFrequency `json:"frequency"`
+	Interval  int                 `json:"interval"`
+	StartDate time.Time           `json:"start_date"`
+	EndDate   *time.Time          `json:"end_date"`
+	Count     *int                `json:"count"`
+}
+
+// Occurrence mengembalikan tanggal kemunculan ke-n (mulai dari 0) dalam zona waktu loc (zona
+// preferensi user), dengan jam yang sama seperti StartDate. Tanggal yang tidak ada di bulan
+// tujuan di-clamp ke hari terakhir bulan tersebut.
+func (s Schedule) Occurrence(n int, loc *time.Location) time.Time {
+	start := s.StartDate.In(loc)
+	interval := s.Interval
+	if interval < 1 {
+		interval = 1
+	}
+	step := n * interval
+
+	switch s.Frequency {
+	case FrequencyDaily:
+		return start.AddDate(0, 0, step)
+	case FrequencyWeekly:
+		return start.AddDate(0, 0, 7*step)
+	case FrequencyYearly:
+		return clampedDate(start, start.Year()+step, start.Month())
+	default:
+		return clampedDate(start, start.Year(), start.Month()+time.Month(step))
+	}
+}
+
+// HasOccurrence melaporkan apakah kemunculan ke-n masih berada dalam batas Count/EndDate.
+func (s Schedule) HasOccurrence(n int, loc *time.Location) bool {
+	if s.Frequency == FrequencyOnce && n > 0 {
+		return false
+	}
+	if s.Count != nil && n >= *s.Count {
+		return false
+	}
+	if s.EndDate != nil && s.Occurrence(n, loc).After(*s.EndDate) {
+		return false
+	}
+	return true
+}
+
+// Validate memastikan UNTIL dan COUNT tidak dipakai bersamaan dan EndDate tidak sebelum StartDate.
+func (s Schedule) Validate() error {
+	if s.EndDate != nil && s.Count != nil {
+		return errors.New("end_date and count cannot be combined")
+	}
+	if s.EndDate != nil && s.EndDate.Before(s.StartDate) {
+		return errors.New("end_date is before start_date")
+	}
+	return nil
+}
+
+// clampedDate membuat tanggal di (year, month) dengan hari & jam dari anchor; month boleh
+// melebihi 12 dan akan dinormalisasi.
+func clampedDate(anchor time.Time, year int, month time.Month) time.Time {
+	firstOfMonth := time.Date(year, month, 1, anchor.Hour(), anchor.Minute(), anchor.Second(), anchor.Nanosecond(), anchor.Location())
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+
+	day := anchor.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return firstOfMonth.AddDate(0, 0, day-1)
+}
@@ -0,0 +1,97 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchedule_Occurrence(t *testing.T) {
+	loc, _ := time.LoadLocation("Asia/Jakarta")
+
+	t.Run("Monthly Clamps To Last Day Without Drifting", func(t *testing.T) {
+		r := Schedule{
+			Frequency: FrequencyMonthly,
+			Interval:  1,
+			StartDate: time.Date(2026, time.January, 31, 9, 0, 0, 0, loc),
+		}
+
+		assert.Equal(t, time.Date(2026, time.January, 31, 9, 0, 0, 0, loc), r.Occurrence(0, loc))
+		assert.Equal(t, time.Date(2026, time.February, 28, 9, 0, 0, 0, loc), r.Occurrence(1, loc))
+		assert.Equal(t, time.Date(2026, time.March, 31, 9, 0, 0, 0, loc), r.Occurrence(2, loc))
+		assert.Equal(t, time.Date(2026, time.April, 30, 9, 0, 0, 0, loc), r.Occurrence(3, loc))
+		assert.Equal(t, time.Date(2027, time.January, 31, 9, 0, 0, 0, loc), r.Occurrence(12, loc))
+	})
+
+	t.Run("Yearly On Leap Day", func(t *testing.T) {
+		r := Schedule{
+			Frequency: FrequencyYearly,
+			StartDate: time.Date(2028, time.February, 29, 0, 0, 0, 0, loc),
+		}
+
+		assert.Equal(t, time.Date(2029, time.February, 28, 0, 0, 0, 0, loc), r.Occurrence(1, loc))
+		assert.Equal(t, time.Date(2032, time.February, 29, 0, 0, 0, 0, loc), r.Occurrence(4, loc))
+	})
+
+	t.Run("Weekly With Interval", func(t *testing.T) {
+		r := Schedule{
+			Frequency: FrequencyWeekly,
+			Interval:  2,
+			StartDate: time.Date(2026, time.March, 2, 8, 0, 0, 0, loc),
+		}
+
+		assert.Equal(t, time.Date(2026, time.March, 16, 8, 0, 0, 0, loc), r.Occurrence(1, loc))
+		assert.Equal(t, time.Date(2026, time.March, 30, 8, 0, 0, 0, loc), r.Occurrence(2, loc))
+	})
+
+	t.Run("Daily", func(t *testing.T) {
+		r := Schedule{
+			Frequency: FrequencyDaily,
+			Interval:  3,
+			StartDate: time.Date(2026, time.December, 30, 8, 0, 0, 0, loc),
+		}
+
+		assert.Equal(t, time.Date(2027, time.January, 2, 8, 0, 0, 0, loc), r.Occurrence(1, loc))
+	})
+}
+
+func TestSchedule_OccurrenceFollowsLocation(t *testing.T) {
+	jakarta, _ := time.LoadLocation("Asia/Jakarta")
+	// 31 Jan 23:30 UTC sudah 1 Feb 06:30 WIB, jadi tanggal bulanan berbeda per zona
+	r := Schedule{
+		Frequency: FrequencyMonthly,
+		Interval:  1,
+		StartDate: time.Date(2026, time.January, 31, 23, 30, 0, 0, time.UTC),
+	}
+
+	assert.True(t, r.Occurrence(1, time.UTC).Equal(time.Date(2026, time.February, 28, 23, 30, 0, 0, time.UTC)))
+	assert.True(t, r.Occurrence(1, jakarta).Equal(time.Date(2026, time.March, 1, 6, 30, 0, 0, jakarta)))
+}
+
+func TestRecurringTransaction_NextOccurrence(t *testing.T) {
+	loc, _ := time.LoadLocation("Asia/Jakarta")
+	start := time.Date(2026, time.January, 25, 0, 0, 0, 0, loc)
+
+	t.Run("Stops After Count", func(t *testing.T) {
+		count := 3
+		r := RecurringTransaction{Schedule: Schedule{Frequency: FrequencyMonthly, StartDate: start, Count: &count}}
+
+		r.OccurrenceCount = 2
+		assert.Equal(t, time.Date(2026, time.March, 25, 0, 0, 0, 0, loc), *r.NextOccurrence(loc))
+
+		r.OccurrenceCount = 3
+		assert.Nil(t, r.NextOccurrence(loc))
+	})
+
+	t.Run("Stops After End Date", func(t *testing.T) {
+		endDate := time.Date(2026, time.March, 24, 0, 0, 0, 0, loc)
+		r := RecurringTransaction{Schedule: Schedule{Frequency: FrequencyMonthly, StartDate: start, EndDate: &endDate}}
+
+		assert.True(t, r.HasOccurrence(1, loc))
+		assert.False(t, r.HasOccurrence(2, loc))
+
+		r.OccurrenceCount = 2
+		assert.Nil(t, r.NextOccurrence(loc))
+	})
+}
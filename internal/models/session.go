@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Session adalah satu login di satu perangkat. ID sesi sama dengan FamilyID refresh token-nya
+// dan ikut tertanam di access token (claim "sid"), sehingga mencabut sesi memutus keduanya.
+type Session struct {
+	ID         uuid.UUID  `json:"id"`
+	UserID     uuid.UUID  `json:"-"`
+	Device     string     `json:"device"`
+	UserAgent  string     `json:"user_agent"`
+	IPAddress  string     `json:"ip_address"`
+	Current    bool       `json:"current"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt time.Time  `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"-"`
+}
+
+// SessionMetadata adalah informasi perangkat yang dicatat saat login.
+type SessionMetadata struct {
+	Device    string
+	UserAgent string
+	IPAddress string
+}
@@ -1,6 +1,11 @@
 package models
 
 import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -25,9 +30,19 @@ type Transaction struct {
 	CreatedAt       time.Time       `json:"created_at"`
 	UpdatedAt       time.Time       `json:"updated_at"`
 
-	// TODO: Tambahkan data join
-	// CategoryName string `json:"category_name,omitempty"`
-	// WalletName   string `json:"wallet_name,omitempty"`
+	// Diisi jika transaksi dibuat dari template berulang; (RecurringID, RecurrenceIndex) unik
+	RecurringID     *int64 `json:"recurring_id,omitempty"`
+	RecurrenceIndex *int   `json:"-"`
+
+	// Diisi jika transaksi ini adalah biaya admin sebuah transfer; diubah lewat transfernya
+	TransferID *int64 `json:"transfer_id,omitempty"`
+
+	// Amplop yang dipotong pengeluaran ini; hanya amplop ini yang dikembalikan saat diubah/dihapus
+	EnvelopeID *int64 `json:"-"`
+
+	// Data join, kosong jika caller meminta ids_only
+	CategoryName string `json:"category_name,omitempty"`
+	WalletName   string `json:"wallet_name,omitempty"`
 }
 
 type CreateTransactionRequest struct {
@@ -38,3 +53,93 @@ type CreateTransactionRequest struct {
 	Description     *string    `json:"description"`
 	TransactionDate *time.Time `json:"transaction_date"`
 }
+
+type UpdateTransactionRequest struct {
+	WalletID        int64      `json:"wallet_id" binding:"required,gt=0"`
+	CategoryID      int64      `json:"category_id" binding:"required,gt=0"`
+	Amount          int64      `json:"amount" binding:"required,gt=0"`
+	Type            string     `json:"type" binding:"required,oneof=expense income"`
+	Description     *string    `json:"description"`
+	TransactionDate *time.Time `json:"transaction_date"`
+}
+
+// BalanceEffect mengembalikan perubahan saldo dompet yang ditimbulkan transaksi ini.
+// Pemasukan menambah saldo, pengeluaran mengurangi saldo.
+func (t *Transaction) BalanceEffect() int64 {
+	if t.Type == TransactionExpense {
+		return -t.Amount
+	}
+	return t.Amount
+}
+
+// TransactionFilter dipakai bersama oleh handler (query string), service, dan repository.
+type TransactionFilter struct {
+	StartDate   *time.Time `form:"start_date" time_format:"2006-01-02" time_location:"Asia/Jakarta"`
+	EndDate     *time.Time `form:"end_date" time_format:"2006-01-02" time_location:"Asia/Jakarta"`
+	WalletIDs   []int64    `form:"wallet_id"`
+	CategoryIDs []int64    `form:"category_id"`
+	Type        string     `form:"type" binding:"omitempty,oneof=expense income"`
+	MinAmount   *int64     `form:"min_amount" binding:"omitempty,gt=0"`
+	MaxAmount   *int64     `form:"max_amount" binding:"omitempty,gt=0"`
+	Search      string     `form:"q" binding:"max=100"`
+	Cursor      string     `form:"cursor"`
+	Limit       int        `form:"limit" binding:"omitempty,min=1,max=100"`
+	IDsOnly     bool       `form:"ids_only"` // lewati join nama dompet & kategori
+
+	// After diisi service dari Cursor, tidak dibaca dari query string
+	After *TransactionCursor `form:"-"`
+}
+
+// TransactionCursor menandai posisi terakhir pada urutan (transaction_date DESC, id DESC).
+type TransactionCursor struct {
+	TransactionDate time.Time
+	ID              int64
+}
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+func (c TransactionCursor) Encode() string {
+	return encodeCursor(c.TransactionDate, c.ID)
+}
+
+func DecodeTransactionCursor(s string) (*TransactionCursor, error) {
+	date, id, err := decodeCursor(s)
+	if err != nil {
+		return nil, err
+	}
+	return &TransactionCursor{TransactionDate: date, ID: id}, nil
+}
+
+// encodeCursor menyandikan posisi (tanggal, id) yang dipakai bersama oleh cursor transaksi dan transfer.
+func encodeCursor(date time.Time, id int64) string {
+	raw := date.UTC().Format(time.RFC3339Nano) + "|" + strconv.FormatInt(id, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(s string) (time.Time, int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return time.Time{}, 0, ErrInvalidCursor
+	}
+
+	datePart, idPart, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return time.Time{}, 0, ErrInvalidCursor
+	}
+
+	date, err := time.Parse(time.RFC3339Nano, datePart)
+	if err != nil {
+		return time.Time{}, 0, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+	id, err := strconv.ParseInt(idPart, 10, 64)
+	if err != nil {
+		return time.Time{}, 0, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+
+	return date, id, nil
+}
+
+type TransactionPage struct {
+	Data       []Transaction `json:"data"`
+	NextCursor *string       `json:"next_cursor"`
+}
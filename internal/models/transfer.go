@@ -0,0 +1,70 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Transfer adalah perpindahan dana antar dompet milik user yang sama.
+// Transfer tidak dihitung sebagai pemasukan maupun pengeluaran; biaya admin dicatat sebagai
+// transaksi pengeluaran terpisah (FeeTransactionID) agar laporan tetap cocok dengan saldo dompet.
+type Transfer struct {
+	ID           int64     `json:"id"`
+	UserID       uuid.UUID `json:"-"`
+	FromWalletID int64     `json:"from_wallet_id"`
+	ToWalletID   int64     `json:"to_wallet_id"`
+	Amount       int64     `json:"amount"`
+	Fee          int64     `json:"fee"`
+	Description  *string   `json:"description,omitempty"`
+	TransferDate time.Time `json:"transfer_date"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+
+	// Transaksi pengeluaran biaya admin, kosong jika Fee = 0
+	FeeTransactionID *int64 `json:"fee_transaction_id,omitempty"`
+	FeeCategoryID    *int64 `json:"fee_category_id,omitempty"`
+}
+
+// UpsertTransferRequest: FeeCategoryID (kategori pengeluaran) wajib diisi jika Fee > 0.
+type UpsertTransferRequest struct {
+	FromWalletID  int64      `json:"from_wallet_id" binding:"required,gt=0"`
+	ToWalletID    int64      `json:"to_wallet_id" binding:"required,gt=0,nefield=FromWalletID"`
+	Amount        int64      `json:"amount" binding:"required,gt=0"`
+	Fee           int64      `json:"fee" binding:"gte=0"`
+	FeeCategoryID *int64     `json:"fee_category_id" binding:"omitempty,gt=0"`
+	Description   *string    `json:"description"`
+	TransferDate  *time.Time `json:"transfer_date"`
+}
+
+// TransferFilter adalah parameter paginasi daftar transfer.
+type TransferFilter struct {
+	Cursor string `form:"cursor"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100"`
+
+	// After diisi service dari Cursor, tidak dibaca dari query string
+	After *TransferCursor `form:"-"`
+}
+
+// TransferCursor menandai posisi terakhir pada urutan (transfer_date DESC, id DESC).
+type TransferCursor struct {
+	TransferDate time.Time
+	ID           int64
+}
+
+func (c TransferCursor) Encode() string {
+	return encodeCursor(c.TransferDate, c.ID)
+}
+
+func DecodeTransferCursor(s string) (*TransferCursor, error) {
+	date, id, err := decodeCursor(s)
+	if err != nil {
+		return nil, err
+	}
+	return &TransferCursor{TransferDate: date, ID: id}, nil
+}
+
+type TransferPage struct {
+	Data       []Transfer `json:"data"`
+	NextCursor *string    `json:"next_cursor"`
+}
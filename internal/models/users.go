@@ -7,9 +7,38 @@ import (
 )
 
 type User struct {
-	ID           uuid.UUID `json:"id"`
-	Name         string    `db:"name"`
-	Email        string    `db:"email"`
-	PasswordHash string    `db:"-"`
-	CreatedAt    time.Time `db:"created_at"`
+	ID              uuid.UUID  `json:"id"`
+	Name            string     `db:"name"`
+	Email           string     `db:"email"`
+	PasswordHash    string     `json:"-" db:"-"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at" db:"email_verified_at"`
+	CreatedAt       time.Time  `db:"created_at"`
+}
+
+// EmailVerified bernilai true jika email user saat ini sudah diverifikasi.
+func (u *User) EmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+// UpdateProfileRequest: field yang tidak dikirim tidak diubah. Mengganti email membuat akun
+// kembali belum terverifikasi sampai email baru dikonfirmasi.
+type UpdateProfileRequest struct {
+	Name  *string `json:"name" binding:"omitempty,max=100"`
+	Email *string `json:"email" binding:"omitempty,email"`
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// EmailVerificationToken adalah token verifikasi sekali pakai untuk satu alamat email;
+// hanya hash-nya yang disimpan.
+type EmailVerificationToken struct {
+	ID        int64
+	UserID    uuid.UUID
+	Email     string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
 }
@@ -7,12 +7,18 @@ import (
 )
 
 type Wallet struct {
-	ID        int64     `json:"id"`
-	UserID    uuid.UUID `json:"-"`
-	Name      string    `json:"name"`
-	Balance   int64     `json:"balance"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID         int64      `json:"id"`
+	UserID     uuid.UUID  `json:"-"`
+	Name       string     `json:"name"`
+	Balance    int64      `json:"balance"`
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// IsArchived menandakan dompet sudah diarsipkan dan tidak boleh menerima transaksi baru.
+func (w *Wallet) IsArchived() bool {
+	return w.ArchivedAt != nil
 }
 
 type CreateWalletRequest struct {
@@ -23,3 +29,10 @@ type CreateWalletRequest struct {
 type UpdateWalletRequest struct {
 	Name string `json:"name" binding:"required,min=3,max=100"`
 }
+
+// DeleteWalletQuery menentukan apa yang terjadi pada riwayat dompet saat dihapus.
+// Tanpa opsi, penghapusan ditolak jika dompet masih memiliki transaksi.
+type DeleteWalletQuery struct {
+	ReassignTo int64 `form:"reassign_to" binding:"omitempty,gt=0"`
+	Archive    bool  `form:"archive"`
+}
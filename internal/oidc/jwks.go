@@ -0,0 +1,144 @@
+package oidc
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// minRSABits adalah ukuran kunci RSA provider terkecil yang diterima
+const minRSABits = 2048
+
+var errUnsupportedKey = errors.New("unsupported jwk")
+
+type jsonWebKey struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+
+	// RSA
+	N string `json:"n"`
+	E string `json:"e"`
+
+	// EC dan OKP
+	Curve string `json:"crv"`
+	X     string `json:"x"`
+	Y     string `json:"y"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+// verificationKey adalah public key provider beserta algoritma yang boleh memakainya.
+type verificationKey struct {
+	id         string
+	algorithms []string
+	key        interface{}
+}
+
+func (k verificationKey) allows(alg string) bool {
+	for _, a := range k.algorithms {
+		if a == alg {
+			return true
+		}
+	}
+	return false
+}
+
+// parseJWK mengubah JWK menjadi public key untuk golang-jwt. Algoritma dibatasi sesuai jenis
+// kunci sehingga kunci RSA tidak bisa dipakai untuk token ES256 dan sebaliknya.
+func parseJWK(jwk jsonWebKey) (verificationKey, error) {
+	if jwk.Use != "" && jwk.Use != "sig" {
+		return verificationKey{}, fmt.Errorf("%w: use %q", errUnsupportedKey, jwk.Use)
+	}
+
+	var (
+		key        interface{}
+		algorithms []string
+	)
+	switch jwk.KeyType {
+	case "RSA":
+		n, err := decodeBigInt(jwk.N)
+		if err != nil {
+			return verificationKey{}, err
+		}
+		e, err := decodeBigInt(jwk.E)
+		if err != nil {
+			return verificationKey{}, err
+		}
+		if n.BitLen() < minRSABits || !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 {
+			return verificationKey{}, fmt.Errorf("%w: weak rsa key", errUnsupportedKey)
+		}
+		key = &rsa.PublicKey{N: n, E: int(e.Int64())}
+		algorithms = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512"}
+	case "EC":
+		curve, alg := ellipticCurve(jwk.Curve)
+		if curve == nil {
+			return verificationKey{}, fmt.Errorf("%w: curve %q", errUnsupportedKey, jwk.Curve)
+		}
+		x, err := decodeBigInt(jwk.X)
+		if err != nil {
+			return verificationKey{}, err
+		}
+		y, err := decodeBigInt(jwk.Y)
+		if err != nil {
+			return verificationKey{}, err
+		}
+		public := &ecdsa.PublicKey{Curve: curve, X: x, Y: y}
+		if _, err := public.ECDH(); err != nil {
+			return verificationKey{}, fmt.Errorf("%w: point not on curve", errUnsupportedKey)
+		}
+		key = public
+		algorithms = []string{alg}
+	case "OKP":
+		if jwk.Curve != "Ed25519" {
+			return verificationKey{}, fmt.Errorf("%w: curve %q", errUnsupportedKey, jwk.Curve)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return verificationKey{}, fmt.Errorf("%w: invalid ed25519 key", errUnsupportedKey)
+		}
+		key = ed25519.PublicKey(x)
+		algorithms = []string{"EdDSA"}
+	default:
+		return verificationKey{}, fmt.Errorf("%w: kty %q", errUnsupportedKey, jwk.KeyType)
+	}
+
+	// Jika JWK menyebut alg, hanya alg itu yang boleh dipakai
+	if jwk.Algorithm != "" {
+		allowed := verificationKey{algorithms: algorithms}
+		if !allowed.allows(jwk.Algorithm) {
+			return verificationKey{}, fmt.Errorf("%w: alg %q for kty %q", errUnsupportedKey, jwk.Algorithm, jwk.KeyType)
+		}
+		algorithms = []string{jwk.Algorithm}
+	}
+
+	return verificationKey{id: jwk.KeyID, algorithms: algorithms, key: key}, nil
+}
+
+func ellipticCurve(name string) (elliptic.Curve, string) {
+	switch name {
+	case "P-256":
+		return elliptic.P256(), "ES256"
+	case "P-384":
+		return elliptic.P384(), "ES384"
+	case "P-521":
+		return elliptic.P521(), "ES512"
+	}
+	return nil, ""
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(b) == 0 {
+		return nil, fmt.Errorf("%w: invalid base64url integer", errUnsupportedKey)
+	}
+	return new(big.Int).SetBytes(b), nil
+}
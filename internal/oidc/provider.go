@@ -0,0 +1,380 @@
+// Package oidc adalah relying party OpenID Connect minimal untuk alur authorization code
+// dengan PKCE: discovery, penukaran code, dan verifikasi ID token terhadap JWKS provider.
+package oidc
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// jwksRefreshInterval membatasi seberapa sering JWKS diambil ulang karena kid tidak dikenal,
+// supaya token dengan kid asal-asalan tidak bisa membanjiri provider.
+const jwksRefreshInterval = time.Minute
+
+// maxResponseSize membatasi ukuran respons discovery, JWKS dan token endpoint
+const maxResponseSize = 1 << 20
+
+var (
+	ErrDiscovery         = errors.New("oidc discovery failed")
+	ErrInvalidIDToken    = errors.New("invalid id token")
+	ErrUnknownSigningKey = errors.New("unknown id token signing key")
+)
+
+// signingAlgorithms adalah algoritma ID token yang diterima; "none" dan HMAC tidak pernah
+// diterima karena client secret bukan kunci verifikasi yang layak.
+var signingAlgorithms = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+
+// Config adalah pendaftaran aplikasi ini di satu identity provider.
+type Config struct {
+	Name         string
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+// Metadata adalah bagian dokumen /.well-known/openid-configuration yang dipakai.
+type Metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// TokenError adalah respons error dari token endpoint (RFC 6749 bagian 5.2).
+type TokenError struct {
+	StatusCode  int
+	Code        string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (e *TokenError) Error() string {
+	if e.Description != "" {
+		return fmt.Sprintf("oidc token endpoint: %s: %s", e.Code, e.Description)
+	}
+	return fmt.Sprintf("oidc token endpoint: %s (status %d)", e.Code, e.StatusCode)
+}
+
+// IDToken adalah identitas user yang sudah diverifikasi dari ID token.
+type IDToken struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Locale        string
+}
+
+// Provider menyimpan metadata discovery dan JWKS satu provider. Discovery dilakukan saat
+// pertama dibutuhkan, sehingga aplikasi tetap bisa start walaupun provider sedang tidak bisa
+// dihubungi. Aman dipakai bersamaan dari banyak goroutine.
+type Provider struct {
+	config     Config
+	httpClient *http.Client
+	now        func() time.Time
+
+	mu            sync.Mutex
+	metadata      *Metadata
+	keys          []verificationKey
+	keysFetchedAt time.Time
+}
+
+func NewProvider(config Config, httpClient *http.Client) *Provider {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	if len(config.Scopes) == 0 {
+		config.Scopes = []string{"openid", "email", "profile"}
+	}
+	return &Provider{config: config, httpClient: httpClient, now: time.Now}
+}
+
+func (p *Provider) Name() string {
+	return p.config.Name
+}
+
+// AuthCodeURL menyusun URL authorization endpoint untuk mengarahkan user ke provider.
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	metadata, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	authURL, err := url.Parse(metadata.AuthorizationEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("%w: invalid authorization_endpoint", ErrDiscovery)
+	}
+
+	query := authURL.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", p.config.ClientID)
+	query.Set("redirect_uri", p.config.RedirectURL)
+	query.Set("scope", strings.Join(p.config.Scopes, " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", codeChallenge)
+	query.Set("code_challenge_method", "S256")
+	authURL.RawQuery = query.Encode()
+
+	return authURL.String(), nil
+}
+
+// Exchange menukar authorization code (beserta code_verifier PKCE-nya) di token endpoint lalu
+// memverifikasi ID token yang dikembalikan, termasuk nonce yang dikirim di AuthCodeURL.
+func (p *Provider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*IDToken, error) {
+	metadata, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.config.RedirectURL)
+	form.Set("code_verifier", codeVerifier)
+	if p.config.ClientSecret == "" {
+		// Public client: tanpa secret, client_id dikirim di body
+		form.Set("client_id", p.config.ClientID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, metadata.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.config.ClientSecret != "" {
+		// client_secret_basic; id dan secret di-encode dulu sesuai RFC 6749 bagian 2.3.1
+		req.SetBasicAuth(url.QueryEscape(p.config.ClientID), url.QueryEscape(p.config.ClientSecret))
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		tokenErr := &TokenError{StatusCode: resp.StatusCode}
+		if json.Unmarshal(body, tokenErr) != nil || tokenErr.Code == "" {
+			tokenErr.Code = "invalid_response"
+		}
+		return nil, tokenErr
+	}
+
+	var tokenResp struct {
+		IDToken string `json:"id_token"`
+	}
+	if err := json.Unmarshal(body, &tokenResp); err != nil {
+		return nil, fmt.Errorf("oidc token endpoint: invalid response: %w", err)
+	}
+	if tokenResp.IDToken == "" {
+		return nil, fmt.Errorf("%w: token response has no id_token", ErrInvalidIDToken)
+	}
+
+	return p.VerifyIDToken(ctx, tokenResp.IDToken, nonce)
+}
+
+// idTokenClaims adalah claim ID token yang dibaca. email_verified dikirim sebagian provider
+// sebagai string, sehingga dibaca lewat flexibleBool.
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce           string       `json:"nonce"`
+	AuthorizedParty string       `json:"azp"`
+	Email           string       `json:"email"`
+	EmailVerified   flexibleBool `json:"email_verified"`
+	Name            string       `json:"name"`
+	Locale          string       `json:"locale"`
+}
+
+type flexibleBool bool
+
+func (b *flexibleBool) UnmarshalJSON(data []byte) error {
+	switch string(data) {
+	case "true", `"true"`:
+		*b = true
+	case "false", `"false"`, "null":
+		*b = false
+	default:
+		return fmt.Errorf("invalid boolean %s", data)
+	}
+	return nil
+}
+
+// VerifyIDToken memeriksa tanda tangan ID token terhadap JWKS provider, lalu iss, aud, exp
+// dan nonce (OpenID Connect Core bagian 3.1.3.7).
+func (p *Provider) VerifyIDToken(ctx context.Context, raw, nonce string) (*IDToken, error) {
+	metadata, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := &idTokenClaims{}
+	_, err = jwt.ParseWithClaims(raw, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.verificationKey(ctx, kid, token.Method.Alg())
+	},
+		jwt.WithValidMethods(signingAlgorithms),
+		jwt.WithIssuer(metadata.Issuer),
+		jwt.WithAudience(p.config.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(time.Minute),
+		jwt.WithTimeFunc(p.now),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidIDToken, err)
+	}
+
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: missing sub", ErrInvalidIDToken)
+	}
+	if len(claims.Audience) > 1 && claims.AuthorizedParty != p.config.ClientID {
+		return nil, fmt.Errorf("%w: azp does not match client", ErrInvalidIDToken)
+	}
+	if subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1 {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidIDToken)
+	}
+
+	return &IDToken{
+		Issuer:        claims.Issuer,
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: bool(claims.EmailVerified),
+		Name:          claims.Name,
+		Locale:        claims.Locale,
+	}, nil
+}
+
+// verificationKey mencari kunci untuk kid dan alg token. Jika tidak ditemukan, JWKS diambil
+// ulang (paling sering sekali per jwksRefreshInterval) karena provider mungkin baru merotasi
+// kuncinya.
+func (p *Provider) verificationKey(ctx context.Context, kid, alg string) (interface{}, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok := findKey(p.keys, kid, alg); ok {
+		return key, nil
+	}
+
+	if !p.keysFetchedAt.IsZero() && p.now().Sub(p.keysFetchedAt) < jwksRefreshInterval {
+		return nil, ErrUnknownSigningKey
+	}
+
+	keys, err := p.fetchKeys(ctx, p.metadata.JWKSURI)
+	if err != nil {
+		return nil, err
+	}
+	p.keys = keys
+	p.keysFetchedAt = p.now()
+
+	if key, ok := findKey(p.keys, kid, alg); ok {
+		return key, nil
+	}
+	return nil, ErrUnknownSigningKey
+}
+
+// findKey memilih kunci dengan kid yang sama. Token tanpa kid hanya diterima jika tepat satu
+// kunci cocok dengan alg-nya.
+func findKey(keys []verificationKey, kid, alg string) (interface{}, bool) {
+	var match *verificationKey
+	for i := range keys {
+		if !keys[i].allows(alg) {
+			continue
+		}
+		if kid != "" && keys[i].id == kid {
+			return keys[i].key, true
+		}
+		if kid == "" {
+			if match != nil {
+				return nil, false
+			}
+			match = &keys[i]
+		}
+	}
+	if match != nil {
+		return match.key, true
+	}
+	return nil, false
+}
+
+func (p *Provider) fetchKeys(ctx context.Context, jwksURI string) ([]verificationKey, error) {
+	var set jsonWebKeySet
+	if err := p.getJSON(ctx, jwksURI, &set); err != nil {
+		return nil, fmt.Errorf("oidc jwks: %w", err)
+	}
+
+	keys := make([]verificationKey, 0, len(set.Keys))
+	for _, jwk := range set.Keys {
+		key, err := parseJWK(jwk)
+		if err != nil {
+			// Kunci yang tidak didukung (mis. kunci enkripsi) dilewati, bukan menggagalkan semua
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// discover mengambil dokumen discovery sekali lalu menyimpannya. Issuer di dokumen wajib sama
+// dengan IssuerURL yang dikonfigurasi (OpenID Connect Discovery bagian 4.3).
+func (p *Provider) discover(ctx context.Context) (*Metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.metadata != nil {
+		return p.metadata, nil
+	}
+
+	wellKnown := strings.TrimSuffix(p.config.IssuerURL, "/") + "/.well-known/openid-configuration"
+	metadata := &Metadata{}
+	if err := p.getJSON(ctx, wellKnown, metadata); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrDiscovery, err)
+	}
+
+	if strings.TrimSuffix(metadata.Issuer, "/") != strings.TrimSuffix(p.config.IssuerURL, "/") {
+		return nil, fmt.Errorf("%w: issuer %q does not match %q", ErrDiscovery, metadata.Issuer, p.config.IssuerURL)
+	}
+	if metadata.AuthorizationEndpoint == "" || metadata.TokenEndpoint == "" || metadata.JWKSURI == "" {
+		return nil, fmt.Errorf("%w: incomplete provider metadata", ErrDiscovery)
+	}
+
+	p.metadata = metadata
+	return metadata, nil
+}
+
+func (p *Provider) getJSON(ctx context.Context, target string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: status %d", target, resp.StatusCode)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(v)
+}
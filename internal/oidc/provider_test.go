@@ -0,0 +1,192 @@
+package oidc_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Udean777/uang-bijak-go/internal/oidc"
+	"github.com/Udean777/uang-bijak-go/internal/oidc/oidctest"
+)
+
+var testUser = oidctest.User{
+	Subject:       "provider-user-1",
+	Email:         "budi@example.com",
+	EmailVerified: true,
+	Name:          "Budi",
+}
+
+func newTestProvider(mock *oidctest.Provider) *oidc.Provider {
+	return oidc.NewProvider(oidc.Config{
+		Name:         "mock",
+		IssuerURL:    mock.Issuer(),
+		ClientID:     oidctest.ClientID,
+		ClientSecret: oidctest.ClientSecret,
+		RedirectURL:  "http://localhost:3000/auth/oidc/mock/callback",
+	}, nil)
+}
+
+func TestProvider_AuthorizationCodeFlow(t *testing.T) {
+	// 1. Setup
+	mock := oidctest.NewProvider(t)
+	provider := newTestProvider(mock)
+	ctx := context.Background()
+
+	verifier, err := oidc.NewCodeVerifier()
+	assert.NoError(t, err)
+
+	authURL, err := provider.AuthCodeURL(ctx, "state-123", "nonce-123", oidc.CodeChallengeS256(verifier))
+	assert.NoError(t, err)
+
+	parsed, _ := url.Parse(authURL)
+	assert.Equal(t, mock.Issuer()+"/authorize", parsed.Scheme+"://"+parsed.Host+parsed.Path)
+	assert.Equal(t, "openid email profile", parsed.Query().Get("scope"))
+
+	code, state, err := mock.Authorize(authURL, testUser)
+	assert.NoError(t, err)
+	assert.Equal(t, "state-123", state)
+
+	// 2. Act
+	idToken, err := provider.Exchange(ctx, code, verifier, "nonce-123")
+
+	// 3. Assert
+	assert.NoError(t, err)
+	assert.Equal(t, mock.Issuer(), idToken.Issuer)
+	assert.Equal(t, testUser.Subject, idToken.Subject)
+	assert.Equal(t, testUser.Email, idToken.Email)
+	assert.True(t, idToken.EmailVerified)
+	assert.Equal(t, testUser.Name, idToken.Name)
+}
+
+func TestProvider_Exchange_WrongCodeVerifier(t *testing.T) {
+	// 1. Setup
+	mock := oidctest.NewProvider(t)
+	provider := newTestProvider(mock)
+	ctx := context.Background()
+
+	verifier, _ := oidc.NewCodeVerifier()
+	otherVerifier, _ := oidc.NewCodeVerifier()
+	authURL, _ := provider.AuthCodeURL(ctx, "state", "nonce", oidc.CodeChallengeS256(verifier))
+	code, _, _ := mock.Authorize(authURL, testUser)
+
+	// 2. Act
+	idToken, err := provider.Exchange(ctx, code, otherVerifier, "nonce")
+
+	// 3. Assert
+	assert.Nil(t, idToken)
+	var tokenErr *oidc.TokenError
+	assert.True(t, errors.As(err, &tokenErr))
+	assert.Equal(t, "invalid_grant", tokenErr.Code)
+}
+
+func TestProvider_Exchange_NonceMismatch(t *testing.T) {
+	// 1. Setup
+	mock := oidctest.NewProvider(t)
+	provider := newTestProvider(mock)
+	ctx := context.Background()
+
+	verifier, _ := oidc.NewCodeVerifier()
+	authURL, _ := provider.AuthCodeURL(ctx, "state", "nonce-asli", oidc.CodeChallengeS256(verifier))
+	code, _, _ := mock.Authorize(authURL, testUser)
+
+	// 2. Act
+	idToken, err := provider.Exchange(ctx, code, verifier, "nonce-lain")
+
+	// 3. Assert
+	assert.Nil(t, idToken)
+	assert.ErrorIs(t, err, oidc.ErrInvalidIDToken)
+}
+
+func TestProvider_VerifyIDToken(t *testing.T) {
+	mock := oidctest.NewProvider(t)
+	provider := newTestProvider(mock)
+	ctx := context.Background()
+
+	valid := mock.IDTokenClaims(testUser, "nonce")
+	with := func(key string, value interface{}) jwt.MapClaims {
+		claims := jwt.MapClaims{}
+		for k, v := range valid {
+			claims[k] = v
+		}
+		if value == nil {
+			delete(claims, key)
+		} else {
+			claims[key] = value
+		}
+		return claims
+	}
+
+	hmacToken, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, valid).SignedString([]byte(oidctest.ClientSecret))
+	noneToken, _ := jwt.NewWithClaims(jwt.SigningMethodNone, valid).SignedString(jwt.UnsafeAllowNoneSignatureType)
+
+	tests := []struct {
+		name  string
+		token string
+		valid bool
+	}{
+		{"valid", mock.SignIDToken(valid), true},
+		{"audience lain", mock.SignIDToken(with("aud", "client-lain")), false},
+		{"issuer lain", mock.SignIDToken(with("iss", "https://evil.example.com")), false},
+		{"kedaluwarsa", mock.SignIDToken(with("exp", time.Now().Add(-time.Hour).Unix())), false},
+		{"tanpa exp", mock.SignIDToken(with("exp", nil)), false},
+		{"tanpa sub", mock.SignIDToken(with("sub", nil)), false},
+		{"multi audience tanpa azp", mock.SignIDToken(with("aud", []string{oidctest.ClientID, "client-lain"})), false},
+		{"ditandatangani dengan client secret", hmacToken, false},
+		{"alg none", noneToken, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idToken, err := provider.VerifyIDToken(ctx, tt.token, "nonce")
+			if tt.valid {
+				assert.NoError(t, err)
+				assert.Equal(t, testUser.Subject, idToken.Subject)
+			} else {
+				assert.ErrorIs(t, err, oidc.ErrInvalidIDToken)
+				assert.Nil(t, idToken)
+			}
+		})
+	}
+}
+
+func TestProvider_EmailVerifiedAsString(t *testing.T) {
+	// 1. Setup
+	mock := oidctest.NewProvider(t)
+	provider := newTestProvider(mock)
+
+	claims := mock.IDTokenClaims(testUser, "nonce")
+	claims["email_verified"] = "true"
+
+	// 2. Act
+	idToken, err := provider.VerifyIDToken(context.Background(), mock.SignIDToken(claims), "nonce")
+
+	// 3. Assert
+	assert.NoError(t, err)
+	assert.True(t, idToken.EmailVerified)
+}
+
+func TestProvider_DiscoveryIssuerMismatch(t *testing.T) {
+	// 1. Setup
+	mock := oidctest.NewProvider(t)
+	provider := oidc.NewProvider(oidc.Config{
+		Name:      "mock",
+		IssuerURL: mock.Issuer() + "/tenant-lain",
+		ClientID:  oidctest.ClientID,
+	}, nil)
+
+	// 2. Act
+	_, err := provider.AuthCodeURL(context.Background(), "state", "nonce", "challenge")
+
+	// 3. Assert
+	assert.ErrorIs(t, err, oidc.ErrDiscovery)
+}
+
+func TestCodeChallengeS256(t *testing.T) {
+	// Contoh dari RFC 7636 lampiran B
+	assert.Equal(t, "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM", oidc.CodeChallengeS256("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"))
+}
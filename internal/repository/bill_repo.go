@@ -0,0 +1,200 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type BillRepository interface {
+	Create(ctx context.Context, bill *models.Bill) error
+	GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]models.Bill, error)
+	Update(ctx context.Context, bill *models.Bill) error
+	Delete(ctx context.Context, id int64) error
+	GetDueForReminder(ctx context.Context, now time.Time, limit int) ([]models.Bill, error)
+	GetForUpdateTx(ctx context.Context, tx pgx.Tx, id int64) (*models.Bill, error)
+	MarkPaidTx(ctx context.Context, tx pgx.Tx, id int64, paidCount int, dueDate *time.Time) error
+	CountByWalletID(ctx context.Context, walletID int64) (int64, error)
+	ReassignWalletTx(ctx context.Context, tx pgx.Tx, fromWalletID int64, toWalletID int64) error
+	CountByCategoryID(ctx context.Context, categoryID int64) (int64, error)
+	ReassignCategoryTx(ctx context.Context, tx pgx.Tx, fromCategoryID int64, toCategoryID int64) error
+
+	// Helper untuk mengecek kepemilikan
+	CheckOwnership(ctx context.Context, billID int64, userID uuid.UUID) (*models.Bill, error)
+}
+
+type billRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewBillRepository(db *pgxpool.Pool) BillRepository {
+	return &billRepository{db: db}
+}
+
+const billColumns = `b.id, b.user_id, b.wallet_id, b.category_id, b.name, b.amount, 
+	b.frequency, b.repeat_interval, b.start_date, b.end_date, b.count, 
+	b.remind_days_before, b.paid_count, b.due_date, b.created_at, b.updated_at`
+
+func scanBill(row pgx.Row, extra ...any) (*models.Bill, error) {
+	var b models.Bill
+	dest := []any{
+		&b.ID, &b.UserID, &b.WalletID, &b.CategoryID, &b.Name, &b.Amount,
+		&b.Frequency, &b.Interval, &b.StartDate, &b.EndDate, &b.Count,
+		&b.RemindDaysBefore, &b.PaidCount, &b.DueDate, &b.CreatedAt, &b.UpdatedAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+func (r *billRepository) queryBills(ctx context.Context, query string, args ...any) ([]models.Bill, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var bills []models.Bill
+	for rows.Next() {
+		b, err := scanBill(rows)
+		if err != nil {
+			return nil, err
+		}
+		bills = append(bills, *b)
+	}
+
+	return bills, rows.Err()
+}
+
+func (r *billRepository) Create(ctx context.Context, b *models.Bill) error {
+	query := `INSERT INTO bills 
+	          (user_id, wallet_id, category_id, name, amount, frequency, repeat_interval, start_date, end_date, count, remind_days_before, due_date) 
+	          VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) 
+	          RETURNING id, created_at, updated_at`
+
+	return r.db.QueryRow(ctx, query,
+		b.UserID, b.WalletID, b.CategoryID, b.Name, b.Amount,
+		b.Frequency, b.Interval, b.StartDate, b.EndDate, b.Count, b.RemindDaysBefore, b.DueDate,
+	).Scan(&b.ID, &b.CreatedAt, &b.UpdatedAt)
+}
+
+func (r *billRepository) GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]models.Bill, error) {
+	query := `SELECT ` + billColumns + `, w.name, c.name 
+	          FROM bills b 
+	          JOIN wallets w ON w.id = b.wallet_id 
+	          JOIN categories c ON c.id = b.category_id 
+	          WHERE b.user_id = $1 
+	          ORDER BY b.due_date ASC NULLS LAST, b.id ASC`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var bills []models.Bill
+	for rows.Next() {
+		var walletName, categoryName string
+		b, err := scanBill(rows, &walletName, &categoryName)
+		if err != nil {
+			return nil, err
+		}
+		b.WalletName = walletName
+		b.CategoryName = categoryName
+		bills = append(bills, *b)
+	}
+
+	return bills, rows.Err()
+}
+
+// Update menyimpan detail tagihan, batas akhir jadwal, dan due_date yang sudah dihitung ulang.
+func (r *billRepository) Update(ctx context.Context, b *models.Bill) error {
+	query := `UPDATE bills 
+	          SET wallet_id = $1, category_id = $2, name = $3, amount = $4, end_date = $5, count = $6, 
+	              remind_days_before = $7, due_date = $8, updated_at = NOW() 
+	          WHERE id = $9 
+	          RETURNING updated_at`
+
+	return r.db.QueryRow(ctx, query,
+		b.WalletID, b.CategoryID, b.Name, b.Amount, b.EndDate, b.Count,
+		b.RemindDaysBefore, b.DueDate, b.ID,
+	).Scan(&b.UpdatedAt)
+}
+
+func (r *billRepository) Delete(ctx context.Context, id int64) error {
+	query := `DELETE FROM bills WHERE id = $1`
+	_, err := r.db.Exec(ctx, query, id)
+	return err
+}
+
+// GetDueForReminder mengembalikan tagihan yang sudah masuk masa pengingat (due_date - N hari <= now)
+// dan belum diingatkan untuk jatuh tempo tersebut. Format dedup_key harus sama dengan
+// models.BillReminderDedupKey.
+func (r *billRepository) GetDueForReminder(ctx context.Context, now time.Time, limit int) ([]models.Bill, error) {
+	query := `SELECT ` + billColumns + ` 
+	          FROM bills b 
+	          WHERE b.due_date IS NOT NULL 
+	            AND b.due_date - make_interval(days => b.remind_days_before) <= $1 
+	            AND NOT EXISTS (
+	                SELECT 1 FROM notifications n 
+	                WHERE n.user_id = b.user_id AND n.dedup_key = 'bill:' || b.id || ':' || b.paid_count
+	            ) 
+	          ORDER BY b.due_date ASC 
+	          LIMIT $2`
+
+	return r.queryBills(ctx, query, now, limit)
+}
+
+// GetForUpdateTx mengunci tagihan selama tx agar satu jatuh tempo tidak dibayar dua kali.
+func (r *billRepository) GetForUpdateTx(ctx context.Context, tx pgx.Tx, id int64) (*models.Bill, error) {
+	query := `SELECT ` + billColumns + ` FROM bills b WHERE b.id = $1 FOR UPDATE`
+	return scanBill(tx.QueryRow(ctx, query, id))
+}
+
+func (r *billRepository) MarkPaidTx(ctx context.Context, tx pgx.Tx, id int64, paidCount int, dueDate *time.Time) error {
+	query := `UPDATE bills SET paid_count = $1, due_date = $2, updated_at = NOW() WHERE id = $3`
+	_, err := tx.Exec(ctx, query, paidCount, dueDate, id)
+	return err
+}
+
+func (r *billRepository) CountByWalletID(ctx context.Context, walletID int64) (int64, error) {
+	query := `SELECT COUNT(*) FROM bills WHERE wallet_id = $1`
+
+	var count int64
+	err := r.db.QueryRow(ctx, query, walletID).Scan(&count)
+	return count, err
+}
+
+// ReassignWalletTx memindahkan tagihan ke dompet lain agar tidak ikut terhapus (ON DELETE CASCADE)
+// saat dompet asal dihapus.
+func (r *billRepository) ReassignWalletTx(ctx context.Context, tx pgx.Tx, fromWalletID int64, toWalletID int64) error {
+	query := `UPDATE bills SET wallet_id = $1, updated_at = NOW() WHERE wallet_id = $2`
+	_, err := tx.Exec(ctx, query, toWalletID, fromWalletID)
+	return err
+}
+
+func (r *billRepository) CountByCategoryID(ctx context.Context, categoryID int64) (int64, error) {
+	query := `SELECT COUNT(*) FROM bills WHERE category_id = $1`
+
+	var count int64
+	err := r.db.QueryRow(ctx, query, categoryID).Scan(&count)
+	return count, err
+}
+
+// ReassignCategoryTx memindahkan tagihan ke kategori lain agar tidak ikut terhapus (ON DELETE CASCADE)
+// saat kategori asal dihapus.
+func (r *billRepository) ReassignCategoryTx(ctx context.Context, tx pgx.Tx, fromCategoryID int64, toCategoryID int64) error {
+	query := `UPDATE bills SET category_id = $1, updated_at = NOW() WHERE category_id = $2`
+	_, err := tx.Exec(ctx, query, toCategoryID, fromCategoryID)
+	return err
+}
+
+func (r *billRepository) CheckOwnership(ctx context.Context, billID int64, userID uuid.UUID) (*models.Bill, error) {
+	query := `SELECT ` + billColumns + ` FROM bills b WHERE b.id = $1 AND b.user_id = $2`
+	return scanBill(r.db.QueryRow(ctx, query, billID, userID))
+}
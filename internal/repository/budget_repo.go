@@ -0,0 +1,216 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type BudgetRepository interface {
+	Create(ctx context.Context, budget *models.Budget) error
+	GetAllByUserIDAndPeriod(ctx context.Context, userID uuid.UUID, year int, month int) ([]models.Budget, error)
+	GetRolloverHistory(ctx context.Context, userID uuid.UUID, year int, month int, months int) ([]models.Budget, error)
+	GetUsageForCategoryTx(ctx context.Context, tx pgx.Tx, userID uuid.UUID, categoryID int64, year int, month int, startTime time.Time, endTime time.Time) ([]models.BudgetUsage, error)
+	Update(ctx context.Context, id int64, amount int64, rollover bool, alertThresholds []int) error
+	Delete(ctx context.Context, id int64) error
+	CountByCategoryID(ctx context.Context, categoryID int64) (int64, error)
+	ReassignCategoryTx(ctx context.Context, tx pgx.Tx, fromCategoryID int64, toCategoryID int64) error
+
+	// Helper untuk mengecek kepemilikan
+	CheckOwnership(ctx context.Context, budgetID int64, userID uuid.UUID) (*models.Budget, error)
+}
+
+type budgetRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewBudgetRepository(db *pgxpool.Pool) BudgetRepository {
+	return &budgetRepository{db: db}
+}
+
+func (r *budgetRepository) Create(ctx context.Context, b *models.Budget) error {
+	query := `INSERT INTO budgets (user_id, category_id, year, month, amount, rollover, alert_thresholds) 
+	          VALUES ($1, $2, $3, $4, $5, $6, $7) 
+	          RETURNING id, created_at, updated_at`
+
+	err := r.db.QueryRow(ctx, query, b.UserID, b.CategoryID, b.Year, b.Month, b.Amount, b.Rollover, b.AlertThresholds).Scan(
+		&b.ID,
+		&b.CreatedAt,
+		&b.UpdatedAt,
+	)
+	if isUniqueViolation(err) {
+		return ErrDuplicate
+	}
+	return err
+}
+
+func (r *budgetRepository) GetAllByUserIDAndPeriod(ctx context.Context, userID uuid.UUID, year int, month int) ([]models.Budget, error) {
+	query := `SELECT b.id, b.category_id, c.name, b.year, b.month, b.amount, b.rollover, b.alert_thresholds, b.created_at, b.updated_at 
+	          FROM budgets b 
+	          JOIN categories c ON c.id = b.category_id 
+	          WHERE b.user_id = $1 AND b.year = $2 AND b.month = $3 
+	          ORDER BY c.name ASC`
+
+	return r.queryBudgets(ctx, query, userID, year, month)
+}
+
+// GetRolloverHistory mengembalikan anggaran ber-rollover pada `months` bulan sebelum (year, month).
+func (r *budgetRepository) GetRolloverHistory(ctx context.Context, userID uuid.UUID, year int, month int, months int) ([]models.Budget, error) {
+	query := `SELECT b.id, b.category_id, c.name, b.year, b.month, b.amount, b.rollover, b.alert_thresholds, b.created_at, b.updated_at 
+	          FROM budgets b 
+	          JOIN categories c ON c.id = b.category_id 
+	          WHERE b.user_id = $1 AND b.rollover 
+	            AND b.year * 12 + b.month - 1 >= $2 - $3 
+	            AND b.year * 12 + b.month - 1 < $2 
+	          ORDER BY b.year ASC, b.month ASC`
+
+	return r.queryBudgets(ctx, query, userID, models.PeriodIndex(year, month), months)
+}
+
+func (r *budgetRepository) queryBudgets(ctx context.Context, query string, args ...any) ([]models.Budget, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var budgets []models.Budget
+	for rows.Next() {
+		var b models.Budget
+		err := rows.Scan(
+			&b.ID, &b.CategoryID, &b.CategoryName, &b.Year, &b.Month,
+			&b.Amount, &b.Rollover, &b.AlertThresholds, &b.CreatedAt, &b.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		budgets = append(budgets, b)
+	}
+
+	return budgets, rows.Err()
+}
+
+// GetUsageForCategoryTx mengembalikan anggaran (year, month) milik kategori dan seluruh leluhurnya,
+// masing-masing dengan total pengeluaran antara startTime dan endTime (batas bulan keuangan user)
+// termasuk sub-kategori. Dibaca di dalam tx agar transaksi yang baru dibuat ikut terhitung.
+func (r *budgetRepository) GetUsageForCategoryTx(ctx context.Context, tx pgx.Tx, userID uuid.UUID, categoryID int64, year int, month int, startTime time.Time, endTime time.Time) ([]models.BudgetUsage, error) {
+	query := `WITH RECURSIVE ancestors AS (
+	              SELECT id, parent_id, 0 AS depth FROM categories WHERE id = $2 
+	              UNION ALL 
+	              SELECT c.id, c.parent_id, a.depth + 1 
+	              FROM categories c JOIN ancestors a ON c.id = a.parent_id 
+	              WHERE a.depth < $7
+	          ), subtree AS (
+	              SELECT b.id AS budget_id, b.category_id, 0 AS depth 
+	              FROM budgets b JOIN ancestors a ON a.id = b.category_id 
+	              WHERE b.user_id = $1 AND b.year = $3 AND b.month = $4 
+	              UNION ALL 
+	              SELECT s.budget_id, c.id, s.depth + 1 
+	              FROM categories c JOIN subtree s ON c.parent_id = s.category_id 
+	              WHERE s.depth < $7
+	          )
+	          SELECT b.id, b.user_id, b.category_id, c.name, b.year, b.month, b.amount, b.rollover, b.alert_thresholds, 
+	                 COALESCE(SUM(t.amount), 0) AS spent 
+	          FROM budgets b 
+	          JOIN categories c ON c.id = b.category_id 
+	          JOIN subtree s ON s.budget_id = b.id 
+	          LEFT JOIN transactions t ON t.category_id = s.category_id 
+	              AND t.type = 'expense' 
+	              AND t.transaction_date >= $5 
+	              AND t.transaction_date <= $6 
+	          GROUP BY b.id, c.name`
+
+	rows, err := tx.Query(ctx, query, userID, categoryID, year, month, startTime, endTime, models.MaxCategoryDepth)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var usages []models.BudgetUsage
+	for rows.Next() {
+		var u models.BudgetUsage
+		err := rows.Scan(
+			&u.ID, &u.UserID, &u.CategoryID, &u.CategoryName, &u.Year, &u.Month,
+			&u.Amount, &u.Rollover, &u.AlertThresholds, &u.Spent,
+		)
+		if err != nil {
+			return nil, err
+		}
+		usages = append(usages, u)
+	}
+
+	return usages, rows.Err()
+}
+
+func (r *budgetRepository) Update(ctx context.Context, id int64, amount int64, rollover bool, alertThresholds []int) error {
+	query := `UPDATE budgets SET amount = $1, rollover = $2, alert_thresholds = $3, updated_at = $4 WHERE id = $5`
+	_, err := r.db.Exec(ctx, query, amount, rollover, alertThresholds, time.Now(), id)
+	return err
+}
+
+func (r *budgetRepository) Delete(ctx context.Context, id int64) error {
+	query := `DELETE FROM budgets WHERE id = $1`
+	_, err := r.db.Exec(ctx, query, id)
+	return err
+}
+
+func (r *budgetRepository) CountByCategoryID(ctx context.Context, categoryID int64) (int64, error) {
+	query := `SELECT COUNT(*) FROM budgets WHERE category_id = $1`
+
+	var count int64
+	err := r.db.QueryRow(ctx, query, categoryID).Scan(&count)
+	return count, err
+}
+
+// ReassignCategoryTx memindahkan anggaran ke kategori lain agar tidak ikut terhapus (ON DELETE CASCADE)
+// saat kategori asal dihapus. Jika target sudah punya anggaran di periode yang sama, nominalnya
+// dijumlahkan (rollover aktif jika salah satunya aktif) dan anggaran asal dihapus, karena
+// (category_id, year, month) unik.
+func (r *budgetRepository) ReassignCategoryTx(ctx context.Context, tx pgx.Tx, fromCategoryID int64, toCategoryID int64) error {
+	mergeQuery := `UPDATE budgets t 
+	               SET amount = t.amount + s.amount, rollover = t.rollover OR s.rollover, updated_at = NOW() 
+	               FROM budgets s 
+	               WHERE s.category_id = $1 AND t.category_id = $2 AND t.year = s.year AND t.month = s.month`
+	if _, err := tx.Exec(ctx, mergeQuery, fromCategoryID, toCategoryID); err != nil {
+		return err
+	}
+
+	deleteQuery := `DELETE FROM budgets s 
+	                USING budgets t 
+	                WHERE s.category_id = $1 AND t.category_id = $2 AND t.year = s.year AND t.month = s.month`
+	if _, err := tx.Exec(ctx, deleteQuery, fromCategoryID, toCategoryID); err != nil {
+		return err
+	}
+
+	moveQuery := `UPDATE budgets SET category_id = $1, updated_at = NOW() WHERE category_id = $2`
+	_, err := tx.Exec(ctx, moveQuery, toCategoryID, fromCategoryID)
+	return err
+}
+
+func (r *budgetRepository) CheckOwnership(ctx context.Context, budgetID int64, userID uuid.UUID) (*models.Budget, error) {
+	query := `SELECT id, user_id, category_id, year, month, amount, rollover, alert_thresholds, created_at, updated_at 
+	          FROM budgets WHERE id = $1 AND user_id = $2`
+	var b models.Budget
+
+	err := r.db.QueryRow(ctx, query, budgetID, userID).Scan(
+		&b.ID,
+		&b.UserID,
+		&b.CategoryID,
+		&b.Year,
+		&b.Month,
+		&b.Amount,
+		&b.Rollover,
+		&b.AlertThresholds,
+		&b.CreatedAt,
+		&b.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
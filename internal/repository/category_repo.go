@@ -6,15 +6,19 @@ import (
 
 	"github.com/Udean777/uang-bijak-go/internal/models"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type CategoryRepository interface {
 	Create(ctx context.Context, category *models.Category) (int64, error)
+	CreateTree(ctx context.Context, userID uuid.UUID, categories []models.Category) error
 	GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]models.Category, error)
 	GetByID(ctx context.Context, id int64) (*models.Category, error)
-	Update(ctx context.Context, id int64, name string) error
+	Update(ctx context.Context, id int64, name string, parentID *int64, kind models.TransactionType) error
 	Delete(ctx context.Context, id int64) error
+	DeleteTx(ctx context.Context, tx pgx.Tx, id int64) error
+	ReparentChildrenTx(ctx context.Context, tx pgx.Tx, parentID int64, newParentID int64) error
 
 	// Helper untuk mengecek kepemilikan
 	CheckOwnership(ctx context.Context, categoryID int64, userID uuid.UUID) (*models.Category, error)
@@ -29,9 +33,9 @@ func NewCategoryRepository(db *pgxpool.Pool) CategoryRepository {
 }
 
 func (r *categoryRepository) Create(ctx context.Context, category *models.Category) (int64, error) {
-	query := `INSERT INTO categories (user_id, name) VALUES ($1, $2) RETURNING id, created_at, updated_at`
+	query := `INSERT INTO categories (user_id, name, parent_id, kind) VALUES ($1, $2, $3, $4) RETURNING id, created_at, updated_at`
 
-	err := r.db.QueryRow(ctx, query, category.UserID, category.Name).Scan(
+	err := r.db.QueryRow(ctx, query, category.UserID, category.Name, category.ParentID, category.Kind).Scan(
 		&category.ID,
 		&category.CreatedAt,
 		&category.UpdatedAt,
@@ -43,8 +47,43 @@ func (r *categoryRepository) Create(ctx context.Context, category *models.Catego
 	return category.ID, nil
 }
 
+// CreateTree menyimpan pohon kategori (beserta Children) dalam satu transaksi.
+func (r *categoryRepository) CreateTree(ctx context.Context, userID uuid.UUID, categories []models.Category) error {
+	return pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		return createCategoryTreeTx(ctx, tx, userID, nil, categories)
+	})
+}
+
+// createCategoryTreeTx menyimpan kategori beserta Children secara rekursif. Kategori tanpa
+// ParentID diletakkan di bawah parentID; ID hasil insert ditulis kembali ke slice.
+func createCategoryTreeTx(ctx context.Context, tx pgx.Tx, userID uuid.UUID, parentID *int64, categories []models.Category) error {
+	query := `INSERT INTO categories (user_id, name, parent_id, kind) VALUES ($1, $2, $3, $4) RETURNING id, created_at, updated_at`
+
+	for i := range categories {
+		cat := &categories[i]
+		cat.UserID = userID
+		if cat.ParentID == nil {
+			cat.ParentID = parentID
+		}
+
+		err := tx.QueryRow(ctx, query, cat.UserID, cat.Name, cat.ParentID, cat.Kind).Scan(
+			&cat.ID,
+			&cat.CreatedAt,
+			&cat.UpdatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		if err := createCategoryTreeTx(ctx, tx, userID, &cat.ID, cat.Children); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (r *categoryRepository) GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]models.Category, error) {
-	query := `SELECT id, name, created_at, updated_at FROM categories WHERE user_id = $1 ORDER BY name ASC`
+	query := `SELECT id, parent_id, name, kind, created_at, updated_at FROM categories WHERE user_id = $1 ORDER BY name ASC`
 
 	rows, err := r.db.Query(ctx, query, userID)
 	if err != nil {
@@ -55,7 +94,7 @@ func (r *categoryRepository) GetAllByUserID(ctx context.Context, userID uuid.UUI
 	var categories []models.Category
 	for rows.Next() {
 		var cat models.Category
-		if err := rows.Scan(&cat.ID, &cat.Name, &cat.CreatedAt, &cat.UpdatedAt); err != nil {
+		if err := rows.Scan(&cat.ID, &cat.ParentID, &cat.Name, &cat.Kind, &cat.CreatedAt, &cat.UpdatedAt); err != nil {
 			return nil, err
 		}
 		categories = append(categories, cat)
@@ -65,13 +104,15 @@ func (r *categoryRepository) GetAllByUserID(ctx context.Context, userID uuid.UUI
 }
 
 func (r *categoryRepository) GetByID(ctx context.Context, id int64) (*models.Category, error) {
-	query := `SELECT id, user_id, name, created_at, updated_at FROM categories WHERE id = $1`
+	query := `SELECT id, user_id, parent_id, name, kind, created_at, updated_at FROM categories WHERE id = $1`
 	var cat models.Category
 
 	err := r.db.QueryRow(ctx, query, id).Scan(
 		&cat.ID,
 		&cat.UserID,
+		&cat.ParentID,
 		&cat.Name,
+		&cat.Kind,
 		&cat.CreatedAt,
 		&cat.UpdatedAt,
 	)
@@ -82,26 +123,48 @@ func (r *categoryRepository) GetByID(ctx context.Context, id int64) (*models.Cat
 	return &cat, nil
 }
 
-func (r *categoryRepository) Update(ctx context.Context, id int64, name string) error {
-	query := `UPDATE categories SET name = $1, updated_at = $2 WHERE id = $3`
-	_, err := r.db.Exec(ctx, query, name, time.Now(), id)
+func (r *categoryRepository) Update(ctx context.Context, id int64, name string, parentID *int64, kind models.TransactionType) error {
+	query := `UPDATE categories SET name = $1, parent_id = $2, kind = $3, updated_at = $4 WHERE id = $5`
+	_, err := r.db.Exec(ctx, query, name, parentID, kind, time.Now(), id)
 	return err
 }
 
 func (r *categoryRepository) Delete(ctx context.Context, id int64) error {
+	return pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		return r.DeleteTx(ctx, tx, id)
+	})
+}
+
+// DeleteTx menghapus kategori; sub-kategorinya naik satu level ke induk kategori yang dihapus.
+func (r *categoryRepository) DeleteTx(ctx context.Context, tx pgx.Tx, id int64) error {
+	reparent := `UPDATE categories SET parent_id = (SELECT parent_id FROM categories WHERE id = $1), updated_at = $2
+	             WHERE parent_id = $1`
+	if _, err := tx.Exec(ctx, reparent, id, time.Now()); err != nil {
+		return err
+	}
+
 	query := `DELETE FROM categories WHERE id = $1`
-	_, err := r.db.Exec(ctx, query, id)
+	_, err := tx.Exec(ctx, query, id)
+	return err
+}
+
+// ReparentChildrenTx memindahkan sub-kategori langsung dari parentID ke bawah newParentID.
+func (r *categoryRepository) ReparentChildrenTx(ctx context.Context, tx pgx.Tx, parentID int64, newParentID int64) error {
+	query := `UPDATE categories SET parent_id = $1, updated_at = $2 WHERE parent_id = $3`
+	_, err := tx.Exec(ctx, query, newParentID, time.Now(), parentID)
 	return err
 }
 
 func (r *categoryRepository) CheckOwnership(ctx context.Context, categoryID int64, userID uuid.UUID) (*models.Category, error) {
-	query := `SELECT id, user_id, name, created_at, updated_at FROM categories WHERE id = $1 AND user_id = $2`
+	query := `SELECT id, user_id, parent_id, name, kind, created_at, updated_at FROM categories WHERE id = $1 AND user_id = $2`
 	var cat models.Category
 
 	err := r.db.QueryRow(ctx, query, categoryID, userID).Scan(
 		&cat.ID,
 		&cat.UserID,
+		&cat.ParentID,
 		&cat.Name,
+		&cat.Kind,
 		&cat.CreatedAt,
 		&cat.UpdatedAt,
 	)
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type EmailVerificationRepository interface {
+	Create(ctx context.Context, token *models.EmailVerificationToken) error
+	Consume(ctx context.Context, tokenHash string) (*models.EmailVerificationToken, error)
+	GetLastSentAt(ctx context.Context, userID uuid.UUID) (*time.Time, error)
+}
+
+type emailVerificationRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewEmailVerificationRepository(db *pgxpool.Pool) EmailVerificationRepository {
+	return &emailVerificationRepository{db: db}
+}
+
+// Create menyimpan token baru dan membatalkan token lain milik user yang belum terpakai,
+// sehingga hanya email verifikasi terakhir yang berlaku.
+func (r *emailVerificationRepository) Create(ctx context.Context, t *models.EmailVerificationToken) error {
+	return pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		invalidate := `UPDATE email_verification_tokens SET used_at = NOW() WHERE user_id = $1 AND used_at IS NULL`
+		if _, err := tx.Exec(ctx, invalidate, t.UserID); err != nil {
+			return err
+		}
+
+		query := `INSERT INTO email_verification_tokens (user_id, email, token_hash, expires_at) 
+		          VALUES ($1, $2, $3, $4) 
+		          RETURNING id, created_at`
+		return tx.QueryRow(ctx, query, t.UserID, t.Email, t.TokenHash, t.ExpiresAt).Scan(&t.ID, &t.CreatedAt)
+	})
+}
+
+// Consume menandai token terpakai secara atomik. Mengembalikan pgx.ErrNoRows jika token
+// tidak ada, sudah dipakai, atau kedaluwarsa.
+func (r *emailVerificationRepository) Consume(ctx context.Context, tokenHash string) (*models.EmailVerificationToken, error) {
+	query := `UPDATE email_verification_tokens SET used_at = NOW() 
+	          WHERE token_hash = $1 AND used_at IS NULL AND expires_at > NOW() 
+	          RETURNING id, user_id, email, token_hash, expires_at, used_at, created_at`
+
+	t := &models.EmailVerificationToken{}
+	err := r.db.QueryRow(ctx, query, tokenHash).Scan(&t.ID, &t.UserID, &t.Email, &t.TokenHash, &t.ExpiresAt, &t.UsedAt, &t.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// GetLastSentAt mengembalikan waktu token verifikasi terakhir dibuat (nil jika belum pernah).
+func (r *emailVerificationRepository) GetLastSentAt(ctx context.Context, userID uuid.UUID) (*time.Time, error) {
+	query := `SELECT MAX(created_at) FROM email_verification_tokens WHERE user_id = $1`
+
+	var lastSentAt *time.Time
+	if err := r.db.QueryRow(ctx, query, userID).Scan(&lastSentAt); err != nil {
+		return nil, err
+	}
+	return lastSentAt, nil
+}
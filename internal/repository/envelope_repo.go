@@ -0,0 +1,200 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type EnvelopeRepository interface {
+	GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]models.Envelope, error)
+	GetAllByUserIDForUpdateTx(ctx context.Context, tx pgx.Tx, userID uuid.UUID) ([]models.Envelope, error)
+	AssignTx(ctx context.Context, tx pgx.Tx, userID uuid.UUID, categoryID int64, amount int64) (*models.Envelope, error)
+	DrawDownTx(ctx context.Context, tx pgx.Tx, categoryID int64, amount int64) (*int64, error)
+	RefundTx(ctx context.Context, tx pgx.Tx, envelopeID int64, amount int64) error
+	Delete(ctx context.Context, id int64) error
+	GetBalanceByCategoryID(ctx context.Context, categoryID int64) (int64, error)
+	ReassignCategoryTx(ctx context.Context, tx pgx.Tx, fromCategoryID int64, toCategoryID int64) error
+
+	// Helper untuk mengecek kepemilikan
+	CheckOwnership(ctx context.Context, envelopeID int64, userID uuid.UUID) (*models.Envelope, error)
+}
+
+type envelopeRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewEnvelopeRepository(db *pgxpool.Pool) EnvelopeRepository {
+	return &envelopeRepository{db: db}
+}
+
+const selectEnvelopesByUserQuery = `SELECT e.id, e.category_id, c.name, e.balance, e.created_at, e.updated_at 
+	          FROM envelopes e 
+	          JOIN categories c ON c.id = e.category_id 
+	          WHERE e.user_id = $1 
+	          ORDER BY c.name ASC`
+
+func (r *envelopeRepository) GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]models.Envelope, error) {
+	rows, err := r.db.Query(ctx, selectEnvelopesByUserQuery, userID)
+	if err != nil {
+		return nil, err
+	}
+	return scanEnvelopes(rows)
+}
+
+// GetAllByUserIDForUpdateTx mengunci alokasi amplop user (advisory lock sampai tx selesai) lalu
+// mengambil semua amplopnya, sehingga alokasi bersamaan diproses satu per satu dan pengecekan
+// dana tidak bisa dilewati. Advisory lock dipakai karena amplop baru belum punya baris untuk dikunci.
+func (r *envelopeRepository) GetAllByUserIDForUpdateTx(ctx context.Context, tx pgx.Tx, userID uuid.UUID) ([]models.Envelope, error) {
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtextextended('envelopes:' || $1::text, 0))`, userID); err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, selectEnvelopesByUserQuery, userID)
+	if err != nil {
+		return nil, err
+	}
+	return scanEnvelopes(rows)
+}
+
+func scanEnvelopes(rows pgx.Rows) ([]models.Envelope, error) {
+	defer rows.Close()
+
+	var envelopes []models.Envelope
+	for rows.Next() {
+		var e models.Envelope
+		if err := rows.Scan(&e.ID, &e.CategoryID, &e.CategoryName, &e.Balance, &e.CreatedAt, &e.UpdatedAt); err != nil {
+			return nil, err
+		}
+		envelopes = append(envelopes, e)
+	}
+
+	return envelopes, rows.Err()
+}
+
+// AssignTx menambah (atau mengurangi) saldo amplop kategori; amplop dibuat jika belum ada.
+func (r *envelopeRepository) AssignTx(ctx context.Context, tx pgx.Tx, userID uuid.UUID, categoryID int64, amount int64) (*models.Envelope, error) {
+	query := `INSERT INTO envelopes (user_id, category_id, balance) 
+	          VALUES ($1, $2, $3) 
+	          ON CONFLICT (category_id) DO UPDATE 
+	          SET balance = envelopes.balance + EXCLUDED.balance, updated_at = NOW() 
+	          RETURNING id, user_id, category_id, balance, created_at, updated_at`
+
+	var e models.Envelope
+	err := tx.QueryRow(ctx, query, userID, categoryID, amount).Scan(
+		&e.ID,
+		&e.UserID,
+		&e.CategoryID,
+		&e.Balance,
+		&e.CreatedAt,
+		&e.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// DrawDownTx mengurangi saldo amplop milik kategori atau leluhur terdekat yang memiliki amplop dan
+// mengembalikan ID amplop tersebut untuk disimpan di transaksi. Tanpa amplop: no-op, ID nil.
+func (r *envelopeRepository) DrawDownTx(ctx context.Context, tx pgx.Tx, categoryID int64, amount int64) (*int64, error) {
+	query := `WITH RECURSIVE chain AS (
+	              SELECT id, parent_id, 0 AS depth FROM categories WHERE id = $1
+	              UNION ALL
+	              SELECT c.id, c.parent_id, chain.depth + 1 
+	              FROM categories c JOIN chain ON c.id = chain.parent_id 
+	              WHERE chain.depth < $3
+	          )
+	          UPDATE envelopes SET balance = balance - $2, updated_at = NOW() 
+	          WHERE category_id = (
+	              SELECT chain.id FROM chain 
+	              JOIN envelopes e ON e.category_id = chain.id 
+	              ORDER BY chain.depth ASC LIMIT 1
+	          ) 
+	          RETURNING id`
+
+	var envelopeID int64
+	err := tx.QueryRow(ctx, query, categoryID, amount, models.MaxCategoryDepth).Scan(&envelopeID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &envelopeID, nil
+}
+
+// RefundTx mengembalikan dana ke amplop yang sebelumnya dipotong DrawDownTx.
+func (r *envelopeRepository) RefundTx(ctx context.Context, tx pgx.Tx, envelopeID int64, amount int64) error {
+	query := `UPDATE envelopes SET balance = balance + $1, updated_at = NOW() WHERE id = $2`
+	_, err := tx.Exec(ctx, query, amount, envelopeID)
+	return err
+}
+
+func (r *envelopeRepository) Delete(ctx context.Context, id int64) error {
+	query := `DELETE FROM envelopes WHERE id = $1`
+	_, err := r.db.Exec(ctx, query, id)
+	return err
+}
+
+// GetBalanceByCategoryID mengembalikan saldo amplop kategori, atau 0 jika kategori tidak punya amplop.
+func (r *envelopeRepository) GetBalanceByCategoryID(ctx context.Context, categoryID int64) (int64, error) {
+	query := `SELECT COALESCE(SUM(balance), 0) FROM envelopes WHERE category_id = $1`
+
+	var balance int64
+	err := r.db.QueryRow(ctx, query, categoryID).Scan(&balance)
+	return balance, err
+}
+
+// ReassignCategoryTx memindahkan amplop ke kategori lain agar saldonya tidak ikut terhapus
+// (ON DELETE CASCADE) saat kategori asal dihapus. Jika target sudah punya amplop, saldo amplop
+// asal ditambahkan ke amplop target lalu amplop asal dihapus, karena category_id unik.
+func (r *envelopeRepository) ReassignCategoryTx(ctx context.Context, tx pgx.Tx, fromCategoryID int64, toCategoryID int64) error {
+	mergeQuery := `UPDATE envelopes t 
+	               SET balance = t.balance + s.balance, updated_at = NOW() 
+	               FROM envelopes s 
+	               WHERE s.category_id = $1 AND t.category_id = $2`
+	tag, err := tx.Exec(ctx, mergeQuery, fromCategoryID, toCategoryID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		moveQuery := `UPDATE envelopes SET category_id = $1, updated_at = NOW() WHERE category_id = $2`
+		_, err := tx.Exec(ctx, moveQuery, toCategoryID, fromCategoryID)
+		return err
+	}
+
+	// Transaksi yang memotong amplop asal kini dikembalikan ke amplop gabungan
+	repointQuery := `UPDATE transactions SET envelope_id = (SELECT id FROM envelopes WHERE category_id = $1) 
+	                 WHERE envelope_id = (SELECT id FROM envelopes WHERE category_id = $2)`
+	if _, err := tx.Exec(ctx, repointQuery, toCategoryID, fromCategoryID); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM envelopes WHERE category_id = $1`, fromCategoryID)
+	return err
+}
+
+func (r *envelopeRepository) CheckOwnership(ctx context.Context, envelopeID int64, userID uuid.UUID) (*models.Envelope, error) {
+	query := `SELECT id, user_id, category_id, balance, created_at, updated_at FROM envelopes WHERE id = $1 AND user_id = $2`
+	var e models.Envelope
+
+	err := r.db.QueryRow(ctx, query, envelopeID, userID).Scan(
+		&e.ID,
+		&e.UserID,
+		&e.CategoryID,
+		&e.Balance,
+		&e.CreatedAt,
+		&e.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// ErrDuplicate dikembalikan saat insert/update melanggar unique constraint.
+var ErrDuplicate = errors.New("duplicate record")
+
+// uniqueViolation adalah kode SQLSTATE Postgres untuk pelanggaran unique constraint
+const uniqueViolation = "23505"
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type MFARepository interface {
+	GetTOTP(ctx context.Context, userID uuid.UUID) (*models.TOTPCredential, error)
+	SavePendingTOTP(ctx context.Context, userID uuid.UUID, secret string) (bool, error)
+	EnableTOTP(ctx context.Context, userID uuid.UUID, step int64, recoveryCodeHashes []string) error
+	DisableTOTP(ctx context.Context, userID uuid.UUID) error
+	AdvanceTOTPStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error)
+	ConsumeRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error)
+}
+
+type mfaRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewMFARepository(db *pgxpool.Pool) MFARepository {
+	return &mfaRepository{db: db}
+}
+
+func (r *mfaRepository) GetTOTP(ctx context.Context, userID uuid.UUID) (*models.TOTPCredential, error) {
+	query := `SELECT user_id, secret, enabled_at, last_step, created_at FROM user_totp WHERE user_id = $1`
+
+	c := &models.TOTPCredential{}
+	err := r.db.QueryRow(ctx, query, userID).Scan(&c.UserID, &c.Secret, &c.EnabledAt, &c.LastStep, &c.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// SavePendingTOTP menyimpan (atau mengganti) secret yang belum dikonfirmasi. Mengembalikan
+// false jika TOTP user sudah aktif, karena secret aktif tidak boleh tertimpa.
+func (r *mfaRepository) SavePendingTOTP(ctx context.Context, userID uuid.UUID, secret string) (bool, error) {
+	query := `INSERT INTO user_totp (user_id, secret) VALUES ($1, $2) 
+	          ON CONFLICT (user_id) DO UPDATE 
+	          SET secret = EXCLUDED.secret, last_step = NULL, created_at = NOW() 
+	          WHERE user_totp.enabled_at IS NULL`
+
+	tag, err := r.db.Exec(ctx, query, userID, secret)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// EnableTOTP mengaktifkan TOTP dan mengganti seluruh recovery code dalam satu transaksi.
+func (r *mfaRepository) EnableTOTP(ctx context.Context, userID uuid.UUID, step int64, recoveryCodeHashes []string) error {
+	return pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		query := `UPDATE user_totp SET enabled_at = NOW(), last_step = $2 WHERE user_id = $1`
+		if _, err := tx.Exec(ctx, query, userID, step); err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, `DELETE FROM mfa_recovery_codes WHERE user_id = $1`, userID); err != nil {
+			return err
+		}
+
+		for _, hash := range recoveryCodeHashes {
+			insert := `INSERT INTO mfa_recovery_codes (user_id, code_hash) VALUES ($1, $2)`
+			if _, err := tx.Exec(ctx, insert, userID, hash); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *mfaRepository) DisableTOTP(ctx context.Context, userID uuid.UUID) error {
+	return pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `DELETE FROM user_totp WHERE user_id = $1`, userID); err != nil {
+			return err
+		}
+		_, err := tx.Exec(ctx, `DELETE FROM mfa_recovery_codes WHERE user_id = $1`, userID)
+		return err
+	})
+}
+
+// AdvanceTOTPStep mencatat step kode yang baru diterima. Mengembalikan false jika step tersebut
+// (atau yang lebih baru) sudah pernah dipakai, yaitu percobaan replay.
+func (r *mfaRepository) AdvanceTOTPStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error) {
+	query := `UPDATE user_totp SET last_step = $2 
+	          WHERE user_id = $1 AND (last_step IS NULL OR last_step < $2)`
+
+	tag, err := r.db.Exec(ctx, query, userID, step)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *mfaRepository) ConsumeRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error) {
+	query := `UPDATE mfa_recovery_codes SET used_at = NOW() 
+	          WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL`
+
+	tag, err := r.db.Exec(ctx, query, userID, codeHash)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
@@ -8,6 +8,8 @@ import (
 	models "github.com/Udean777/uang-bijak-go/internal/models"
 	mock "github.com/stretchr/testify/mock"
 
+	pgx "github.com/jackc/pgx/v5"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -141,6 +143,54 @@ func (_c *MockCategoryRepository_Create_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// CreateTree provides a mock function with given fields: ctx, userID, categories
+func (_m *MockCategoryRepository) CreateTree(ctx context.Context, userID uuid.UUID, categories []models.Category) error {
+	ret := _m.Called(ctx, userID, categories)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTree")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []models.Category) error); ok {
+		r0 = rf(ctx, userID, categories)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCategoryRepository_CreateTree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTree'
+type MockCategoryRepository_CreateTree_Call struct {
+	*mock.Call
+}
+
+// CreateTree is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - categories []models.Category
+func (_e *MockCategoryRepository_Expecter) CreateTree(ctx interface{}, userID interface{}, categories interface{}) *MockCategoryRepository_CreateTree_Call {
+	return &MockCategoryRepository_CreateTree_Call{Call: _e.mock.On("CreateTree", ctx, userID, categories)}
+}
+
+func (_c *MockCategoryRepository_CreateTree_Call) Run(run func(ctx context.Context, userID uuid.UUID, categories []models.Category)) *MockCategoryRepository_CreateTree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]models.Category))
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepository_CreateTree_Call) Return(_a0 error) *MockCategoryRepository_CreateTree_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCategoryRepository_CreateTree_Call) RunAndReturn(run func(context.Context, uuid.UUID, []models.Category) error) *MockCategoryRepository_CreateTree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function with given fields: ctx, id
 func (_m *MockCategoryRepository) Delete(ctx context.Context, id int64) error {
 	ret := _m.Called(ctx, id)
@@ -188,6 +238,54 @@ func (_c *MockCategoryRepository_Delete_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// DeleteTx provides a mock function with given fields: ctx, tx, id
+func (_m *MockCategoryRepository) DeleteTx(ctx context.Context, tx pgx.Tx, id int64) error {
+	ret := _m.Called(ctx, tx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx, int64) error); ok {
+		r0 = rf(ctx, tx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCategoryRepository_DeleteTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTx'
+type MockCategoryRepository_DeleteTx_Call struct {
+	*mock.Call
+}
+
+// DeleteTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx pgx.Tx
+//   - id int64
+func (_e *MockCategoryRepository_Expecter) DeleteTx(ctx interface{}, tx interface{}, id interface{}) *MockCategoryRepository_DeleteTx_Call {
+	return &MockCategoryRepository_DeleteTx_Call{Call: _e.mock.On("DeleteTx", ctx, tx, id)}
+}
+
+func (_c *MockCategoryRepository_DeleteTx_Call) Run(run func(ctx context.Context, tx pgx.Tx, id int64)) *MockCategoryRepository_DeleteTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgx.Tx), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepository_DeleteTx_Call) Return(_a0 error) *MockCategoryRepository_DeleteTx_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCategoryRepository_DeleteTx_Call) RunAndReturn(run func(context.Context, pgx.Tx, int64) error) *MockCategoryRepository_DeleteTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllByUserID provides a mock function with given fields: ctx, userID
 func (_m *MockCategoryRepository) GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]models.Category, error) {
 	ret := _m.Called(ctx, userID)
@@ -306,17 +404,66 @@ func (_c *MockCategoryRepository_GetByID_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
-// Update provides a mock function with given fields: ctx, id, name
-func (_m *MockCategoryRepository) Update(ctx context.Context, id int64, name string) error {
-	ret := _m.Called(ctx, id, name)
+// ReparentChildrenTx provides a mock function with given fields: ctx, tx, parentID, newParentID
+func (_m *MockCategoryRepository) ReparentChildrenTx(ctx context.Context, tx pgx.Tx, parentID int64, newParentID int64) error {
+	ret := _m.Called(ctx, tx, parentID, newParentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReparentChildrenTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx, int64, int64) error); ok {
+		r0 = rf(ctx, tx, parentID, newParentID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCategoryRepository_ReparentChildrenTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReparentChildrenTx'
+type MockCategoryRepository_ReparentChildrenTx_Call struct {
+	*mock.Call
+}
+
+// ReparentChildrenTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx pgx.Tx
+//   - parentID int64
+//   - newParentID int64
+func (_e *MockCategoryRepository_Expecter) ReparentChildrenTx(ctx interface{}, tx interface{}, parentID interface{}, newParentID interface{}) *MockCategoryRepository_ReparentChildrenTx_Call {
+	return &MockCategoryRepository_ReparentChildrenTx_Call{Call: _e.mock.On("ReparentChildrenTx", ctx, tx, parentID, newParentID)}
+}
+
+func (_c *MockCategoryRepository_ReparentChildrenTx_Call) Run(run func(ctx context.Context, tx pgx.Tx, parentID int64, newParentID int64)) *MockCategoryRepository_ReparentChildrenTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgx.Tx), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCategoryRepository_ReparentChildrenTx_Call) Return(_a0 error) *MockCategoryRepository_ReparentChildrenTx_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCategoryRepository_ReparentChildrenTx_Call) RunAndReturn(run func(context.Context, pgx.Tx, int64, int64) error) *MockCategoryRepository_ReparentChildrenTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, name, parentID, kind
+func (_m *MockCategoryRepository) Update(ctx context.Context, id int64, name string, parentID *int64, kind models.TransactionType) error {
+	ret := _m.Called(ctx, id, name, parentID, kind)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
-		r0 = rf(ctx, id, name)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, *int64, models.TransactionType) error); ok {
+		r0 = rf(ctx, id, name, parentID, kind)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -333,13 +480,15 @@ type MockCategoryRepository_Update_Call struct {
 //   - ctx context.Context
 //   - id int64
 //   - name string
-func (_e *MockCategoryRepository_Expecter) Update(ctx interface{}, id interface{}, name interface{}) *MockCategoryRepository_Update_Call {
-	return &MockCategoryRepository_Update_Call{Call: _e.mock.On("Update", ctx, id, name)}
+//   - parentID *int64
+//   - kind models.TransactionType
+func (_e *MockCategoryRepository_Expecter) Update(ctx interface{}, id interface{}, name interface{}, parentID interface{}, kind interface{}) *MockCategoryRepository_Update_Call {
+	return &MockCategoryRepository_Update_Call{Call: _e.mock.On("Update", ctx, id, name, parentID, kind)}
 }
 
-func (_c *MockCategoryRepository_Update_Call) Run(run func(ctx context.Context, id int64, name string)) *MockCategoryRepository_Update_Call {
+func (_c *MockCategoryRepository_Update_Call) Run(run func(ctx context.Context, id int64, name string, parentID *int64, kind models.TransactionType)) *MockCategoryRepository_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(*int64), args[4].(models.TransactionType))
 	})
 	return _c
 }
@@ -349,7 +498,7 @@ func (_c *MockCategoryRepository_Update_Call) Return(_a0 error) *MockCategoryRep
 	return _c
 }
 
-func (_c *MockCategoryRepository_Update_Call) RunAndReturn(run func(context.Context, int64, string) error) *MockCategoryRepository_Update_Call {
+func (_c *MockCategoryRepository_Update_Call) RunAndReturn(run func(context.Context, int64, string, *int64, models.TransactionType) error) *MockCategoryRepository_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -28,6 +28,180 @@ func (_m *MockTransactionRepository) EXPECT() *MockTransactionRepository_Expecte
 	return &MockTransactionRepository_Expecter{mock: &_m.Mock}
 }
 
+// CheckOwnership provides a mock function with given fields: ctx, transactionID, userID
+func (_m *MockTransactionRepository) CheckOwnership(ctx context.Context, transactionID int64, userID uuid.UUID) (*models.Transaction, error) {
+	ret := _m.Called(ctx, transactionID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckOwnership")
+	}
+
+	var r0 *models.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uuid.UUID) (*models.Transaction, error)); ok {
+		return rf(ctx, transactionID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uuid.UUID) *models.Transaction); ok {
+		r0 = rf(ctx, transactionID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, uuid.UUID) error); ok {
+		r1 = rf(ctx, transactionID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_CheckOwnership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckOwnership'
+type MockTransactionRepository_CheckOwnership_Call struct {
+	*mock.Call
+}
+
+// CheckOwnership is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionID int64
+//   - userID uuid.UUID
+func (_e *MockTransactionRepository_Expecter) CheckOwnership(ctx interface{}, transactionID interface{}, userID interface{}) *MockTransactionRepository_CheckOwnership_Call {
+	return &MockTransactionRepository_CheckOwnership_Call{Call: _e.mock.On("CheckOwnership", ctx, transactionID, userID)}
+}
+
+func (_c *MockTransactionRepository_CheckOwnership_Call) Run(run func(ctx context.Context, transactionID int64, userID uuid.UUID)) *MockTransactionRepository_CheckOwnership_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_CheckOwnership_Call) Return(_a0 *models.Transaction, _a1 error) *MockTransactionRepository_CheckOwnership_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_CheckOwnership_Call) RunAndReturn(run func(context.Context, int64, uuid.UUID) (*models.Transaction, error)) *MockTransactionRepository_CheckOwnership_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountByCategoryID provides a mock function with given fields: ctx, categoryID
+func (_m *MockTransactionRepository) CountByCategoryID(ctx context.Context, categoryID int64) (int64, error) {
+	ret := _m.Called(ctx, categoryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByCategoryID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
+		return rf(ctx, categoryID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, categoryID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, categoryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_CountByCategoryID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByCategoryID'
+type MockTransactionRepository_CountByCategoryID_Call struct {
+	*mock.Call
+}
+
+// CountByCategoryID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - categoryID int64
+func (_e *MockTransactionRepository_Expecter) CountByCategoryID(ctx interface{}, categoryID interface{}) *MockTransactionRepository_CountByCategoryID_Call {
+	return &MockTransactionRepository_CountByCategoryID_Call{Call: _e.mock.On("CountByCategoryID", ctx, categoryID)}
+}
+
+func (_c *MockTransactionRepository_CountByCategoryID_Call) Run(run func(ctx context.Context, categoryID int64)) *MockTransactionRepository_CountByCategoryID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_CountByCategoryID_Call) Return(_a0 int64, _a1 error) *MockTransactionRepository_CountByCategoryID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_CountByCategoryID_Call) RunAndReturn(run func(context.Context, int64) (int64, error)) *MockTransactionRepository_CountByCategoryID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountByWalletID provides a mock function with given fields: ctx, walletID
+func (_m *MockTransactionRepository) CountByWalletID(ctx context.Context, walletID int64) (int64, error) {
+	ret := _m.Called(ctx, walletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByWalletID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
+		return rf(ctx, walletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, walletID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, walletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_CountByWalletID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByWalletID'
+type MockTransactionRepository_CountByWalletID_Call struct {
+	*mock.Call
+}
+
+// CountByWalletID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID int64
+func (_e *MockTransactionRepository_Expecter) CountByWalletID(ctx interface{}, walletID interface{}) *MockTransactionRepository_CountByWalletID_Call {
+	return &MockTransactionRepository_CountByWalletID_Call{Call: _e.mock.On("CountByWalletID", ctx, walletID)}
+}
+
+func (_c *MockTransactionRepository_CountByWalletID_Call) Run(run func(ctx context.Context, walletID int64)) *MockTransactionRepository_CountByWalletID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_CountByWalletID_Call) Return(_a0 int64, _a1 error) *MockTransactionRepository_CountByWalletID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_CountByWalletID_Call) RunAndReturn(run func(context.Context, int64) (int64, error)) *MockTransactionRepository_CountByWalletID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateTx provides a mock function with given fields: ctx, tx, transaction
 func (_m *MockTransactionRepository) CreateTx(ctx context.Context, tx pgx.Tx, transaction *models.Transaction) error {
 	ret := _m.Called(ctx, tx, transaction)
@@ -76,29 +250,77 @@ func (_c *MockTransactionRepository_CreateTx_Call) RunAndReturn(run func(context
 	return _c
 }
 
-// GetAllByUserID provides a mock function with given fields: ctx, userID
-func (_m *MockTransactionRepository) GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]models.Transaction, error) {
-	ret := _m.Called(ctx, userID)
+// DeleteTx provides a mock function with given fields: ctx, tx, id
+func (_m *MockTransactionRepository) DeleteTx(ctx context.Context, tx pgx.Tx, id int64) error {
+	ret := _m.Called(ctx, tx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAllByUserID")
+		panic("no return value specified for DeleteTx")
 	}
 
-	var r0 []models.Transaction
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx, int64) error); ok {
+		r0 = rf(ctx, tx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTransactionRepository_DeleteTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTx'
+type MockTransactionRepository_DeleteTx_Call struct {
+	*mock.Call
+}
+
+// DeleteTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx pgx.Tx
+//   - id int64
+func (_e *MockTransactionRepository_Expecter) DeleteTx(ctx interface{}, tx interface{}, id interface{}) *MockTransactionRepository_DeleteTx_Call {
+	return &MockTransactionRepository_DeleteTx_Call{Call: _e.mock.On("DeleteTx", ctx, tx, id)}
+}
+
+func (_c *MockTransactionRepository_DeleteTx_Call) Run(run func(ctx context.Context, tx pgx.Tx, id int64)) *MockTransactionRepository_DeleteTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgx.Tx), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_DeleteTx_Call) Return(_a0 error) *MockTransactionRepository_DeleteTx_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTransactionRepository_DeleteTx_Call) RunAndReturn(run func(context.Context, pgx.Tx, int64) error) *MockTransactionRepository_DeleteTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByIDTx provides a mock function with given fields: ctx, tx, id
+func (_m *MockTransactionRepository) GetByIDTx(ctx context.Context, tx pgx.Tx, id int64) (*models.Transaction, error) {
+	ret := _m.Called(ctx, tx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDTx")
+	}
+
+	var r0 *models.Transaction
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.Transaction, error)); ok {
-		return rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx, int64) (*models.Transaction, error)); ok {
+		return rf(ctx, tx, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.Transaction); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx, int64) *models.Transaction); ok {
+		r0 = rf(ctx, tx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Transaction)
+			r0 = ret.Get(0).(*models.Transaction)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, pgx.Tx, int64) error); ok {
+		r1 = rf(ctx, tx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -106,31 +328,92 @@ func (_m *MockTransactionRepository) GetAllByUserID(ctx context.Context, userID
 	return r0, r1
 }
 
-// MockTransactionRepository_GetAllByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllByUserID'
-type MockTransactionRepository_GetAllByUserID_Call struct {
+// MockTransactionRepository_GetByIDTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDTx'
+type MockTransactionRepository_GetByIDTx_Call struct {
 	*mock.Call
 }
 
-// GetAllByUserID is a helper method to define mock.On call
+// GetByIDTx is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID uuid.UUID
-func (_e *MockTransactionRepository_Expecter) GetAllByUserID(ctx interface{}, userID interface{}) *MockTransactionRepository_GetAllByUserID_Call {
-	return &MockTransactionRepository_GetAllByUserID_Call{Call: _e.mock.On("GetAllByUserID", ctx, userID)}
+//   - tx pgx.Tx
+//   - id int64
+func (_e *MockTransactionRepository_Expecter) GetByIDTx(ctx interface{}, tx interface{}, id interface{}) *MockTransactionRepository_GetByIDTx_Call {
+	return &MockTransactionRepository_GetByIDTx_Call{Call: _e.mock.On("GetByIDTx", ctx, tx, id)}
 }
 
-func (_c *MockTransactionRepository_GetAllByUserID_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockTransactionRepository_GetAllByUserID_Call {
+func (_c *MockTransactionRepository_GetByIDTx_Call) Run(run func(ctx context.Context, tx pgx.Tx, id int64)) *MockTransactionRepository_GetByIDTx_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(pgx.Tx), args[2].(int64))
 	})
 	return _c
 }
 
-func (_c *MockTransactionRepository_GetAllByUserID_Call) Return(_a0 []models.Transaction, _a1 error) *MockTransactionRepository_GetAllByUserID_Call {
+func (_c *MockTransactionRepository_GetByIDTx_Call) Return(_a0 *models.Transaction, _a1 error) *MockTransactionRepository_GetByIDTx_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockTransactionRepository_GetAllByUserID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.Transaction, error)) *MockTransactionRepository_GetAllByUserID_Call {
+func (_c *MockTransactionRepository_GetByIDTx_Call) RunAndReturn(run func(context.Context, pgx.Tx, int64) (*models.Transaction, error)) *MockTransactionRepository_GetByIDTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByTransferIDTx provides a mock function with given fields: ctx, tx, transferID
+func (_m *MockTransactionRepository) GetByTransferIDTx(ctx context.Context, tx pgx.Tx, transferID int64) (*models.Transaction, error) {
+	ret := _m.Called(ctx, tx, transferID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTransferIDTx")
+	}
+
+	var r0 *models.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx, int64) (*models.Transaction, error)); ok {
+		return rf(ctx, tx, transferID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx, int64) *models.Transaction); ok {
+		r0 = rf(ctx, tx, transferID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgx.Tx, int64) error); ok {
+		r1 = rf(ctx, tx, transferID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_GetByTransferIDTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTransferIDTx'
+type MockTransactionRepository_GetByTransferIDTx_Call struct {
+	*mock.Call
+}
+
+// GetByTransferIDTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx pgx.Tx
+//   - transferID int64
+func (_e *MockTransactionRepository_Expecter) GetByTransferIDTx(ctx interface{}, tx interface{}, transferID interface{}) *MockTransactionRepository_GetByTransferIDTx_Call {
+	return &MockTransactionRepository_GetByTransferIDTx_Call{Call: _e.mock.On("GetByTransferIDTx", ctx, tx, transferID)}
+}
+
+func (_c *MockTransactionRepository_GetByTransferIDTx_Call) Run(run func(ctx context.Context, tx pgx.Tx, transferID int64)) *MockTransactionRepository_GetByTransferIDTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgx.Tx), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetByTransferIDTx_Call) Return(_a0 *models.Transaction, _a1 error) *MockTransactionRepository_GetByTransferIDTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetByTransferIDTx_Call) RunAndReturn(run func(context.Context, pgx.Tx, int64) (*models.Transaction, error)) *MockTransactionRepository_GetByTransferIDTx_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -201,6 +484,293 @@ func (_c *MockTransactionRepository_GetTotalIncomeAndExpense_Call) RunAndReturn(
 	return _c
 }
 
+// GetTotalsByCategory provides a mock function with given fields: ctx, userID, startTime, endTime
+func (_m *MockTransactionRepository) GetTotalsByCategory(ctx context.Context, userID uuid.UUID, startTime time.Time, endTime time.Time) ([]models.CategorySummary, error) {
+	ret := _m.Called(ctx, userID, startTime, endTime)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTotalsByCategory")
+	}
+
+	var r0 []models.CategorySummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) ([]models.CategorySummary, error)); ok {
+		return rf(ctx, userID, startTime, endTime)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) []models.CategorySummary); ok {
+		r0 = rf(ctx, userID, startTime, endTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CategorySummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, userID, startTime, endTime)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_GetTotalsByCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTotalsByCategory'
+type MockTransactionRepository_GetTotalsByCategory_Call struct {
+	*mock.Call
+}
+
+// GetTotalsByCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - startTime time.Time
+//   - endTime time.Time
+func (_e *MockTransactionRepository_Expecter) GetTotalsByCategory(ctx interface{}, userID interface{}, startTime interface{}, endTime interface{}) *MockTransactionRepository_GetTotalsByCategory_Call {
+	return &MockTransactionRepository_GetTotalsByCategory_Call{Call: _e.mock.On("GetTotalsByCategory", ctx, userID, startTime, endTime)}
+}
+
+func (_c *MockTransactionRepository_GetTotalsByCategory_Call) Run(run func(ctx context.Context, userID uuid.UUID, startTime time.Time, endTime time.Time)) *MockTransactionRepository_GetTotalsByCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetTotalsByCategory_Call) Return(_a0 []models.CategorySummary, _a1 error) *MockTransactionRepository_GetTotalsByCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_GetTotalsByCategory_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time, time.Time) ([]models.CategorySummary, error)) *MockTransactionRepository_GetTotalsByCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, userID, filter
+func (_m *MockTransactionRepository) List(ctx context.Context, userID uuid.UUID, filter models.TransactionFilter) ([]models.Transaction, error) {
+	ret := _m.Called(ctx, userID, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []models.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.TransactionFilter) ([]models.Transaction, error)); ok {
+		return rf(ctx, userID, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.TransactionFilter) []models.Transaction); ok {
+		r0 = rf(ctx, userID, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.TransactionFilter) error); ok {
+		r1 = rf(ctx, userID, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockTransactionRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - filter models.TransactionFilter
+func (_e *MockTransactionRepository_Expecter) List(ctx interface{}, userID interface{}, filter interface{}) *MockTransactionRepository_List_Call {
+	return &MockTransactionRepository_List_Call{Call: _e.mock.On("List", ctx, userID, filter)}
+}
+
+func (_c *MockTransactionRepository_List_Call) Run(run func(ctx context.Context, userID uuid.UUID, filter models.TransactionFilter)) *MockTransactionRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.TransactionFilter))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_List_Call) Return(_a0 []models.Transaction, _a1 error) *MockTransactionRepository_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionRepository_List_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.TransactionFilter) ([]models.Transaction, error)) *MockTransactionRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReassignCategoryTx provides a mock function with given fields: ctx, tx, fromCategoryID, toCategoryID
+func (_m *MockTransactionRepository) ReassignCategoryTx(ctx context.Context, tx pgx.Tx, fromCategoryID int64, toCategoryID int64) (int64, error) {
+	ret := _m.Called(ctx, tx, fromCategoryID, toCategoryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReassignCategoryTx")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx, int64, int64) (int64, error)); ok {
+		return rf(ctx, tx, fromCategoryID, toCategoryID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx, int64, int64) int64); ok {
+		r0 = rf(ctx, tx, fromCategoryID, toCategoryID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgx.Tx, int64, int64) error); ok {
+		r1 = rf(ctx, tx, fromCategoryID, toCategoryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_ReassignCategoryTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReassignCategoryTx'
+type MockTransactionRepository_ReassignCategoryTx_Call struct {
+	*mock.Call
+}
+
+// ReassignCategoryTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx pgx.Tx
+//   - fromCategoryID int64
+//   - toCategoryID int64
+func (_e *MockTransactionRepository_Expecter) ReassignCategoryTx(ctx interface{}, tx interface{}, fromCategoryID interface{}, toCategoryID interface{}) *MockTransactionRepository_ReassignCategoryTx_Call {
+	return &MockTransactionRepository_ReassignCategoryTx_Call{Call: _e.mock.On("ReassignCategoryTx", ctx, tx, fromCategoryID, toCategoryID)}
+}
+
+func (_c *MockTransactionRepository_ReassignCategoryTx_Call) Run(run func(ctx context.Context, tx pgx.Tx, fromCategoryID int64, toCategoryID int64)) *MockTransactionRepository_ReassignCategoryTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgx.Tx), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_ReassignCategoryTx_Call) Return(moved int64, err error) *MockTransactionRepository_ReassignCategoryTx_Call {
+	_c.Call.Return(moved, err)
+	return _c
+}
+
+func (_c *MockTransactionRepository_ReassignCategoryTx_Call) RunAndReturn(run func(context.Context, pgx.Tx, int64, int64) (int64, error)) *MockTransactionRepository_ReassignCategoryTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReassignWalletTx provides a mock function with given fields: ctx, tx, fromWalletID, toWalletID
+func (_m *MockTransactionRepository) ReassignWalletTx(ctx context.Context, tx pgx.Tx, fromWalletID int64, toWalletID int64) (int64, error) {
+	ret := _m.Called(ctx, tx, fromWalletID, toWalletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReassignWalletTx")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx, int64, int64) (int64, error)); ok {
+		return rf(ctx, tx, fromWalletID, toWalletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx, int64, int64) int64); ok {
+		r0 = rf(ctx, tx, fromWalletID, toWalletID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgx.Tx, int64, int64) error); ok {
+		r1 = rf(ctx, tx, fromWalletID, toWalletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionRepository_ReassignWalletTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReassignWalletTx'
+type MockTransactionRepository_ReassignWalletTx_Call struct {
+	*mock.Call
+}
+
+// ReassignWalletTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx pgx.Tx
+//   - fromWalletID int64
+//   - toWalletID int64
+func (_e *MockTransactionRepository_Expecter) ReassignWalletTx(ctx interface{}, tx interface{}, fromWalletID interface{}, toWalletID interface{}) *MockTransactionRepository_ReassignWalletTx_Call {
+	return &MockTransactionRepository_ReassignWalletTx_Call{Call: _e.mock.On("ReassignWalletTx", ctx, tx, fromWalletID, toWalletID)}
+}
+
+func (_c *MockTransactionRepository_ReassignWalletTx_Call) Run(run func(ctx context.Context, tx pgx.Tx, fromWalletID int64, toWalletID int64)) *MockTransactionRepository_ReassignWalletTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgx.Tx), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_ReassignWalletTx_Call) Return(balanceEffect int64, err error) *MockTransactionRepository_ReassignWalletTx_Call {
+	_c.Call.Return(balanceEffect, err)
+	return _c
+}
+
+func (_c *MockTransactionRepository_ReassignWalletTx_Call) RunAndReturn(run func(context.Context, pgx.Tx, int64, int64) (int64, error)) *MockTransactionRepository_ReassignWalletTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTx provides a mock function with given fields: ctx, tx, transaction
+func (_m *MockTransactionRepository) UpdateTx(ctx context.Context, tx pgx.Tx, transaction *models.Transaction) error {
+	ret := _m.Called(ctx, tx, transaction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx, *models.Transaction) error); ok {
+		r0 = rf(ctx, tx, transaction)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTransactionRepository_UpdateTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTx'
+type MockTransactionRepository_UpdateTx_Call struct {
+	*mock.Call
+}
+
+// UpdateTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx pgx.Tx
+//   - transaction *models.Transaction
+func (_e *MockTransactionRepository_Expecter) UpdateTx(ctx interface{}, tx interface{}, transaction interface{}) *MockTransactionRepository_UpdateTx_Call {
+	return &MockTransactionRepository_UpdateTx_Call{Call: _e.mock.On("UpdateTx", ctx, tx, transaction)}
+}
+
+func (_c *MockTransactionRepository_UpdateTx_Call) Run(run func(ctx context.Context, tx pgx.Tx, transaction *models.Transaction)) *MockTransactionRepository_UpdateTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgx.Tx), args[2].(*models.Transaction))
+	})
+	return _c
+}
+
+func (_c *MockTransactionRepository_UpdateTx_Call) Return(_a0 error) *MockTransactionRepository_UpdateTx_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTransactionRepository_UpdateTx_Call) RunAndReturn(run func(context.Context, pgx.Tx, *models.Transaction) error) *MockTransactionRepository_UpdateTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockTransactionRepository creates a new instance of MockTransactionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockTransactionRepository(t interface {
@@ -24,9 +24,9 @@ func (_m *MockUserRepository) EXPECT() *MockUserRepository_Expecter {
 	return &MockUserRepository_Expecter{mock: &_m.Mock}
 }
 
-// CreateUser provides a mock function with given fields: ctx, user
-func (_m *MockUserRepository) CreateUser(ctx context.Context, user *models.User) (uuid.UUID, error) {
-	ret := _m.Called(ctx, user)
+// CreateUser provides a mock function with given fields: ctx, user, defaultCategories
+func (_m *MockUserRepository) CreateUser(ctx context.Context, user *models.User, defaultCategories []models.Category) (uuid.UUID, error) {
+	ret := _m.Called(ctx, user, defaultCategories)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateUser")
@@ -34,19 +34,19 @@ func (_m *MockUserRepository) CreateUser(ctx context.Context, user *models.User)
 
 	var r0 uuid.UUID
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *models.User) (uuid.UUID, error)); ok {
-		return rf(ctx, user)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.User, []models.Category) (uuid.UUID, error)); ok {
+		return rf(ctx, user, defaultCategories)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *models.User) uuid.UUID); ok {
-		r0 = rf(ctx, user)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.User, []models.Category) uuid.UUID); ok {
+		r0 = rf(ctx, user, defaultCategories)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(uuid.UUID)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *models.User) error); ok {
-		r1 = rf(ctx, user)
+	if rf, ok := ret.Get(1).(func(context.Context, *models.User, []models.Category) error); ok {
+		r1 = rf(ctx, user, defaultCategories)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -62,13 +62,14 @@ type MockUserRepository_CreateUser_Call struct {
 // CreateUser is a helper method to define mock.On call
 //   - ctx context.Context
 //   - user *models.User
-func (_e *MockUserRepository_Expecter) CreateUser(ctx interface{}, user interface{}) *MockUserRepository_CreateUser_Call {
-	return &MockUserRepository_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, user)}
+//   - defaultCategories []models.Category
+func (_e *MockUserRepository_Expecter) CreateUser(ctx interface{}, user interface{}, defaultCategories interface{}) *MockUserRepository_CreateUser_Call {
+	return &MockUserRepository_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, user, defaultCategories)}
 }
 
-func (_c *MockUserRepository_CreateUser_Call) Run(run func(ctx context.Context, user *models.User)) *MockUserRepository_CreateUser_Call {
+func (_c *MockUserRepository_CreateUser_Call) Run(run func(ctx context.Context, user *models.User, defaultCategories []models.Category)) *MockUserRepository_CreateUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*models.User))
+		run(args[0].(context.Context), args[1].(*models.User), args[2].([]models.Category))
 	})
 	return _c
 }
@@ -78,7 +79,7 @@ func (_c *MockUserRepository_CreateUser_Call) Return(_a0 uuid.UUID, _a1 error) *
 	return _c
 }
 
-func (_c *MockUserRepository_CreateUser_Call) RunAndReturn(run func(context.Context, *models.User) (uuid.UUID, error)) *MockUserRepository_CreateUser_Call {
+func (_c *MockUserRepository_CreateUser_Call) RunAndReturn(run func(context.Context, *models.User, []models.Category) (uuid.UUID, error)) *MockUserRepository_CreateUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -201,6 +202,173 @@ func (_c *MockUserRepository_GetUserByID_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// MarkEmailVerified provides a mock function with given fields: ctx, id, email
+func (_m *MockUserRepository) MarkEmailVerified(ctx context.Context, id uuid.UUID, email string) (bool, error) {
+	ret := _m.Called(ctx, id, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkEmailVerified")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (bool, error)); ok {
+		return rf(ctx, id, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) bool); ok {
+		r0 = rf(ctx, id, email)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, id, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_MarkEmailVerified_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkEmailVerified'
+type MockUserRepository_MarkEmailVerified_Call struct {
+	*mock.Call
+}
+
+// MarkEmailVerified is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - email string
+func (_e *MockUserRepository_Expecter) MarkEmailVerified(ctx interface{}, id interface{}, email interface{}) *MockUserRepository_MarkEmailVerified_Call {
+	return &MockUserRepository_MarkEmailVerified_Call{Call: _e.mock.On("MarkEmailVerified", ctx, id, email)}
+}
+
+func (_c *MockUserRepository_MarkEmailVerified_Call) Run(run func(ctx context.Context, id uuid.UUID, email string)) *MockUserRepository_MarkEmailVerified_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_MarkEmailVerified_Call) Return(_a0 bool, _a1 error) *MockUserRepository_MarkEmailVerified_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_MarkEmailVerified_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (bool, error)) *MockUserRepository_MarkEmailVerified_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePassword provides a mock function with given fields: ctx, id, passwordHash
+func (_m *MockUserRepository) UpdatePassword(ctx context.Context, id uuid.UUID, passwordHash string) error {
+	ret := _m.Called(ctx, id, passwordHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, id, passwordHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepository_UpdatePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePassword'
+type MockUserRepository_UpdatePassword_Call struct {
+	*mock.Call
+}
+
+// UpdatePassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - passwordHash string
+func (_e *MockUserRepository_Expecter) UpdatePassword(ctx interface{}, id interface{}, passwordHash interface{}) *MockUserRepository_UpdatePassword_Call {
+	return &MockUserRepository_UpdatePassword_Call{Call: _e.mock.On("UpdatePassword", ctx, id, passwordHash)}
+}
+
+func (_c *MockUserRepository_UpdatePassword_Call) Run(run func(ctx context.Context, id uuid.UUID, passwordHash string)) *MockUserRepository_UpdatePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_UpdatePassword_Call) Return(_a0 error) *MockUserRepository_UpdatePassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepository_UpdatePassword_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) error) *MockUserRepository_UpdatePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProfile provides a mock function with given fields: ctx, id, name, email
+func (_m *MockUserRepository) UpdateProfile(ctx context.Context, id uuid.UUID, name string, email string) (*models.User, error) {
+	ret := _m.Called(ctx, id, name, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProfile")
+	}
+
+	var r0 *models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) (*models.User, error)); ok {
+		return rf(ctx, id, name, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) *models.User); ok {
+		r0 = rf(ctx, id, name, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string) error); ok {
+		r1 = rf(ctx, id, name, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_UpdateProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProfile'
+type MockUserRepository_UpdateProfile_Call struct {
+	*mock.Call
+}
+
+// UpdateProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - name string
+//   - email string
+func (_e *MockUserRepository_Expecter) UpdateProfile(ctx interface{}, id interface{}, name interface{}, email interface{}) *MockUserRepository_UpdateProfile_Call {
+	return &MockUserRepository_UpdateProfile_Call{Call: _e.mock.On("UpdateProfile", ctx, id, name, email)}
+}
+
+func (_c *MockUserRepository_UpdateProfile_Call) Run(run func(ctx context.Context, id uuid.UUID, name string, email string)) *MockUserRepository_UpdateProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_UpdateProfile_Call) Return(_a0 *models.User, _a1 error) *MockUserRepository_UpdateProfile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_UpdateProfile_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string) (*models.User, error)) *MockUserRepository_UpdateProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockUserRepository creates a new instance of MockUserRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockUserRepository(t interface {
@@ -26,6 +26,53 @@ func (_m *MockWalletRepository) EXPECT() *MockWalletRepository_Expecter {
 	return &MockWalletRepository_Expecter{mock: &_m.Mock}
 }
 
+// Archive provides a mock function with given fields: ctx, id
+func (_m *MockWalletRepository) Archive(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Archive")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWalletRepository_Archive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Archive'
+type MockWalletRepository_Archive_Call struct {
+	*mock.Call
+}
+
+// Archive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockWalletRepository_Expecter) Archive(ctx interface{}, id interface{}) *MockWalletRepository_Archive_Call {
+	return &MockWalletRepository_Archive_Call{Call: _e.mock.On("Archive", ctx, id)}
+}
+
+func (_c *MockWalletRepository_Archive_Call) Run(run func(ctx context.Context, id int64)) *MockWalletRepository_Archive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockWalletRepository_Archive_Call) Return(_a0 error) *MockWalletRepository_Archive_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWalletRepository_Archive_Call) RunAndReturn(run func(context.Context, int64) error) *MockWalletRepository_Archive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckOwnership provides a mock function with given fields: ctx, walletID, userID
 func (_m *MockWalletRepository) CheckOwnership(ctx context.Context, walletID int64, userID uuid.UUID) (*models.Wallet, error) {
 	ret := _m.Called(ctx, walletID, userID)
@@ -190,6 +237,54 @@ func (_c *MockWalletRepository_Delete_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// DeleteTx provides a mock function with given fields: ctx, tx, id
+func (_m *MockWalletRepository) DeleteTx(ctx context.Context, tx pgx.Tx, id int64) error {
+	ret := _m.Called(ctx, tx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx, int64) error); ok {
+		r0 = rf(ctx, tx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWalletRepository_DeleteTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTx'
+type MockWalletRepository_DeleteTx_Call struct {
+	*mock.Call
+}
+
+// DeleteTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx pgx.Tx
+//   - id int64
+func (_e *MockWalletRepository_Expecter) DeleteTx(ctx interface{}, tx interface{}, id interface{}) *MockWalletRepository_DeleteTx_Call {
+	return &MockWalletRepository_DeleteTx_Call{Call: _e.mock.On("DeleteTx", ctx, tx, id)}
+}
+
+func (_c *MockWalletRepository_DeleteTx_Call) Run(run func(ctx context.Context, tx pgx.Tx, id int64)) *MockWalletRepository_DeleteTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgx.Tx), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockWalletRepository_DeleteTx_Call) Return(_a0 error) *MockWalletRepository_DeleteTx_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWalletRepository_DeleteTx_Call) RunAndReturn(run func(context.Context, pgx.Tx, int64) error) *MockWalletRepository_DeleteTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllByUserID provides a mock function with given fields: ctx, userID
 func (_m *MockWalletRepository) GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]models.Wallet, error) {
 	ret := _m.Called(ctx, userID)
@@ -308,6 +403,66 @@ func (_c *MockWalletRepository_GetByID_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// GetForUpdateTx provides a mock function with given fields: ctx, tx, id
+func (_m *MockWalletRepository) GetForUpdateTx(ctx context.Context, tx pgx.Tx, id int64) (*models.Wallet, error) {
+	ret := _m.Called(ctx, tx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForUpdateTx")
+	}
+
+	var r0 *models.Wallet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx, int64) (*models.Wallet, error)); ok {
+		return rf(ctx, tx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx, int64) *models.Wallet); ok {
+		r0 = rf(ctx, tx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Wallet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgx.Tx, int64) error); ok {
+		r1 = rf(ctx, tx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWalletRepository_GetForUpdateTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForUpdateTx'
+type MockWalletRepository_GetForUpdateTx_Call struct {
+	*mock.Call
+}
+
+// GetForUpdateTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx pgx.Tx
+//   - id int64
+func (_e *MockWalletRepository_Expecter) GetForUpdateTx(ctx interface{}, tx interface{}, id interface{}) *MockWalletRepository_GetForUpdateTx_Call {
+	return &MockWalletRepository_GetForUpdateTx_Call{Call: _e.mock.On("GetForUpdateTx", ctx, tx, id)}
+}
+
+func (_c *MockWalletRepository_GetForUpdateTx_Call) Run(run func(ctx context.Context, tx pgx.Tx, id int64)) *MockWalletRepository_GetForUpdateTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgx.Tx), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockWalletRepository_GetForUpdateTx_Call) Return(_a0 *models.Wallet, _a1 error) *MockWalletRepository_GetForUpdateTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWalletRepository_GetForUpdateTx_Call) RunAndReturn(run func(context.Context, pgx.Tx, int64) (*models.Wallet, error)) *MockWalletRepository_GetForUpdateTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTotalBalanceByUserID provides a mock function with given fields: ctx, userID
 func (_m *MockWalletRepository) GetTotalBalanceByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
 	ret := _m.Called(ctx, userID)
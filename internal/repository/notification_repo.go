@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type NotificationRepository interface {
+	Create(ctx context.Context, notification *models.Notification) (bool, error)
+	CreateTx(ctx context.Context, tx pgx.Tx, notification *models.Notification) (bool, error)
+	GetAllByUserID(ctx context.Context, userID uuid.UUID, unreadOnly bool) ([]models.Notification, error)
+	MarkRead(ctx context.Context, id int64) error
+	MarkAllRead(ctx context.Context, userID uuid.UUID) (int64, error)
+
+	// Helper untuk mengecek kepemilikan
+	CheckOwnership(ctx context.Context, notificationID int64, userID uuid.UUID) (*models.Notification, error)
+}
+
+type notificationRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewNotificationRepository(db *pgxpool.Pool) NotificationRepository {
+	return &notificationRepository{db: db}
+}
+
+const insertNotificationQuery = `INSERT INTO notifications (user_id, type, message, budget_id, threshold, bill_id, dedup_key) 
+	          VALUES ($1, $2, $3, $4, $5, $6, $7) 
+	          ON CONFLICT (user_id, dedup_key) DO NOTHING 
+	          RETURNING id, created_at`
+
+// Create menyimpan notifikasi jika DedupKey-nya belum pernah dipakai user tersebut.
+// Mengembalikan false (tanpa error) jika notifikasi yang sama sudah ada.
+func (r *notificationRepository) Create(ctx context.Context, n *models.Notification) (bool, error) {
+	return scanInsertedNotification(r.db.QueryRow(ctx, insertNotificationQuery,
+		n.UserID, n.Type, n.Message, n.BudgetID, n.Threshold, n.BillID, n.DedupKey,
+	), n)
+}
+
+// CreateTx sama seperti Create, di dalam tx milik caller.
+func (r *notificationRepository) CreateTx(ctx context.Context, tx pgx.Tx, n *models.Notification) (bool, error) {
+	return scanInsertedNotification(tx.QueryRow(ctx, insertNotificationQuery,
+		n.UserID, n.Type, n.Message, n.BudgetID, n.Threshold, n.BillID, n.DedupKey,
+	), n)
+}
+
+func scanInsertedNotification(row pgx.Row, n *models.Notification) (bool, error) {
+	err := row.Scan(&n.ID, &n.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *notificationRepository) GetAllByUserID(ctx context.Context, userID uuid.UUID, unreadOnly bool) ([]models.Notification, error) {
+	query := `SELECT id, type, message, budget_id, threshold, bill_id, read_at, created_at 
+	          FROM notifications 
+	          WHERE user_id = $1 AND (NOT $2 OR read_at IS NULL) 
+	          ORDER BY created_at DESC, id DESC`
+
+	rows, err := r.db.Query(ctx, query, userID, unreadOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notifications []models.Notification
+	for rows.Next() {
+		var n models.Notification
+		if err := rows.Scan(&n.ID, &n.Type, &n.Message, &n.BudgetID, &n.Threshold, &n.BillID, &n.ReadAt, &n.CreatedAt); err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, n)
+	}
+
+	return notifications, rows.Err()
+}
+
+func (r *notificationRepository) MarkRead(ctx context.Context, id int64) error {
+	query := `UPDATE notifications SET read_at = NOW() WHERE id = $1 AND read_at IS NULL`
+	_, err := r.db.Exec(ctx, query, id)
+	return err
+}
+
+func (r *notificationRepository) MarkAllRead(ctx context.Context, userID uuid.UUID) (int64, error) {
+	query := `UPDATE notifications SET read_at = NOW() WHERE user_id = $1 AND read_at IS NULL`
+	tag, err := r.db.Exec(ctx, query, userID)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+func (r *notificationRepository) CheckOwnership(ctx context.Context, notificationID int64, userID uuid.UUID) (*models.Notification, error) {
+	query := `SELECT id, user_id, type, message, budget_id, threshold, bill_id, read_at, created_at 
+	          FROM notifications WHERE id = $1 AND user_id = $2`
+	var n models.Notification
+
+	err := r.db.QueryRow(ctx, query, notificationID, userID).Scan(
+		&n.ID,
+		&n.UserID,
+		&n.Type,
+		&n.Message,
+		&n.BudgetID,
+		&n.Threshold,
+		&n.BillID,
+		&n.ReadAt,
+		&n.CreatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type OIDCRepository interface {
+	GetIdentity(ctx context.Context, provider, subject string) (*models.UserIdentity, error)
+	CreateIdentity(ctx context.Context, identity *models.UserIdentity) error
+	TouchIdentity(ctx context.Context, id int64) error
+	CreateLoginState(ctx context.Context, state *models.OIDCLoginState) error
+	ConsumeLoginState(ctx context.Context, stateHash, provider string) (*models.OIDCLoginState, error)
+	DeleteExpiredLoginStates(ctx context.Context, before time.Time) (int, error)
+}
+
+type oidcRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewOIDCRepository(db *pgxpool.Pool) OIDCRepository {
+	return &oidcRepository{db: db}
+}
+
+func (r *oidcRepository) GetIdentity(ctx context.Context, provider, subject string) (*models.UserIdentity, error) {
+	query := `SELECT id, user_id, provider, subject, email, last_login_at, created_at 
+	          FROM user_identities WHERE provider = $1 AND subject = $2`
+
+	i := &models.UserIdentity{}
+	err := r.db.QueryRow(ctx, query, provider, subject).Scan(&i.ID, &i.UserID, &i.Provider, &i.Subject, &i.Email, &i.LastLoginAt, &i.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+func (r *oidcRepository) CreateIdentity(ctx context.Context, i *models.UserIdentity) error {
+	query := `INSERT INTO user_identities (user_id, provider, subject, email, last_login_at) 
+	          VALUES ($1, $2, $3, $4, NOW()) 
+	          RETURNING id, last_login_at, created_at`
+	return r.db.QueryRow(ctx, query, i.UserID, i.Provider, i.Subject, i.Email).Scan(&i.ID, &i.LastLoginAt, &i.CreatedAt)
+}
+
+func (r *oidcRepository) TouchIdentity(ctx context.Context, id int64) error {
+	_, err := r.db.Exec(ctx, `UPDATE user_identities SET last_login_at = NOW() WHERE id = $1`, id)
+	return err
+}
+
+func (r *oidcRepository) CreateLoginState(ctx context.Context, s *models.OIDCLoginState) error {
+	query := `INSERT INTO oidc_login_states (state_hash, provider, nonce, code_verifier, expires_at) 
+	          VALUES ($1, $2, $3, $4, $5) 
+	          RETURNING created_at`
+	return r.db.QueryRow(ctx, query, s.StateHash, s.Provider, s.Nonce, s.CodeVerifier, s.ExpiresAt).Scan(&s.CreatedAt)
+}
+
+// ConsumeLoginState menghapus state secara atomik sehingga satu state hanya bisa dipakai
+// sekali. Mengembalikan pgx.ErrNoRows jika state tidak ada, milik provider lain, atau
+// kedaluwarsa.
+func (r *oidcRepository) ConsumeLoginState(ctx context.Context, stateHash, provider string) (*models.OIDCLoginState, error) {
+	query := `DELETE FROM oidc_login_states 
+	          WHERE state_hash = $1 AND provider = $2 AND expires_at > NOW() 
+	          RETURNING state_hash, provider, nonce, code_verifier, expires_at, created_at`
+
+	s := &models.OIDCLoginState{}
+	err := r.db.QueryRow(ctx, query, stateHash, provider).Scan(&s.StateHash, &s.Provider, &s.Nonce, &s.CodeVerifier, &s.ExpiresAt, &s.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (r *oidcRepository) DeleteExpiredLoginStates(ctx context.Context, before time.Time) (int, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM oidc_login_states WHERE expires_at < $1`, before)
+	if err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), nil
+}
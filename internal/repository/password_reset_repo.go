@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PasswordResetRepository interface {
+	Create(ctx context.Context, token *models.PasswordResetToken) error
+	Consume(ctx context.Context, tokenHash string) (*models.PasswordResetToken, error)
+}
+
+type passwordResetRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPasswordResetRepository(db *pgxpool.Pool) PasswordResetRepository {
+	return &passwordResetRepository{db: db}
+}
+
+// Create menyimpan token baru dan membatalkan token lain milik user yang belum terpakai,
+// sehingga hanya link reset terakhir yang berlaku.
+func (r *passwordResetRepository) Create(ctx context.Context, t *models.PasswordResetToken) error {
+	return pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		invalidate := `UPDATE password_reset_tokens SET used_at = NOW() WHERE user_id = $1 AND used_at IS NULL`
+		if _, err := tx.Exec(ctx, invalidate, t.UserID); err != nil {
+			return err
+		}
+
+		query := `INSERT INTO password_reset_tokens (user_id, token_hash, expires_at) 
+		          VALUES ($1, $2, $3) 
+		          RETURNING id, created_at`
+		return tx.QueryRow(ctx, query, t.UserID, t.TokenHash, t.ExpiresAt).Scan(&t.ID, &t.CreatedAt)
+	})
+}
+
+// Consume menandai token terpakai secara atomik. Mengembalikan pgx.ErrNoRows jika token
+// tidak ada, sudah dipakai, atau kedaluwarsa.
+func (r *passwordResetRepository) Consume(ctx context.Context, tokenHash string) (*models.PasswordResetToken, error) {
+	query := `UPDATE password_reset_tokens SET used_at = NOW() 
+	          WHERE token_hash = $1 AND used_at IS NULL AND expires_at > NOW() 
+	          RETURNING id, user_id, token_hash, expires_at, used_at, created_at`
+
+	t := &models.PasswordResetToken{}
+	err := r.db.QueryRow(ctx, query, tokenHash).Scan(&t.ID, &t.UserID, &t.TokenHash, &t.ExpiresAt, &t.UsedAt, &t.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
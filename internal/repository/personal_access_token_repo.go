@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PersonalAccessTokenRepository interface {
+	Create(ctx context.Context, token *models.PersonalAccessToken) error
+	GetActiveByHash(ctx context.Context, tokenHash string) (*models.PersonalAccessToken, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]models.PersonalAccessToken, error)
+	Touch(ctx context.Context, id uuid.UUID) error
+	Revoke(ctx context.Context, id uuid.UUID) error
+	RevokeAllByUserID(ctx context.Context, userID uuid.UUID) error
+
+	// Helper untuk mengecek kepemilikan
+	CheckOwnership(ctx context.Context, tokenID uuid.UUID, userID uuid.UUID) (*models.PersonalAccessToken, error)
+}
+
+type personalAccessTokenRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPersonalAccessTokenRepository(db *pgxpool.Pool) PersonalAccessTokenRepository {
+	return &personalAccessTokenRepository{db: db}
+}
+
+const personalAccessTokenColumns = `id, user_id, name, token_hash, scopes, expires_at, last_used_at, revoked_at, created_at`
+
+func scanPersonalAccessToken(row pgx.Row) (*models.PersonalAccessToken, error) {
+	var t models.PersonalAccessToken
+	err := row.Scan(&t.ID, &t.UserID, &t.Name, &t.TokenHash, &t.Scopes, &t.ExpiresAt, &t.LastUsedAt, &t.RevokedAt, &t.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *personalAccessTokenRepository) Create(ctx context.Context, t *models.PersonalAccessToken) error {
+	query := `INSERT INTO personal_access_tokens (id, user_id, name, token_hash, scopes, expires_at) 
+	          VALUES ($1, $2, $3, $4, $5, $6) 
+	          RETURNING created_at`
+
+	return r.db.QueryRow(ctx, query, t.ID, t.UserID, t.Name, t.TokenHash, t.Scopes, t.ExpiresAt).Scan(&t.CreatedAt)
+}
+
+// GetActiveByHash hanya mengembalikan token yang belum dicabut dan belum kedaluwarsa;
+// selain itu pgx.ErrNoRows.
+func (r *personalAccessTokenRepository) GetActiveByHash(ctx context.Context, tokenHash string) (*models.PersonalAccessToken, error) {
+	query := `SELECT ` + personalAccessTokenColumns + ` FROM personal_access_tokens 
+	          WHERE token_hash = $1 AND revoked_at IS NULL 
+	            AND (expires_at IS NULL OR expires_at > NOW())`
+	return scanPersonalAccessToken(r.db.QueryRow(ctx, query, tokenHash))
+}
+
+// GetByUserID mengembalikan token yang belum dicabut (termasuk yang kedaluwarsa), terbaru dulu.
+func (r *personalAccessTokenRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]models.PersonalAccessToken, error) {
+	query := `SELECT ` + personalAccessTokenColumns + ` FROM personal_access_tokens 
+	          WHERE user_id = $1 AND revoked_at IS NULL 
+	          ORDER BY created_at DESC`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tokens []models.PersonalAccessToken
+	for rows.Next() {
+		t, err := scanPersonalAccessToken(rows)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, *t)
+	}
+	return tokens, rows.Err()
+}
+
+func (r *personalAccessTokenRepository) Touch(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE personal_access_tokens SET last_used_at = NOW() WHERE id = $1`
+	_, err := r.db.Exec(ctx, query, id)
+	return err
+}
+
+func (r *personalAccessTokenRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE personal_access_tokens SET revoked_at = NOW() WHERE id = $1 AND revoked_at IS NULL`
+	_, err := r.db.Exec(ctx, query, id)
+	return err
+}
+
+// RevokeAllByUserID mencabut semua token milik user, dipakai saat password diganti/direset dan logout
+// dari semua perangkat.
+func (r *personalAccessTokenRepository) RevokeAllByUserID(ctx context.Context, userID uuid.UUID) error {
+	query := `UPDATE personal_access_tokens SET revoked_at = NOW() WHERE user_id = $1 AND revoked_at IS NULL`
+	_, err := r.db.Exec(ctx, query, userID)
+	return err
+}
+
+func (r *personalAccessTokenRepository) CheckOwnership(ctx context.Context, tokenID uuid.UUID, userID uuid.UUID) (*models.PersonalAccessToken, error) {
+	query := `SELECT ` + personalAccessTokenColumns + ` FROM personal_access_tokens 
+	          WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL`
+	return scanPersonalAccessToken(r.db.QueryRow(ctx, query, tokenID, userID))
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PreferencesRepository interface {
+	GetByUserID(ctx context.Context, userID uuid.UUID) (*models.UserPreferences, error)
+	Upsert(ctx context.Context, prefs *models.UserPreferences) error
+}
+
+type preferencesRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPreferencesRepository(db *pgxpool.Pool) PreferencesRepository {
+	return &preferencesRepository{db: db}
+}
+
+// GetByUserID mengembalikan pgx.ErrNoRows jika user belum pernah menyimpan preferensi.
+func (r *preferencesRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*models.UserPreferences, error) {
+	query := `SELECT user_id, currency, timezone, locale, first_day_of_week, month_start_day, updated_at 
+	          FROM user_preferences WHERE user_id = $1`
+
+	p := &models.UserPreferences{}
+	err := r.db.QueryRow(ctx, query, userID).Scan(&p.UserID, &p.Currency, &p.Timezone, &p.Locale, &p.FirstDayOfWeek, &p.MonthStartDay, &p.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (r *preferencesRepository) Upsert(ctx context.Context, p *models.UserPreferences) error {
+	query := `INSERT INTO user_preferences (user_id, currency, timezone, locale, first_day_of_week, month_start_day) 
+	          VALUES ($1, $2, $3, $4, $5, $6) 
+	          ON CONFLICT (user_id) DO UPDATE 
+	          SET currency = EXCLUDED.currency, timezone = EXCLUDED.timezone, locale = EXCLUDED.locale, 
+	              first_day_of_week = EXCLUDED.first_day_of_week, month_start_day = EXCLUDED.month_start_day, 
+	              updated_at = NOW() 
+	          RETURNING updated_at`
+	return r.db.QueryRow(ctx, query, p.UserID, p.Currency, p.Timezone, p.Locale, p.FirstDayOfWeek, p.MonthStartDay).Scan(&p.UpdatedAt)
+}
@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RecurringRepository interface {
+	Create(ctx context.Context, recurring *models.RecurringTransaction) error
+	GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]models.RecurringTransaction, error)
+	Update(ctx context.Context, recurring *models.RecurringTransaction) error
+	Delete(ctx context.Context, id int64) error
+	GetDueIDs(ctx context.Context, now time.Time, limit int) ([]int64, error)
+	GetForUpdateTx(ctx context.Context, tx pgx.Tx, id int64) (*models.RecurringTransaction, error)
+	AdvanceTx(ctx context.Context, tx pgx.Tx, id int64, occurrenceCount int, nextRunAt *time.Time) error
+	CountByWalletID(ctx context.Context, walletID int64) (int64, error)
+	ReassignWalletTx(ctx context.Context, tx pgx.Tx, fromWalletID int64, toWalletID int64) error
+	CountByCategoryID(ctx context.Context, categoryID int64) (int64, error)
+	ReassignCategoryTx(ctx context.Context, tx pgx.Tx, fromCategoryID int64, toCategoryID int64) error
+
+	// Helper untuk mengecek kepemilikan
+	CheckOwnership(ctx context.Context, recurringID int64, userID uuid.UUID) (*models.RecurringTransaction, error)
+}
+
+type recurringRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewRecurringRepository(db *pgxpool.Pool) RecurringRepository {
+	return &recurringRepository{db: db}
+}
+
+const recurringColumns = `r.id, r.user_id, r.wallet_id, r.category_id, r.amount, r.type, r.description, 
+	r.frequency, r.repeat_interval, r.start_date, r.end_date, r.count, r.occurrence_count, r.next_run_at, 
+	r.created_at, r.updated_at`
+
+func scanRecurring(row pgx.Row, extra ...any) (*models.RecurringTransaction, error) {
+	var r models.RecurringTransaction
+	dest := []any{
+		&r.ID, &r.UserID, &r.WalletID, &r.CategoryID, &r.Amount, &r.Type, &r.Description,
+		&r.Frequency, &r.Interval, &r.StartDate, &r.EndDate, &r.Count, &r.OccurrenceCount, &r.NextRunAt,
+		&r.CreatedAt, &r.UpdatedAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (r *recurringRepository) Create(ctx context.Context, rt *models.RecurringTransaction) error {
+	query := `INSERT INTO recurring_transactions 
+	          (user_id, wallet_id, category_id, amount, type, description, frequency, repeat_interval, start_date, end_date, count, next_run_at) 
+	          VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) 
+	          RETURNING id, created_at, updated_at`
+
+	return r.db.QueryRow(ctx, query,
+		rt.UserID, rt.WalletID, rt.CategoryID, rt.Amount, rt.Type, rt.Description,
+		rt.Frequency, rt.Interval, rt.StartDate, rt.EndDate, rt.Count, rt.NextRunAt,
+	).Scan(&rt.ID, &rt.CreatedAt, &rt.UpdatedAt)
+}
+
+func (r *recurringRepository) GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]models.RecurringTransaction, error) {
+	query := `SELECT ` + recurringColumns + `, w.name, c.name 
+	          FROM recurring_transactions r 
+	          JOIN wallets w ON w.id = r.wallet_id 
+	          JOIN categories c ON c.id = r.category_id 
+	          WHERE r.user_id = $1 
+	          ORDER BY r.next_run_at ASC NULLS LAST, r.id ASC`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var templates []models.RecurringTransaction
+	for rows.Next() {
+		var walletName, categoryName string
+		rt, err := scanRecurring(rows, &walletName, &categoryName)
+		if err != nil {
+			return nil, err
+		}
+		rt.WalletName = walletName
+		rt.CategoryName = categoryName
+		templates = append(templates, *rt)
+	}
+
+	return templates, rows.Err()
+}
+
+// Update menyimpan isi transaksi, batas akhir jadwal, dan next_run_at yang sudah dihitung ulang.
+func (r *recurringRepository) Update(ctx context.Context, rt *models.RecurringTransaction) error {
+	query := `UPDATE recurring_transactions 
+	          SET wallet_id = $1, category_id = $2, amount = $3, type = $4, description = $5, 
+	              end_date = $6, count = $7, next_run_at = $8, updated_at = NOW() 
+	          WHERE id = $9 
+	          RETURNING updated_at`
+
+	return r.db.QueryRow(ctx, query,
+		rt.WalletID, rt.CategoryID, rt.Amount, rt.Type, rt.Description,
+		rt.EndDate, rt.Count, rt.NextRunAt, rt.ID,
+	).Scan(&rt.UpdatedAt)
+}
+
+func (r *recurringRepository) Delete(ctx context.Context, id int64) error {
+	query := `DELETE FROM recurring_transactions WHERE id = $1`
+	_, err := r.db.Exec(ctx, query, id)
+	return err
+}
+
+// GetDueIDs mengembalikan template yang jadwal berikutnya sudah lewat, yang paling lama tertunda dulu.
+// Template pada dompet yang diarsipkan dilewati karena dompet tersebut dibekukan.
+func (r *recurringRepository) GetDueIDs(ctx context.Context, now time.Time, limit int) ([]int64, error) {
+	query := `SELECT rt.id FROM recurring_transactions rt 
+	          JOIN wallets w ON w.id = rt.wallet_id 
+	          WHERE rt.next_run_at IS NOT NULL AND rt.next_run_at <= $1 AND w.archived_at IS NULL 
+	          ORDER BY rt.next_run_at ASC 
+	          LIMIT $2`
+
+	rows, err := r.db.Query(ctx, query, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// GetForUpdateTx mengunci template selama tx berjalan. SKIP LOCKED membuat instance lain
+// langsung mendapat pgx.ErrNoRows alih-alih menunggu, sehingga satu kemunculan hanya
+// diproses oleh satu worker.
+func (r *recurringRepository) GetForUpdateTx(ctx context.Context, tx pgx.Tx, id int64) (*models.RecurringTransaction, error) {
+	query := `SELECT ` + recurringColumns + ` 
+	          FROM recurring_transactions r 
+	          WHERE r.id = $1 
+	          FOR UPDATE SKIP LOCKED`
+
+	return scanRecurring(tx.QueryRow(ctx, query, id))
+}
+
+func (r *recurringRepository) AdvanceTx(ctx context.Context, tx pgx.Tx, id int64, occurrenceCount int, nextRunAt *time.Time) error {
+	query := `UPDATE recurring_transactions 
+	          SET occurrence_count = $1, next_run_at = $2, updated_at = NOW() 
+	          WHERE id = $3`
+	_, err := tx.Exec(ctx, query, occurrenceCount, nextRunAt, id)
+	return err
+}
+
+func (r *recurringRepository) CountByWalletID(ctx context.Context, walletID int64) (int64, error) {
+	query := `SELECT COUNT(*) FROM recurring_transactions WHERE wallet_id = $1`
+
+	var count int64
+	err := r.db.QueryRow(ctx, query, walletID).Scan(&count)
+	return count, err
+}
+
+// ReassignWalletTx memindahkan template ke dompet lain agar tidak ikut terhapus (ON DELETE CASCADE)
+// saat dompet asal dihapus.
+func (r *recurringRepository) ReassignWalletTx(ctx context.Context, tx pgx.Tx, fromWalletID int64, toWalletID int64) error {
+	query := `UPDATE recurring_transactions SET wallet_id = $1, updated_at = NOW() WHERE wallet_id = $2`
+	_, err := tx.Exec(ctx, query, toWalletID, fromWalletID)
+	return err
+}
+
+func (r *recurringRepository) CountByCategoryID(ctx context.Context, categoryID int64) (int64, error) {
+	query := `SELECT COUNT(*) FROM recurring_transactions WHERE category_id = $1`
+
+	var count int64
+	err := r.db.QueryRow(ctx, query, categoryID).Scan(&count)
+	return count, err
+}
+
+// ReassignCategoryTx memindahkan template ke kategori lain agar tidak ikut terhapus (ON DELETE CASCADE)
+// saat kategori asal dihapus.
+func (r *recurringRepository) ReassignCategoryTx(ctx context.Context, tx pgx.Tx, fromCategoryID int64, toCategoryID int64) error {
+	query := `UPDATE recurring_transactions SET category_id = $1, updated_at = NOW() WHERE category_id = $2`
+	_, err := tx.Exec(ctx, query, toCategoryID, fromCategoryID)
+	return err
+}
+
+func (r *recurringRepository) CheckOwnership(ctx context.Context, recurringID int64, userID uuid.UUID) (*models.RecurringTransaction, error) {
+	query := `SELECT ` + recurringColumns + ` 
+	          FROM recurring_transactions r 
+	          WHERE r.id = $1 AND r.user_id = $2`
+
+	return scanRecurring(r.db.QueryRow(ctx, query, recurringID, userID))
+}
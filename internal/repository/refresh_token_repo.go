@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RefreshTokenRepository interface {
+	Create(ctx context.Context, token *models.RefreshToken) error
+	GetByHash(ctx context.Context, tokenHash string) (*models.RefreshToken, error)
+	Consume(ctx context.Context, tokenHash string) (*models.RefreshToken, error)
+}
+
+type refreshTokenRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewRefreshTokenRepository(db *pgxpool.Pool) RefreshTokenRepository {
+	return &refreshTokenRepository{db: db}
+}
+
+func (r *refreshTokenRepository) Create(ctx context.Context, t *models.RefreshToken) error {
+	query := `INSERT INTO refresh_tokens (user_id, family_id, token_hash, expires_at) 
+	          VALUES ($1, $2, $3, $4) 
+	          RETURNING id, created_at`
+
+	return r.db.QueryRow(ctx, query, t.UserID, t.FamilyID, t.TokenHash, t.ExpiresAt).Scan(&t.ID, &t.CreatedAt)
+}
+
+func (r *refreshTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*models.RefreshToken, error) {
+	query := `SELECT id, user_id, family_id, token_hash, expires_at, revoked_at, created_at 
+	          FROM refresh_tokens WHERE token_hash = $1`
+
+	t := &models.RefreshToken{}
+	err := r.db.QueryRow(ctx, query, tokenHash).Scan(
+		&t.ID, &t.UserID, &t.FamilyID, &t.TokenHash, &t.ExpiresAt, &t.RevokedAt, &t.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Consume mencabut token yang masih aktif secara atomik dan mengembalikannya.
+// Jika token tidak ada, sudah dicabut, atau kedaluwarsa, mengembalikan pgx.ErrNoRows;
+// dua request bersamaan dengan token yang sama tidak mungkin sama-sama berhasil.
+func (r *refreshTokenRepository) Consume(ctx context.Context, tokenHash string) (*models.RefreshToken, error) {
+	query := `UPDATE refresh_tokens SET revoked_at = NOW() 
+	          WHERE token_hash = $1 AND revoked_at IS NULL AND expires_at > NOW() 
+	          RETURNING id, user_id, family_id, token_hash, expires_at, revoked_at, created_at`
+
+	t := &models.RefreshToken{}
+	err := r.db.QueryRow(ctx, query, tokenHash).Scan(
+		&t.ID, &t.UserID, &t.FamilyID, &t.TokenHash, &t.ExpiresAt, &t.RevokedAt, &t.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
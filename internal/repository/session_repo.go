@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SessionRepository interface {
+	Create(ctx context.Context, session *models.Session) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Session, error)
+	GetActiveByUserID(ctx context.Context, userID uuid.UUID) ([]models.Session, error)
+	Touch(ctx context.Context, id uuid.UUID) error
+	Revoke(ctx context.Context, id uuid.UUID) error
+	RevokeAllByUserID(ctx context.Context, userID uuid.UUID) error
+	RevokeOthers(ctx context.Context, userID uuid.UUID, keepSessionID uuid.UUID) error
+
+	// Helper untuk mengecek kepemilikan
+	CheckOwnership(ctx context.Context, sessionID uuid.UUID, userID uuid.UUID) (*models.Session, error)
+}
+
+type sessionRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewSessionRepository(db *pgxpool.Pool) SessionRepository {
+	return &sessionRepository{db: db}
+}
+
+const sessionColumns = `id, user_id, device, user_agent, ip_address, created_at, last_used_at, revoked_at`
+
+func scanSession(row pgx.Row) (*models.Session, error) {
+	var s models.Session
+	err := row.Scan(&s.ID, &s.UserID, &s.Device, &s.UserAgent, &s.IPAddress, &s.CreatedAt, &s.LastUsedAt, &s.RevokedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *sessionRepository) Create(ctx context.Context, s *models.Session) error {
+	query := `INSERT INTO sessions (id, user_id, device, user_agent, ip_address) 
+	          VALUES ($1, $2, $3, $4, $5) 
+	          RETURNING created_at, last_used_at`
+
+	return r.db.QueryRow(ctx, query, s.ID, s.UserID, s.Device, s.UserAgent, s.IPAddress).Scan(&s.CreatedAt, &s.LastUsedAt)
+}
+
+func (r *sessionRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Session, error) {
+	query := `SELECT ` + sessionColumns + ` FROM sessions WHERE id = $1`
+	return scanSession(r.db.QueryRow(ctx, query, id))
+}
+
+// GetActiveByUserID mengembalikan sesi yang belum dicabut, yang terakhir dipakai lebih dulu.
+func (r *sessionRepository) GetActiveByUserID(ctx context.Context, userID uuid.UUID) ([]models.Session, error) {
+	query := `SELECT ` + sessionColumns + ` FROM sessions 
+	          WHERE user_id = $1 AND revoked_at IS NULL 
+	          ORDER BY last_used_at DESC`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sessions []models.Session
+	for rows.Next() {
+		s, err := scanSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, *s)
+	}
+	return sessions, rows.Err()
+}
+
+func (r *sessionRepository) Touch(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE sessions SET last_used_at = NOW() WHERE id = $1`
+	_, err := r.db.Exec(ctx, query, id)
+	return err
+}
+
+// Revoke mencabut sesi beserta seluruh refresh token di family-nya dalam satu transaksi.
+func (r *sessionRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	return pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `UPDATE sessions SET revoked_at = NOW() WHERE id = $1 AND revoked_at IS NULL`, id); err != nil {
+			return err
+		}
+		_, err := tx.Exec(ctx, `UPDATE refresh_tokens SET revoked_at = NOW() WHERE family_id = $1 AND revoked_at IS NULL`, id)
+		return err
+	})
+}
+
+// RevokeAllByUserID mencabut semua sesi dan refresh token milik user.
+func (r *sessionRepository) RevokeAllByUserID(ctx context.Context, userID uuid.UUID) error {
+	return pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `UPDATE sessions SET revoked_at = NOW() WHERE user_id = $1 AND revoked_at IS NULL`, userID); err != nil {
+			return err
+		}
+		_, err := tx.Exec(ctx, `UPDATE refresh_tokens SET revoked_at = NOW() WHERE user_id = $1 AND revoked_at IS NULL`, userID)
+		return err
+	})
+}
+
+// RevokeOthers mencabut semua sesi user kecuali keepSessionID (mis. setelah ganti password).
+func (r *sessionRepository) RevokeOthers(ctx context.Context, userID uuid.UUID, keepSessionID uuid.UUID) error {
+	return pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		query := `UPDATE sessions SET revoked_at = NOW() WHERE user_id = $1 AND id <> $2 AND revoked_at IS NULL`
+		if _, err := tx.Exec(ctx, query, userID, keepSessionID); err != nil {
+			return err
+		}
+		query = `UPDATE refresh_tokens SET revoked_at = NOW() WHERE user_id = $1 AND family_id <> $2 AND revoked_at IS NULL`
+		_, err := tx.Exec(ctx, query, userID, keepSessionID)
+		return err
+	})
+}
+
+func (r *sessionRepository) CheckOwnership(ctx context.Context, sessionID uuid.UUID, userID uuid.UUID) (*models.Session, error) {
+	query := `SELECT ` + sessionColumns + ` FROM sessions WHERE id = $1 AND user_id = $2`
+	return scanSession(r.db.QueryRow(ctx, query, sessionID, userID))
+}
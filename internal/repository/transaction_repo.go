@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Udean777/uang-bijak-go/internal/models"
@@ -12,9 +14,20 @@ import (
 
 type TransactionRepository interface {
 	CreateTx(ctx context.Context, tx pgx.Tx, transaction *models.Transaction) error
-	GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]models.Transaction, error)
+	List(ctx context.Context, userID uuid.UUID, filter models.TransactionFilter) ([]models.Transaction, error)
 	GetTotalIncomeAndExpense(ctx context.Context, userID uuid.UUID, startTime time.Time, endTime time.Time) (income int64, expense int64, err error)
-	// TODO: tambahkan GetByID, Update, Delete
+	GetTotalsByCategory(ctx context.Context, userID uuid.UUID, startTime time.Time, endTime time.Time) ([]models.CategorySummary, error)
+	GetByIDTx(ctx context.Context, tx pgx.Tx, id int64) (*models.Transaction, error)
+	GetByTransferIDTx(ctx context.Context, tx pgx.Tx, transferID int64) (*models.Transaction, error)
+	UpdateTx(ctx context.Context, tx pgx.Tx, transaction *models.Transaction) error
+	DeleteTx(ctx context.Context, tx pgx.Tx, id int64) error
+	CountByWalletID(ctx context.Context, walletID int64) (int64, error)
+	ReassignWalletTx(ctx context.Context, tx pgx.Tx, fromWalletID int64, toWalletID int64) (balanceEffect int64, err error)
+	CountByCategoryID(ctx context.Context, categoryID int64) (int64, error)
+	ReassignCategoryTx(ctx context.Context, tx pgx.Tx, fromCategoryID int64, toCategoryID int64) (moved int64, err error)
+
+	// Helper untuk mengecek kepemilikan
+	CheckOwnership(ctx context.Context, transactionID int64, userID uuid.UUID) (*models.Transaction, error)
 }
 
 type transactionRepository struct {
@@ -27,45 +40,108 @@ func NewTransactionRepository(db *pgxpool.Pool) TransactionRepository {
 
 func (r *transactionRepository) CreateTx(ctx context.Context, tx pgx.Tx, t *models.Transaction) error {
 	query := `INSERT INTO transactions 
-	          (user_id, wallet_id, category_id, amount, type, description, transaction_date)
-	          VALUES ($1, $2, $3, $4, $5, $6, $7)
+	          (user_id, wallet_id, category_id, amount, type, description, transaction_date, recurring_id, recurrence_index, transfer_id, envelope_id)
+	          VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 	          RETURNING id, created_at, updated_at`
 
 	if t.TransactionDate.IsZero() {
 		t.TransactionDate = time.Now()
 	}
 
-	return tx.QueryRow(ctx, query,
+	err := tx.QueryRow(ctx, query,
 		t.UserID, t.WalletID, t.CategoryID, t.Amount, t.Type, t.Description, t.TransactionDate,
+		t.RecurringID, t.RecurrenceIndex, t.TransferID, t.EnvelopeID,
 	).Scan(&t.ID, &t.CreatedAt, &t.UpdatedAt)
+	if isUniqueViolation(err) {
+		return ErrDuplicate
+	}
+	return err
 }
 
-func (r *transactionRepository) GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]models.Transaction, error) {
-	query := `SELECT id, wallet_id, category_id, amount, type, description, transaction_date, created_at, updated_at 
-	          FROM transactions 
-	          WHERE user_id = $1 
-	          ORDER BY transaction_date DESC, created_at DESC`
+// likeEscaper meng-escape karakter wildcard agar pencarian deskripsi bersifat literal
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// List mengembalikan transaksi user sesuai filter, diurutkan (transaction_date DESC, id DESC)
+// dan dibatasi filter.Limit baris. Jika filter.After diisi, hanya baris setelah cursor yang diambil.
+func (r *transactionRepository) List(ctx context.Context, userID uuid.UUID, f models.TransactionFilter) ([]models.Transaction, error) {
+	conditions := []string{"t.user_id = $1"}
+	args := []interface{}{userID}
+
+	where := func(cond string, values ...interface{}) {
+		placeholders := make([]interface{}, len(values))
+		for i, v := range values {
+			args = append(args, v)
+			placeholders[i] = len(args)
+		}
+		conditions = append(conditions, fmt.Sprintf(cond, placeholders...))
+	}
 
-	rows, err := r.db.Query(ctx, query, userID)
+	if f.StartDate != nil {
+		where("t.transaction_date >= $%d", *f.StartDate)
+	}
+	if f.EndDate != nil {
+		// end_date bersifat inklusif: ambil semua transaksi sampai akhir hari tersebut
+		where("t.transaction_date < $%d", f.EndDate.AddDate(0, 0, 1))
+	}
+	if len(f.WalletIDs) > 0 {
+		where("t.wallet_id = ANY($%d)", f.WalletIDs)
+	}
+	if len(f.CategoryIDs) > 0 {
+		where("t.category_id = ANY($%d)", f.CategoryIDs)
+	}
+	if f.Type != "" {
+		where("t.type = $%d", f.Type)
+	}
+	if f.MinAmount != nil {
+		where("t.amount >= $%d", *f.MinAmount)
+	}
+	if f.MaxAmount != nil {
+		where("t.amount <= $%d", *f.MaxAmount)
+	}
+	if f.Search != "" {
+		where("t.description ILIKE $%d", "%"+likeEscaper.Replace(f.Search)+"%")
+	}
+	if f.After != nil {
+		where("(t.transaction_date, t.id) < ($%d, $%d)", f.After.TransactionDate, f.After.ID)
+	}
+
+	columns := `t.id, t.wallet_id, t.category_id, t.amount, t.type, t.description, t.transaction_date, t.created_at, t.updated_at`
+	from := `transactions t`
+	if !f.IDsOnly {
+		columns += `, w.name, c.name`
+		from += ` JOIN wallets w ON w.id = t.wallet_id JOIN categories c ON c.id = t.category_id`
+	}
+
+	args = append(args, f.Limit)
+	query := fmt.Sprintf(`SELECT %s 
+	          FROM %s 
+	          WHERE %s 
+	          ORDER BY t.transaction_date DESC, t.id DESC 
+	          LIMIT $%d`, columns, from, strings.Join(conditions, " AND "), len(args))
+
+	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var transactions []models.Transaction
+	transactions := []models.Transaction{}
 	for rows.Next() {
 		var t models.Transaction
-		err := rows.Scan(
+		dest := []interface{}{
 			&t.ID, &t.WalletID, &t.CategoryID, &t.Amount, &t.Type,
 			&t.Description, &t.TransactionDate, &t.CreatedAt, &t.UpdatedAt,
-		)
-		if err != nil {
+		}
+		if !f.IDsOnly {
+			dest = append(dest, &t.WalletName, &t.CategoryName)
+		}
+		if err := rows.Scan(dest...); err != nil {
 			return nil, err
 		}
 		transactions = append(transactions, t)
 	}
 
-	return transactions, nil
+	return transactions, rows.Err()
 }
 
 func (r *transactionRepository) GetTotalIncomeAndExpense(ctx context.Context, userID uuid.UUID, startTime time.Time, endTime time.Time) (int64, int64, error) {
@@ -91,3 +167,159 @@ func (r *transactionRepository) GetTotalIncomeAndExpense(ctx context.Context, us
 
 	return totalIncome, totalExpense, nil
 }
+
+// GetTotalsByCategory mengembalikan total per kategori (termasuk kategori tanpa transaksi)
+// beserta parent_id agar service dapat melakukan rollup ke kategori induk.
+func (r *transactionRepository) GetTotalsByCategory(ctx context.Context, userID uuid.UUID, startTime time.Time, endTime time.Time) ([]models.CategorySummary, error) {
+	query := `
+		SELECT 
+			c.id, c.parent_id, c.name,
+			COALESCE(SUM(CASE WHEN t.type = 'income' THEN t.amount ELSE 0 END), 0) AS total_income,
+			COALESCE(SUM(CASE WHEN t.type = 'expense' THEN t.amount ELSE 0 END), 0) AS total_expense
+		FROM 
+			categories c
+			LEFT JOIN transactions t ON t.category_id = c.id
+				AND t.transaction_date >= $2 
+				AND t.transaction_date <= $3
+		WHERE 
+			c.user_id = $1
+		GROUP BY c.id, c.parent_id, c.name
+		ORDER BY c.name ASC
+	`
+
+	rows, err := r.db.Query(ctx, query, userID, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var summaries []models.CategorySummary
+	for rows.Next() {
+		var s models.CategorySummary
+		if err := rows.Scan(&s.CategoryID, &s.ParentID, &s.Name, &s.TotalIncome, &s.TotalExpense); err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, s)
+	}
+
+	return summaries, rows.Err()
+}
+
+// GetByIDTx mengambil transaksi di dalam pgx.Tx dan mengunci barisnya (FOR UPDATE),
+// sehingga pembalikan saldo tidak bisa terjadi dua kali secara bersamaan.
+func (r *transactionRepository) GetByIDTx(ctx context.Context, tx pgx.Tx, id int64) (*models.Transaction, error) {
+	query := `SELECT id, user_id, wallet_id, category_id, amount, type, description, transaction_date, created_at, updated_at, transfer_id, envelope_id 
+	          FROM transactions 
+	          WHERE id = $1 
+	          FOR UPDATE`
+	var t models.Transaction
+
+	err := tx.QueryRow(ctx, query, id).Scan(
+		&t.ID, &t.UserID, &t.WalletID, &t.CategoryID, &t.Amount, &t.Type,
+		&t.Description, &t.TransactionDate, &t.CreatedAt, &t.UpdatedAt, &t.TransferID, &t.EnvelopeID,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// GetByTransferIDTx mengambil (dan mengunci) transaksi biaya admin milik sebuah transfer.
+// Mengembalikan pgx.ErrNoRows jika transfer tersebut tidak memiliki biaya.
+func (r *transactionRepository) GetByTransferIDTx(ctx context.Context, tx pgx.Tx, transferID int64) (*models.Transaction, error) {
+	query := `SELECT id, user_id, wallet_id, category_id, amount, type, description, transaction_date, created_at, updated_at, transfer_id, envelope_id 
+	          FROM transactions 
+	          WHERE transfer_id = $1 
+	          FOR UPDATE`
+	var t models.Transaction
+
+	err := tx.QueryRow(ctx, query, transferID).Scan(
+		&t.ID, &t.UserID, &t.WalletID, &t.CategoryID, &t.Amount, &t.Type,
+		&t.Description, &t.TransactionDate, &t.CreatedAt, &t.UpdatedAt, &t.TransferID, &t.EnvelopeID,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *transactionRepository) UpdateTx(ctx context.Context, tx pgx.Tx, t *models.Transaction) error {
+	query := `UPDATE transactions 
+	          SET wallet_id = $1, category_id = $2, amount = $3, type = $4, description = $5, transaction_date = $6, 
+	              envelope_id = $7, updated_at = $8
+	          WHERE id = $9
+	          RETURNING updated_at`
+
+	return tx.QueryRow(ctx, query,
+		t.WalletID, t.CategoryID, t.Amount, t.Type, t.Description, t.TransactionDate, t.EnvelopeID, time.Now(), t.ID,
+	).Scan(&t.UpdatedAt)
+}
+
+func (r *transactionRepository) DeleteTx(ctx context.Context, tx pgx.Tx, id int64) error {
+	query := `DELETE FROM transactions WHERE id = $1`
+	_, err := tx.Exec(ctx, query, id)
+	return err
+}
+
+func (r *transactionRepository) CountByWalletID(ctx context.Context, walletID int64) (int64, error) {
+	query := `SELECT COUNT(*) FROM transactions WHERE wallet_id = $1`
+
+	var count int64
+	err := r.db.QueryRow(ctx, query, walletID).Scan(&count)
+	return count, err
+}
+
+// ReassignWalletTx memindahkan semua transaksi dari satu dompet ke dompet lain dan
+// mengembalikan total efek saldo dari transaksi yang dipindahkan.
+func (r *transactionRepository) ReassignWalletTx(ctx context.Context, tx pgx.Tx, fromWalletID int64, toWalletID int64) (int64, error) {
+	query := `
+		WITH moved AS (
+			UPDATE transactions SET wallet_id = $1, updated_at = $2
+			WHERE wallet_id = $3
+			RETURNING amount, type
+		)
+		SELECT COALESCE(SUM(CASE WHEN type = 'expense' THEN -amount ELSE amount END), 0) FROM moved
+	`
+
+	var balanceEffect int64
+	err := tx.QueryRow(ctx, query, toWalletID, time.Now(), fromWalletID).Scan(&balanceEffect)
+	return balanceEffect, err
+}
+
+func (r *transactionRepository) CountByCategoryID(ctx context.Context, categoryID int64) (int64, error) {
+	query := `SELECT COUNT(*) FROM transactions WHERE category_id = $1`
+
+	var count int64
+	err := r.db.QueryRow(ctx, query, categoryID).Scan(&count)
+	return count, err
+}
+
+// ReassignCategoryTx memindahkan semua transaksi ke kategori lain. Saldo dompet tidak berubah.
+func (r *transactionRepository) ReassignCategoryTx(ctx context.Context, tx pgx.Tx, fromCategoryID int64, toCategoryID int64) (int64, error) {
+	query := `UPDATE transactions SET category_id = $1, updated_at = $2 WHERE category_id = $3`
+
+	tag, err := tx.Exec(ctx, query, toCategoryID, time.Now(), fromCategoryID)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+func (r *transactionRepository) CheckOwnership(ctx context.Context, transactionID int64, userID uuid.UUID) (*models.Transaction, error) {
+	query := `SELECT id, user_id, wallet_id, category_id, amount, type, description, transaction_date, created_at, updated_at, transfer_id, envelope_id 
+	          FROM transactions 
+	          WHERE id = $1 AND user_id = $2`
+	var t models.Transaction
+
+	err := r.db.QueryRow(ctx, query, transactionID, userID).Scan(
+		&t.ID, &t.UserID, &t.WalletID, &t.CategoryID, &t.Amount, &t.Type,
+		&t.Description, &t.TransactionDate, &t.CreatedAt, &t.UpdatedAt, &t.TransferID, &t.EnvelopeID,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
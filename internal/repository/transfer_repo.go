@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type TransferRepository interface {
+	CreateTx(ctx context.Context, tx pgx.Tx, transfer *models.Transfer) error
+	List(ctx context.Context, userID uuid.UUID, filter models.TransferFilter) ([]models.Transfer, error)
+	GetByIDTx(ctx context.Context, tx pgx.Tx, id int64) (*models.Transfer, error)
+	UpdateTx(ctx context.Context, tx pgx.Tx, transfer *models.Transfer) error
+	DeleteTx(ctx context.Context, tx pgx.Tx, id int64) error
+	CountByWalletID(ctx context.Context, walletID int64) (int64, error)
+	CountBetweenWallets(ctx context.Context, walletA int64, walletB int64) (int64, error)
+	ReassignWalletTx(ctx context.Context, tx pgx.Tx, fromWalletID int64, toWalletID int64) (balanceEffect int64, err error)
+
+	// Helper untuk mengecek kepemilikan
+	CheckOwnership(ctx context.Context, transferID int64, userID uuid.UUID) (*models.Transfer, error)
+}
+
+type transferRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewTransferRepository(db *pgxpool.Pool) TransferRepository {
+	return &transferRepository{db: db}
+}
+
+func (r *transferRepository) CreateTx(ctx context.Context, tx pgx.Tx, t *models.Transfer) error {
+	query := `INSERT INTO transfers 
+	          (user_id, from_wallet_id, to_wallet_id, amount, fee, description, transfer_date)
+	          VALUES ($1, $2, $3, $4, $5, $6, $7)
+	          RETURNING id, created_at, updated_at`
+
+	if t.TransferDate.IsZero() {
+		t.TransferDate = time.Now()
+	}
+
+	return tx.QueryRow(ctx, query,
+		t.UserID, t.FromWalletID, t.ToWalletID, t.Amount, t.Fee, t.Description, t.TransferDate,
+	).Scan(&t.ID, &t.CreatedAt, &t.UpdatedAt)
+}
+
+// List mengembalikan transfer user diurutkan (transfer_date DESC, id DESC) dan dibatasi
+// filter.Limit baris, beserta transaksi biaya adminnya. Jika filter.After diisi, hanya baris
+// setelah cursor yang diambil.
+func (r *transferRepository) List(ctx context.Context, userID uuid.UUID, f models.TransferFilter) ([]models.Transfer, error) {
+	conditions := "tr.user_id = $1"
+	args := []interface{}{userID}
+	if f.After != nil {
+		conditions += " AND (tr.transfer_date, tr.id) < ($2, $3)"
+		args = append(args, f.After.TransferDate, f.After.ID)
+	}
+	args = append(args, f.Limit)
+
+	query := fmt.Sprintf(`SELECT tr.id, tr.from_wallet_id, tr.to_wallet_id, tr.amount, tr.fee, tr.description, tr.transfer_date, tr.created_at, tr.updated_at, 
+	                 ft.id, ft.category_id 
+	          FROM transfers tr 
+	          LEFT JOIN transactions ft ON ft.transfer_id = tr.id 
+	          WHERE %s 
+	          ORDER BY tr.transfer_date DESC, tr.id DESC 
+	          LIMIT $%d`, conditions, len(args))
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	transfers := []models.Transfer{}
+	for rows.Next() {
+		var t models.Transfer
+		err := rows.Scan(
+			&t.ID, &t.FromWalletID, &t.ToWalletID, &t.Amount, &t.Fee,
+			&t.Description, &t.TransferDate, &t.CreatedAt, &t.UpdatedAt,
+			&t.FeeTransactionID, &t.FeeCategoryID,
+		)
+		if err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, t)
+	}
+
+	return transfers, rows.Err()
+}
+
+// GetByIDTx mengambil transfer di dalam pgx.Tx dan mengunci barisnya (FOR UPDATE).
+func (r *transferRepository) GetByIDTx(ctx context.Context, tx pgx.Tx, id int64) (*models.Transfer, error) {
+	query := `SELECT id, user_id, from_wallet_id, to_wallet_id, amount, fee, description, transfer_date, created_at, updated_at 
+	          FROM transfers 
+	          WHERE id = $1 
+	          FOR UPDATE`
+	var t models.Transfer
+
+	err := tx.QueryRow(ctx, query, id).Scan(
+		&t.ID, &t.UserID, &t.FromWalletID, &t.ToWalletID, &t.Amount, &t.Fee,
+		&t.Description, &t.TransferDate, &t.CreatedAt, &t.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *transferRepository) UpdateTx(ctx context.Context, tx pgx.Tx, t *models.Transfer) error {
+	query := `UPDATE transfers 
+	          SET from_wallet_id = $1, to_wallet_id = $2, amount = $3, fee = $4, description = $5, transfer_date = $6, updated_at = $7
+	          WHERE id = $8
+	          RETURNING updated_at`
+
+	return tx.QueryRow(ctx, query,
+		t.FromWalletID, t.ToWalletID, t.Amount, t.Fee, t.Description, t.TransferDate, time.Now(), t.ID,
+	).Scan(&t.UpdatedAt)
+}
+
+func (r *transferRepository) DeleteTx(ctx context.Context, tx pgx.Tx, id int64) error {
+	query := `DELETE FROM transfers WHERE id = $1`
+	_, err := tx.Exec(ctx, query, id)
+	return err
+}
+
+func (r *transferRepository) CountByWalletID(ctx context.Context, walletID int64) (int64, error) {
+	query := `SELECT COUNT(*) FROM transfers WHERE from_wallet_id = $1 OR to_wallet_id = $1`
+
+	var count int64
+	err := r.db.QueryRow(ctx, query, walletID).Scan(&count)
+	return count, err
+}
+
+func (r *transferRepository) CountBetweenWallets(ctx context.Context, walletA int64, walletB int64) (int64, error) {
+	query := `SELECT COUNT(*) FROM transfers 
+	          WHERE (from_wallet_id = $1 AND to_wallet_id = $2) OR (from_wallet_id = $2 AND to_wallet_id = $1)`
+
+	var count int64
+	err := r.db.QueryRow(ctx, query, walletA, walletB).Scan(&count)
+	return count, err
+}
+
+// ReassignWalletTx memindahkan kedua sisi transfer dari satu dompet ke dompet lain dan
+// mengembalikan total efek saldonya (sisi asal: -amount, sisi tujuan: +amount). Biaya admin
+// adalah transaksi tersendiri dan dipindahkan bersama transaksi dompet.
+func (r *transferRepository) ReassignWalletTx(ctx context.Context, tx pgx.Tx, fromWalletID int64, toWalletID int64) (int64, error) {
+	query := `
+		WITH moved_out AS (
+			UPDATE transfers SET from_wallet_id = $1, updated_at = $2
+			WHERE from_wallet_id = $3
+			RETURNING amount AS debit
+		), moved_in AS (
+			UPDATE transfers SET to_wallet_id = $1, updated_at = $2
+			WHERE to_wallet_id = $3
+			RETURNING amount AS credit
+		)
+		SELECT COALESCE((SELECT SUM(credit) FROM moved_in), 0) - COALESCE((SELECT SUM(debit) FROM moved_out), 0)
+	`
+
+	var balanceEffect int64
+	err := tx.QueryRow(ctx, query, toWalletID, time.Now(), fromWalletID).Scan(&balanceEffect)
+	return balanceEffect, err
+}
+
+func (r *transferRepository) CheckOwnership(ctx context.Context, transferID int64, userID uuid.UUID) (*models.Transfer, error) {
+	query := `SELECT id, user_id, from_wallet_id, to_wallet_id, amount, fee, description, transfer_date, created_at, updated_at 
+	          FROM transfers 
+	          WHERE id = $1 AND user_id = $2`
+	var t models.Transfer
+
+	err := r.db.QueryRow(ctx, query, transferID, userID).Scan(
+		&t.ID, &t.UserID, &t.FromWalletID, &t.ToWalletID, &t.Amount, &t.Fee,
+		&t.Description, &t.TransferDate, &t.CreatedAt, &t.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
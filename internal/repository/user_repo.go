@@ -5,13 +5,17 @@ import (
 
 	"github.com/Udean777/uang-bijak-go/internal/models"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type UserRepository interface {
-	CreateUser(ctx context.Context, user *models.User) (uuid.UUID, error)
+	CreateUser(ctx context.Context, user *models.User, defaultCategories []models.Category) (uuid.UUID, error)
 	GetUserByEmail(ctx context.Context, email string) (*models.User, error)
 	GetUserByID(ctx context.Context, id uuid.UUID) (*models.User, error)
+	UpdatePassword(ctx context.Context, id uuid.UUID, passwordHash string) error
+	MarkEmailVerified(ctx context.Context, id uuid.UUID, email string) (bool, error)
+	UpdateProfile(ctx context.Context, id uuid.UUID, name, email string) (*models.User, error)
 }
 
 type userRepository struct {
@@ -22,12 +26,19 @@ func NewUserRepository(db *pgxpool.Pool) UserRepository {
 	return &userRepository{db: db}
 }
 
-func (r *userRepository) CreateUser(ctx context.Context, user *models.User) (uuid.UUID, error) {
+// CreateUser menyimpan user baru beserta kategori bawaannya dalam satu transaksi,
+// sehingga user tidak pernah tersimpan tanpa kategori (atau sebaliknya).
+func (r *userRepository) CreateUser(ctx context.Context, user *models.User, defaultCategories []models.Category) (uuid.UUID, error) {
 	user.ID = uuid.New()
 
 	query := `INSERT INTO users (id, name, email, password_hash) VALUES ($1, $2, $3, $4)`
 
-	_, err := r.db.Exec(ctx, query, user.ID, user.Name, user.Email, user.PasswordHash)
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, query, user.ID, user.Name, user.Email, user.PasswordHash); err != nil {
+			return err
+		}
+		return createCategoryTreeTx(ctx, tx, user.ID, nil, defaultCategories)
+	})
 
 	if err != nil {
 		return uuid.Nil, err
@@ -36,7 +47,7 @@ func (r *userRepository) CreateUser(ctx context.Context, user *models.User) (uui
 }
 
 func (r *userRepository) GetUserByEmail(ctx context.Context, email string) (*models.User, error) {
-	query := `SELECT id, name, email, password_hash, created_at FROM users WHERE email = $1`
+	query := `SELECT id, name, email, password_hash, email_verified_at, created_at FROM users WHERE email = $1`
 	user := &models.User{}
 
 	err := r.db.QueryRow(ctx, query, email).Scan(
@@ -44,6 +55,7 @@ func (r *userRepository) GetUserByEmail(ctx context.Context, email string) (*mod
 		&user.Name,
 		&user.Email,
 		&user.PasswordHash,
+		&user.EmailVerifiedAt,
 		&user.CreatedAt,
 	)
 
@@ -54,13 +66,15 @@ func (r *userRepository) GetUserByEmail(ctx context.Context, email string) (*mod
 }
 
 func (r *userRepository) GetUserByID(ctx context.Context, id uuid.UUID) (*models.User, error) {
-	query := `SELECT id, name, email, created_at FROM users WHERE id = $1`
+	query := `SELECT id, name, email, password_hash, email_verified_at, created_at FROM users WHERE id = $1`
 	user := &models.User{}
 
 	err := r.db.QueryRow(ctx, query, id).Scan(
 		&user.ID,
 		&user.Name,
 		&user.Email,
+		&user.PasswordHash,
+		&user.EmailVerifiedAt,
 		&user.CreatedAt,
 	)
 
@@ -69,3 +83,49 @@ func (r *userRepository) GetUserByID(ctx context.Context, id uuid.UUID) (*models
 	}
 	return user, nil
 }
+
+func (r *userRepository) UpdatePassword(ctx context.Context, id uuid.UUID, passwordHash string) error {
+	query := `UPDATE users SET password_hash = $1 WHERE id = $2`
+	_, err := r.db.Exec(ctx, query, passwordHash, id)
+	return err
+}
+
+// MarkEmailVerified menandai email user terverifikasi, hanya jika email tersebut masih email
+// user saat ini. Mengembalikan false jika email sudah berganti.
+func (r *userRepository) MarkEmailVerified(ctx context.Context, id uuid.UUID, email string) (bool, error) {
+	query := `UPDATE users SET email_verified_at = NOW() WHERE id = $1 AND email = $2`
+	tag, err := r.db.Exec(ctx, query, id, email)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// UpdateProfile mengubah nama dan email user. Jika email berganti, status verifikasi
+// dikosongkan sehingga email baru harus diverifikasi ulang. Mengembalikan ErrDuplicate jika
+// email sudah dipakai user lain.
+func (r *userRepository) UpdateProfile(ctx context.Context, id uuid.UUID, name, email string) (*models.User, error) {
+	query := `UPDATE users 
+	          SET name = $2, email = $3, 
+	              email_verified_at = CASE WHEN email = $3 THEN email_verified_at ELSE NULL END 
+	          WHERE id = $1 
+	          RETURNING id, name, email, password_hash, email_verified_at, created_at`
+	user := &models.User{}
+
+	err := r.db.QueryRow(ctx, query, id, name, email).Scan(
+		&user.ID,
+		&user.Name,
+		&user.Email,
+		&user.PasswordHash,
+		&user.EmailVerifiedAt,
+		&user.CreatedAt,
+	)
+
+	if isUniqueViolation(err) {
+		return nil, ErrDuplicate
+	}
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
@@ -17,6 +17,9 @@ type WalletRepository interface {
 	GetByID(ctx context.Context, id int64) (*models.Wallet, error)
 	Update(ctx context.Context, id int64, name string) error
 	Delete(ctx context.Context, id int64) error
+	DeleteTx(ctx context.Context, tx pgx.Tx, id int64) error
+	GetForUpdateTx(ctx context.Context, tx pgx.Tx, id int64) (*models.Wallet, error)
+	Archive(ctx context.Context, id int64) error
 	CheckOwnership(ctx context.Context, walletID int64, userID uuid.UUID) (*models.Wallet, error)
 	UpdateBalanceTx(ctx context.Context, tx pgx.Tx, walletID int64, amount int64) error
 	GetTotalBalanceByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
@@ -49,7 +52,7 @@ func (r *walletRepository) Create(ctx context.Context, wallet *models.Wallet) (i
 
 func (r *walletRepository) GetAllByUserID(ctx context.Context, userID uuid.UUID) ([]models.Wallet, error) {
 	query := `SELECT id, name, balance, created_at, updated_at FROM wallets 
-	          WHERE user_id = $1 ORDER BY name ASC`
+	          WHERE user_id = $1 AND archived_at IS NULL ORDER BY name ASC`
 
 	rows, err := r.db.Query(ctx, query, userID)
 	if err != nil {
@@ -70,11 +73,11 @@ func (r *walletRepository) GetAllByUserID(ctx context.Context, userID uuid.UUID)
 }
 
 func (r *walletRepository) GetByID(ctx context.Context, id int64) (*models.Wallet, error) {
-	query := `SELECT id, user_id, name, balance, created_at, updated_at FROM wallets WHERE id = $1`
+	query := `SELECT id, user_id, name, balance, archived_at, created_at, updated_at FROM wallets WHERE id = $1`
 	var w models.Wallet
 
 	err := r.db.QueryRow(ctx, query, id).Scan(
-		&w.ID, &w.UserID, &w.Name, &w.Balance, &w.CreatedAt, &w.UpdatedAt,
+		&w.ID, &w.UserID, &w.Name, &w.Balance, &w.ArchivedAt, &w.CreatedAt, &w.UpdatedAt,
 	)
 
 	if err != nil {
@@ -96,13 +99,43 @@ func (r *walletRepository) Delete(ctx context.Context, id int64) error {
 	return err
 }
 
+func (r *walletRepository) DeleteTx(ctx context.Context, tx pgx.Tx, id int64) error {
+	query := `DELETE FROM wallets WHERE id = $1`
+	_, err := tx.Exec(ctx, query, id)
+	return err
+}
+
+// GetForUpdateTx mengambil dompet dan menguncinya (FOR UPDATE) sampai tx selesai.
+func (r *walletRepository) GetForUpdateTx(ctx context.Context, tx pgx.Tx, id int64) (*models.Wallet, error) {
+	query := `SELECT id, user_id, name, balance, archived_at, created_at, updated_at FROM wallets 
+	          WHERE id = $1 FOR UPDATE`
+	var w models.Wallet
+
+	err := tx.QueryRow(ctx, query, id).Scan(
+		&w.ID, &w.UserID, &w.Name, &w.Balance, &w.ArchivedAt, &w.CreatedAt, &w.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+// Archive menyembunyikan dompet dari daftar dan membekukannya dari transaksi baru, namun
+// saldo & riwayatnya tetap dihitung di laporan.
+func (r *walletRepository) Archive(ctx context.Context, id int64) error {
+	query := `UPDATE wallets SET archived_at = $1, updated_at = $1 WHERE id = $2`
+	_, err := r.db.Exec(ctx, query, time.Now(), id)
+	return err
+}
+
 func (r *walletRepository) CheckOwnership(ctx context.Context, walletID int64, userID uuid.UUID) (*models.Wallet, error) {
-	query := `SELECT id, user_id, name, balance, created_at, updated_at FROM wallets 
+	query := `SELECT id, user_id, name, balance, archived_at, created_at, updated_at FROM wallets 
 	          WHERE id = $1 AND user_id = $2`
 	var w models.Wallet
 
 	err := r.db.QueryRow(ctx, query, walletID, userID).Scan(
-		&w.ID, &w.UserID, &w.Name, &w.Balance, &w.CreatedAt, &w.UpdatedAt,
+		&w.ID, &w.UserID, &w.Name, &w.Balance, &w.ArchivedAt, &w.CreatedAt, &w.UpdatedAt,
 	)
 
 	if err != nil {
@@ -2,41 +2,103 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
+	"log"
+	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"golang.org/x/crypto/bcrypt"
 
+	"github.com/Udean777/uang-bijak-go/internal/authtoken"
+	"github.com/Udean777/uang-bijak-go/internal/loginguard"
 	"github.com/Udean777/uang-bijak-go/internal/models"
 	"github.com/Udean777/uang-bijak-go/internal/repository"
 )
 
+var (
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	// ErrRefreshTokenReused: token yang sudah dirotasi dipakai lagi, seluruh family dicabut
+	ErrRefreshTokenReused = errors.New("refresh token reuse detected")
+	ErrInvalidMFAToken    = errors.New("invalid or expired mfa token")
+	ErrTooManyAttempts    = errors.New("too many attempts, please try again later")
+)
+
+// TooManyAttemptsError dikembalikan saat login dikunci sementara; errors.Is(err,
+// ErrTooManyAttempts) bernilai true. Pesannya sengaja sama untuk email terdaftar maupun tidak.
+type TooManyAttemptsError struct {
+	RetryAfter time.Duration
+}
+
+func (e *TooManyAttemptsError) Error() string {
+	return ErrTooManyAttempts.Error()
+}
+
+func (e *TooManyAttemptsError) Is(target error) bool {
+	return target == ErrTooManyAttempts
+}
+
+// dummyPasswordHash dibandingkan saat email tidak terdaftar supaya waktu respons login
+// tidak membedakan email terdaftar dan tidak.
+var dummyPasswordHash = sync.OnceValue(func() []byte {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("uang-bijak-dummy-password"), bcrypt.DefaultCost)
+	return hash
+})
+
+// mfaTokenTTL adalah batas waktu menyelesaikan langkah kedua setelah password benar
+const mfaTokenTTL = 5 * time.Minute
+
 type AuthService interface {
-	Register(ctx context.Context, name, email, password string) (*models.User, error)
-	Login(ctx context.Context, email, password string) (accessToken string, refreshToken string, err error)
-	RefreshToken(ctx context.Context, tokenString string) (string, error)
-	ValidateToken(tokenString string, expectedType string) (uuid.UUID, error)
+	Register(ctx context.Context, name, email, password, locale string) (*models.User, error)
+	Login(ctx context.Context, email, password string, meta models.SessionMetadata) (*models.LoginResult, error)
+	VerifyMFA(ctx context.Context, mfaToken, code string, meta models.SessionMetadata) (accessToken string, refreshToken string, err error)
+	LoginExternal(ctx context.Context, user *models.User, meta models.SessionMetadata) (*models.LoginResult, error)
+	RefreshToken(ctx context.Context, tokenString string) (accessToken string, refreshToken string, err error)
+	Logout(ctx context.Context, refreshToken string) error
+	LogoutAll(ctx context.Context, userID uuid.UUID) error
+	ValidateToken(tokenString string, expectedType string) (*authtoken.Claims, error)
 }
 
 type authService struct {
-	userRepo   repository.UserRepository
-	jwtSecret  string
-	accessTTL  time.Duration
-	refreshTTL time.Duration
+	userRepo         repository.UserRepository
+	sessionRepo      repository.SessionRepository
+	refreshTokenRepo repository.RefreshTokenRepository
+	patRepo          repository.PersonalAccessTokenRepository
+	emailVerifier    EmailVerificationService
+	mfa              MFAService
+	loginGuard       *loginguard.Guard
+	categoryTemplate models.CategoryTemplate
+	tokens           *authtoken.Manager
+	accessTTL        time.Duration
+	refreshTTL       time.Duration
 }
 
-func NewAuthService(repo repository.UserRepository, secret string, accessTTL time.Duration, refreshTTL time.Duration) AuthService {
+func NewAuthService(repo repository.UserRepository, sessionRepo repository.SessionRepository, refreshTokenRepo repository.RefreshTokenRepository, patRepo repository.PersonalAccessTokenRepository, emailVerifier EmailVerificationService, mfa MFAService, loginGuard *loginguard.Guard, categoryTemplate models.CategoryTemplate, tokens *authtoken.Manager, accessTTL time.Duration, refreshTTL time.Duration) AuthService {
 	return &authService{
-		userRepo:   repo,
-		jwtSecret:  secret,
-		accessTTL:  accessTTL,
-		refreshTTL: refreshTTL,
+		userRepo:         repo,
+		sessionRepo:      sessionRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		patRepo:          patRepo,
+		emailVerifier:    emailVerifier,
+		mfa:              mfa,
+		loginGuard:       loginGuard,
+		categoryTemplate: categoryTemplate,
+		tokens:           tokens,
+		accessTTL:        accessTTL,
+		refreshTTL:       refreshTTL,
 	}
 }
 
-func (s *authService) Register(ctx context.Context, name, email, password string) (*models.User, error) {
+// Register membuat user baru beserta kategori bawaan dari template dalam bahasa locale,
+// lalu mengirim email verifikasi. Akun tetap dibuat walaupun email gagal terkirim; user bisa
+// meminta kirim ulang.
+func (s *authService) Register(ctx context.Context, name, email, password, locale string) (*models.User, error) {
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
 		return nil, err
@@ -48,96 +110,280 @@ func (s *authService) Register(ctx context.Context, name, email, password string
 		PasswordHash: string(hashedPassword),
 	}
 
-	id, err := s.userRepo.CreateUser(ctx, user)
+	id, err := s.userRepo.CreateUser(ctx, user, s.categoryTemplate.Build(locale))
 	if err != nil {
 
 		return nil, err
 	}
 	user.ID = id
 
+	if err := s.emailVerifier.SendVerification(ctx, user); err != nil {
+		log.Printf("Gagal mengirim email verifikasi ke user %s: %v", user.ID, err)
+	}
+
 	return user, nil
 }
 
-func (s *authService) Login(ctx context.Context, email, password string) (string, string, error) {
+// Login memverifikasi kredensial lalu membuka sesi baru untuk perangkat yang dijelaskan meta.
+// Jika user mengaktifkan 2FA, sesi belum dibuat: yang dikembalikan hanya MFA token untuk
+// ditukar di VerifyMFA. Kegagalan dicatat per email dan per IP; setelah melewati batas,
+// login ditolak dengan TooManyAttemptsError sampai masa backoff habis.
+func (s *authService) Login(ctx context.Context, email, password string, meta models.SessionMetadata) (*models.LoginResult, error) {
+	if err := s.checkLoginGuard(ctx, email, meta.IPAddress); err != nil {
+		return nil, err
+	}
+
 	user, err := s.userRepo.GetUserByEmail(ctx, email)
 	if err != nil {
-		return "", "", errors.New("invalid credentials")
+		bcrypt.CompareHashAndPassword(dummyPasswordHash(), []byte(password))
+		return nil, s.loginFailed(ctx, email, meta.IPAddress, errors.New("invalid credentials"))
 	}
 
 	err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password))
 	if err != nil {
-		return "", "", errors.New("invalid credentials")
+		return nil, s.loginFailed(ctx, email, meta.IPAddress, errors.New("invalid credentials"))
 	}
 
-	accessToken, err := s.generateToken(user.ID, s.accessTTL, "access")
+	mfaEnabled, err := s.mfa.IsEnabled(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if mfaEnabled {
+		// Hitungan gagal belum direset: password saja tidak cukup, dan percobaan kode di
+		// VerifyMFA masuk ke hitungan yang sama
+		mfaToken, err := s.generateMFAToken(user.ID)
+		if err != nil {
+			return nil, err
+		}
+		return &models.LoginResult{MFARequired: true, MFAToken: mfaToken}, nil
+	}
+
+	if err := s.loginGuard.RecordSuccess(ctx, email); err != nil {
+		return nil, err
+	}
+
+	accessToken, refreshToken, err := s.startSession(ctx, user, meta)
+	if err != nil {
+		return nil, err
+	}
+	return &models.LoginResult{AccessToken: accessToken, RefreshToken: refreshToken}, nil
+}
+
+// VerifyMFA menyelesaikan login dua langkah: MFA token dari Login ditukar dengan sesi baru
+// jika kode TOTP atau recovery code valid.
+func (s *authService) VerifyMFA(ctx context.Context, mfaToken, code string, meta models.SessionMetadata) (string, string, error) {
+	claims, err := s.ValidateToken(mfaToken, authtoken.TypeMFA)
+	if err != nil {
+		return "", "", ErrInvalidMFAToken
+	}
+	userID := claims.UserID()
+
+	user, err := s.userRepo.GetUserByID(ctx, userID)
 	if err != nil {
 		return "", "", err
 	}
 
-	refreshToken, err := s.generateToken(user.ID, s.refreshTTL, "refresh")
+	if err := s.checkLoginGuard(ctx, user.Email, meta.IPAddress); err != nil {
+		return "", "", err
+	}
+
+	ok, err := s.mfa.VerifyCode(ctx, userID, code)
+	if errors.Is(err, ErrMFANotEnabled) {
+		// 2FA dimatikan setelah token diterbitkan; minta user login ulang
+		return "", "", ErrInvalidMFAToken
+	}
 	if err != nil {
 		return "", "", err
 	}
+	if !ok {
+		return "", "", s.loginFailed(ctx, user.Email, meta.IPAddress, ErrInvalidMFACode)
+	}
 
-	return accessToken, refreshToken, nil
+	if err := s.loginGuard.RecordSuccess(ctx, user.Email); err != nil {
+		return "", "", err
+	}
+
+	return s.startSession(ctx, user, meta)
 }
 
-func (s *authService) generateToken(userID uuid.UUID, ttl time.Duration, tokenType string) (string, error) {
-	claims := jwt.MapClaims{
-		"sub":        userID.String(),
-		"iat":        time.Now().Unix(),
-		"exp":        time.Now().Add(ttl).Unix(),
-		"token_type": tokenType,
+// LoginExternal melanjutkan login user yang sudah diautentikasi pihak lain (mis. identity
+// provider OIDC). 2FA tetap berlaku: jika aktif, yang dikembalikan hanya MFA token.
+func (s *authService) LoginExternal(ctx context.Context, user *models.User, meta models.SessionMetadata) (*models.LoginResult, error) {
+	mfaEnabled, err := s.mfa.IsEnabled(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if mfaEnabled {
+		mfaToken, err := s.generateMFAToken(user.ID)
+		if err != nil {
+			return nil, err
+		}
+		return &models.LoginResult{MFARequired: true, MFAToken: mfaToken}, nil
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(s.jwtSecret))
+	accessToken, refreshToken, err := s.startSession(ctx, user, meta)
+	if err != nil {
+		return nil, err
+	}
+	return &models.LoginResult{AccessToken: accessToken, RefreshToken: refreshToken}, nil
 }
 
-func (s *authService) ValidateToken(tokenString string, expectedType string) (uuid.UUID, error) {
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("unexpected signing method")
-		}
-		return []byte(s.jwtSecret), nil
-	})
+// checkLoginGuard menolak percobaan login selama akun atau IP masih dalam masa backoff.
+func (s *authService) checkLoginGuard(ctx context.Context, email, ip string) error {
+	wait, err := s.loginGuard.Check(ctx, email, ip)
+	if err != nil {
+		return err
+	}
+	if wait > 0 {
+		return &TooManyAttemptsError{RetryAfter: wait}
+	}
+	return nil
+}
+
+// loginFailed mencatat kegagalan login lalu mengembalikan cause sebagai error untuk caller.
+func (s *authService) loginFailed(ctx context.Context, email, ip string, cause error) error {
+	if err := s.loginGuard.RecordFailure(ctx, email, ip); err != nil {
+		return err
+	}
+	return cause
+}
+
+// startSession membuka sesi (family refresh token) baru dan menerbitkan token pertamanya.
+func (s *authService) startSession(ctx context.Context, user *models.User, meta models.SessionMetadata) (string, string, error) {
+	session := &models.Session{
+		ID:        uuid.New(),
+		UserID:    user.ID,
+		Device:    meta.Device,
+		UserAgent: meta.UserAgent,
+		IPAddress: meta.IPAddress,
+	}
+	if err := s.sessionRepo.Create(ctx, session); err != nil {
+		return "", "", err
+	}
+
+	return s.issueTokens(ctx, user, session.ID)
+}
+
+// issueTokens membuat access token untuk sesi tersebut dan refresh token opaque yang disimpan
+// (dalam bentuk hash) sebagai anggota family sesi.
+func (s *authService) issueTokens(ctx context.Context, user *models.User, sessionID uuid.UUID) (string, string, error) {
+	accessToken, err := s.generateToken(user, sessionID, s.accessTTL, authtoken.TypeAccess)
+	if err != nil {
+		return "", "", err
+	}
 
+	refreshToken, err := generateOpaqueToken()
 	if err != nil {
-		return uuid.Nil, err
+		return "", "", err
 	}
 
-	if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-		tokenType, ok := claims["token_type"].(string)
-		if !ok || tokenType != expectedType {
-			return uuid.Nil, errors.New("invalid token type")
-		}
+	err = s.refreshTokenRepo.Create(ctx, &models.RefreshToken{
+		UserID:    user.ID,
+		FamilyID:  sessionID,
+		TokenHash: hashToken(refreshToken),
+		ExpiresAt: time.Now().Add(s.refreshTTL),
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	return accessToken, refreshToken, nil
+}
+
+// generateMFAToken menandatangani token berumur pendek yang hanya bisa dipakai di VerifyMFA.
+func (s *authService) generateMFAToken(userID uuid.UUID) (string, error) {
+	return s.tokens.Issue(authtoken.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: userID.String()},
+		TokenType:        authtoken.TypeMFA,
+	}, mfaTokenTTL)
+}
+
+// generateToken menandatangani JWT untuk user. Claim email_verified dipakai middleware untuk
+// menerapkan kebijakan akun yang belum verifikasi; nilainya diperbarui setiap refresh.
+func (s *authService) generateToken(user *models.User, sessionID uuid.UUID, ttl time.Duration, tokenType string) (string, error) {
+	return s.tokens.Issue(authtoken.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: user.ID.String()},
+		SessionID:        sessionID.String(),
+		EmailVerified:    user.EmailVerified(),
+		TokenType:        tokenType,
+	}, ttl)
+}
 
-		userIDStr, ok := claims["sub"].(string)
-		if !ok {
-			return uuid.Nil, errors.New("invalid token claims (sub)")
+// ValidateToken memakai verifier yang sama dengan AuthMiddleware.
+func (s *authService) ValidateToken(tokenString string, expectedType string) (*authtoken.Claims, error) {
+	return s.tokens.Verify(tokenString, expectedType)
+}
+
+// RefreshToken merotasi refresh token: token lama dicabut dan diganti token baru dalam family
+// yang sama. Jika token yang sudah dicabut dipakai lagi, kemungkinan besar token tersebut bocor,
+// sehingga seluruh sesi (family) dicabut dan pemiliknya harus login ulang.
+func (s *authService) RefreshToken(ctx context.Context, tokenString string) (string, string, error) {
+	tokenHash := hashToken(tokenString)
+
+	current, err := s.refreshTokenRepo.Consume(ctx, tokenHash)
+	if errors.Is(err, pgx.ErrNoRows) {
+		stored, lookupErr := s.refreshTokenRepo.GetByHash(ctx, tokenHash)
+		if lookupErr != nil || stored.RevokedAt == nil {
+			// Token tidak dikenal atau sekadar kedaluwarsa
+			return "", "", ErrInvalidRefreshToken
 		}
 
-		userID, err := uuid.Parse(userIDStr)
-		if err != nil {
-			return uuid.Nil, errors.New("invalid user ID in token")
+		if err := s.sessionRepo.Revoke(ctx, stored.FamilyID); err != nil {
+			return "", "", err
 		}
+		return "", "", ErrRefreshTokenReused
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	if err := s.sessionRepo.Touch(ctx, current.FamilyID); err != nil {
+		return "", "", err
+	}
 
-		return userID, nil
+	// Ambil ulang user agar status verifikasi email di access token baru selalu terkini
+	user, err := s.userRepo.GetUserByID(ctx, current.UserID)
+	if err != nil {
+		return "", "", err
 	}
 
-	return uuid.Nil, errors.New("invalid token")
+	return s.issueTokens(ctx, user, current.FamilyID)
 }
 
-func (s *authService) RefreshToken(ctx context.Context, tokenString string) (string, error) {
-	userID, err := s.ValidateToken(tokenString, "refresh")
+// Logout mencabut sesi pemilik refresh token yang diberikan beserta seluruh family-nya.
+func (s *authService) Logout(ctx context.Context, refreshToken string) error {
+	stored, err := s.refreshTokenRepo.GetByHash(ctx, hashToken(refreshToken))
 	if err != nil {
-		return "", err
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInvalidRefreshToken
+		}
+		return err
 	}
 
-	newAccessToken, err := s.generateToken(userID, s.accessTTL, "access")
-	if err != nil {
+	return s.sessionRepo.Revoke(ctx, stored.FamilyID)
+}
+
+// LogoutAll mencabut semua sesi milik user di semua perangkat beserta personal access token-nya.
+func (s *authService) LogoutAll(ctx context.Context, userID uuid.UUID) error {
+	if err := s.sessionRepo.RevokeAllByUserID(ctx, userID); err != nil {
+		return err
+	}
+
+	return s.patRepo.RevokeAllByUserID(ctx, userID)
+}
+
+// generateOpaqueToken membuat token acak 256-bit yang aman untuk URL.
+func generateOpaqueToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
 		return "", err
 	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
 
-	return newAccessToken, nil
+// hashToken menghasilkan hash SHA-256 (hex) dari token opaque untuk disimpan di database.
+// Token berentropi tinggi sehingga tidak perlu hash lambat seperti bcrypt.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
 }
@@ -7,28 +7,65 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"golang.org/x/crypto/bcrypt"
 
 	// Import mock kita
+	"github.com/Udean777/uang-bijak-go/internal/authtoken"
+	"github.com/Udean777/uang-bijak-go/internal/authtoken/authtokentest"
+	"github.com/Udean777/uang-bijak-go/internal/loginguard"
 	"github.com/Udean777/uang-bijak-go/internal/models"
 	mocks "github.com/Udean777/uang-bijak-go/internal/repository/mocks"
+	serviceMocks "github.com/Udean777/uang-bijak-go/internal/service/mocks"
 )
 
-func setupAuthService(t *testing.T) (AuthService, *mocks.MockUserRepository) {
-	mockUserRepo := mocks.NewMockUserRepository(t)
+type authServiceMocks struct {
+	userRepo    *mocks.MockUserRepository
+	sessionRepo *mocks.MockSessionRepository
+	refreshRepo *mocks.MockRefreshTokenRepository
+	patRepo     *mocks.MockPersonalAccessTokenRepository
+	verifier    *serviceMocks.MockEmailVerificationService
+	mfa         *serviceMocks.MockMFAService
+}
+
+// testLoginPolicy: backoff mulai setelah 3 kegagalan per akun
+var testLoginPolicy = loginguard.Policy{
+	AccountAttempts: 3,
+	IPAttempts:      10,
+	BaseDelay:       time.Minute,
+	MaxDelay:        10 * time.Minute,
+	Window:          time.Hour,
+}
+
+func setupAuthService(t *testing.T) (AuthService, authServiceMocks) {
+	m := authServiceMocks{
+		userRepo:    mocks.NewMockUserRepository(t),
+		sessionRepo: mocks.NewMockSessionRepository(t),
+		refreshRepo: mocks.NewMockRefreshTokenRepository(t),
+		patRepo:     mocks.NewMockPersonalAccessTokenRepository(t),
+		verifier:    serviceMocks.NewMockEmailVerificationService(t),
+		mfa:         serviceMocks.NewMockMFAService(t),
+	}
 
-	testSecret := "test_secret_key"
 	testAccessTTL := time.Minute * 15
 	testRefreshTTL := time.Hour * 24
 
-	service := NewAuthService(mockUserRepo, testSecret, testAccessTTL, testRefreshTTL)
-	return service, mockUserRepo
+	service := NewAuthService(m.userRepo, m.sessionRepo, m.refreshRepo, m.patRepo, m.verifier, m.mfa, loginguard.New(loginguard.NewMemoryStore(), testLoginPolicy), models.DefaultCategoryTemplate, authtokentest.NewManager(), testAccessTTL, testRefreshTTL)
+	return service, m
+}
+
+// TestAuthService_SharedTokenSuite menjalankan suite token yang sama dengan AuthMiddleware.
+func TestAuthService_SharedTokenSuite(t *testing.T) {
+	service, _ := setupAuthService(t)
+	authtokentest.Run(t, func(t *testing.T, token string, expectedType string) (*authtoken.Claims, error) {
+		return service.ValidateToken(token, expectedType)
+	})
 }
 
 func TestAuthService_Register(t *testing.T) {
-	service, mockUserRepo := setupAuthService(t)
+	service, m := setupAuthService(t)
 	ctx := context.Background()
 
 	t.Run("Success", func(t *testing.T) {
@@ -37,20 +74,29 @@ func TestAuthService_Register(t *testing.T) {
 
 		// Kita harus "mengharapkan" (expect) panggilan ke CreateUser
 		// Kita tidak bisa tahu persis hashed password-nya, jadi kita pakai 'mock.Anything'
-		mockUserRepo.EXPECT().
-			CreateUser(ctx, mock.AnythingOfType("*models.User")).
-			Run(func(ctx context.Context, user *models.User) {
+		m.userRepo.EXPECT().
+			CreateUser(ctx, mock.AnythingOfType("*models.User"), mock.AnythingOfType("[]models.Category")).
+			Run(func(ctx context.Context, user *models.User, defaultCategories []models.Category) {
 				// Cek apakah data yang dikirim ke repo sudah benar
 				assert.Equal(t, "Test User", user.Name)
 				assert.Equal(t, "test@example.com", user.Email)
 				// Cek apakah password-nya di-hash (bukan plain text)
 				assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte("password123")))
+				// Kategori bawaan ikut disimpan dalam bahasa yang diminta
+				assert.NotEmpty(t, defaultCategories)
+				assert.Equal(t, "Salary", defaultCategories[0].Name)
 			}).
 			Return(testUUID, nil). // Kembalikan ID sukses
 			Once()                 // Harapkan dipanggil 1x
 
+		// Email verifikasi dikirim ke user yang baru dibuat
+		m.verifier.EXPECT().
+			SendVerification(ctx, mock.MatchedBy(func(user *models.User) bool { return user.ID == testUUID })).
+			Return(nil).
+			Once()
+
 		// 2. Act
-		user, err := service.Register(ctx, "Test User", "test@example.com", "password123")
+		user, err := service.Register(ctx, "Test User", "test@example.com", "password123", "en")
 
 		// 3. Assert
 		assert.NoError(t, err)
@@ -59,15 +105,31 @@ func TestAuthService_Register(t *testing.T) {
 		assert.Equal(t, "test@example.com", user.Email)
 	})
 
+	t.Run("Verification Email Failure Does Not Fail Registration", func(t *testing.T) {
+		// 1. Setup
+		m.userRepo.EXPECT().
+			CreateUser(ctx, mock.AnythingOfType("*models.User"), mock.AnythingOfType("[]models.Category")).
+			Return(uuid.New(), nil).
+			Once()
+		m.verifier.EXPECT().SendVerification(ctx, mock.AnythingOfType("*models.User")).Return(errors.New("smtp down")).Once()
+
+		// 2. Act
+		user, err := service.Register(ctx, "Test User", "test@example.com", "password123", "")
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, user)
+	})
+
 	t.Run("Email Already Exists", func(t *testing.T) {
 		// 1. Setup
-		mockUserRepo.EXPECT().
-			CreateUser(ctx, mock.AnythingOfType("*models.User")).
+		m.userRepo.EXPECT().
+			CreateUser(ctx, mock.AnythingOfType("*models.User"), mock.AnythingOfType("[]models.Category")).
 			Return(uuid.Nil, errors.New("unique constraint violation")). // Simulasikan error DB
 			Once()
 
 		// 2. Act
-		user, err := service.Register(ctx, "Test User", "test@example.com", "password123")
+		user, err := service.Register(ctx, "Test User", "test@example.com", "password123", "")
 
 		// 3. Assert
 		assert.Error(t, err)
@@ -77,7 +139,7 @@ func TestAuthService_Register(t *testing.T) {
 }
 
 func TestAuthService_Login(t *testing.T) {
-	service, mockUserRepo := setupAuthService(t)
+	service, m := setupAuthService(t)
 	ctx := context.Background()
 
 	// Buat hash password yang valid untuk tes
@@ -87,37 +149,65 @@ func TestAuthService_Login(t *testing.T) {
 		Email:        "user@example.com",
 		PasswordHash: string(hashedPassword),
 	}
+	testMeta := models.SessionMetadata{Device: "Pixel 8", UserAgent: "okhttp/4.12", IPAddress: "10.0.0.1"}
 
 	t.Run("Success", func(t *testing.T) {
 		// 1. Setup
-		mockUserRepo.EXPECT().
+		m.userRepo.EXPECT().
 			GetUserByEmail(ctx, "user@example.com").
 			Return(testUser, nil).
 			Once()
+		m.mfa.EXPECT().IsEnabled(ctx, testUser.ID).Return(false, nil).Once()
+
+		// Login membuka sesi baru dengan metadata perangkat
+		var session *models.Session
+		m.sessionRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*models.Session")).
+			Run(func(ctx context.Context, s *models.Session) { session = s }).
+			Return(nil).
+			Once()
+
+		// Refresh token disimpan dalam bentuk hash, bukan token aslinya
+		var stored *models.RefreshToken
+		m.refreshRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*models.RefreshToken")).
+			Run(func(ctx context.Context, token *models.RefreshToken) { stored = token }).
+			Return(nil).
+			Once()
 
 		// 2. Act
-		accessToken, refreshToken, err := service.Login(ctx, "user@example.com", "password123")
+		result, err := service.Login(ctx, "user@example.com", "password123", testMeta)
 
 		// 3. Assert
 		assert.NoError(t, err)
+		assert.False(t, result.MFARequired)
+		accessToken, refreshToken := result.AccessToken, result.RefreshToken
 		assert.NotEmpty(t, accessToken)
 		assert.NotEmpty(t, refreshToken)
+		assert.Equal(t, testUser.ID, stored.UserID)
+		assert.Equal(t, testUser.ID, session.UserID)
+		assert.Equal(t, "Pixel 8", session.Device)
+		assert.Equal(t, "10.0.0.1", session.IPAddress)
+		assert.Equal(t, session.ID, stored.FamilyID)
+		assert.Equal(t, hashToken(refreshToken), stored.TokenHash)
+		assert.NotEqual(t, refreshToken, stored.TokenHash)
 
 		// Verifikasi token (opsional tapi bagus)
-		accessID, err := service.ValidateToken(accessToken, "access")
+		claims, err := service.ValidateToken(accessToken, authtoken.TypeAccess)
 		assert.NoError(t, err)
-		assert.Equal(t, testUser.ID, accessID)
+		assert.Equal(t, testUser.ID, claims.UserID())
+		assert.Equal(t, session.ID, claims.Session())
 	})
 
 	t.Run("User Not Found", func(t *testing.T) {
 		// 1. Setup
-		mockUserRepo.EXPECT().
+		m.userRepo.EXPECT().
 			GetUserByEmail(ctx, "wrong@example.com").
 			Return(nil, errors.New("not found")). // Simulasikan user tidak ada
 			Once()
 
 		// 2. Act
-		_, _, err := service.Login(ctx, "wrong@example.com", "password123")
+		_, err := service.Login(ctx, "wrong@example.com", "password123", testMeta)
 
 		// 3. Assert
 		assert.Error(t, err)
@@ -126,16 +216,333 @@ func TestAuthService_Login(t *testing.T) {
 
 	t.Run("Wrong Password", func(t *testing.T) {
 		// 1. Setup
-		mockUserRepo.EXPECT().
+		m.userRepo.EXPECT().
 			GetUserByEmail(ctx, "user@example.com").
 			Return(testUser, nil). // User ditemukan...
 			Once()
 
 		// 2. Act
-		_, _, err := service.Login(ctx, "user@example.com", "wrongpassword") // ...tapi password salah
+		_, err := service.Login(ctx, "user@example.com", "wrongpassword", testMeta) // ...tapi password salah
 
 		// 3. Assert
 		assert.Error(t, err)
 		assert.Equal(t, "invalid credentials", err.Error())
 	})
+
+	t.Run("MFA Enabled - Returns Challenge Instead Of Session", func(t *testing.T) {
+		// 1. Setup
+		m.userRepo.EXPECT().GetUserByEmail(ctx, "user@example.com").Return(testUser, nil).Once()
+		m.mfa.EXPECT().IsEnabled(ctx, testUser.ID).Return(true, nil).Once()
+
+		// 2. Act
+		result, err := service.Login(ctx, "user@example.com", "password123", testMeta)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.True(t, result.MFARequired)
+		assert.Empty(t, result.AccessToken)
+		assert.Empty(t, result.RefreshToken)
+
+		// MFA token bukan access token
+		_, err = service.ValidateToken(result.MFAToken, authtoken.TypeAccess)
+		assert.Error(t, err)
+		challenge, err := service.ValidateToken(result.MFAToken, authtoken.TypeMFA)
+		assert.NoError(t, err)
+		assert.Equal(t, testUser.ID, challenge.UserID())
+		assert.Equal(t, uuid.Nil, challenge.Session())
+	})
+}
+
+func TestAuthService_LoginExternal(t *testing.T) {
+	service, m := setupAuthService(t)
+	ctx := context.Background()
+
+	testUser := &models.User{ID: uuid.New(), Email: "user@example.com"}
+	testMeta := models.SessionMetadata{Device: "Chrome", IPAddress: "10.0.0.1"}
+
+	t.Run("Success - Opens Session", func(t *testing.T) {
+		// 1. Setup
+		m.mfa.EXPECT().IsEnabled(ctx, testUser.ID).Return(false, nil).Once()
+
+		var session *models.Session
+		m.sessionRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*models.Session")).
+			Run(func(ctx context.Context, s *models.Session) { session = s }).
+			Return(nil).
+			Once()
+		m.refreshRepo.EXPECT().Create(ctx, mock.AnythingOfType("*models.RefreshToken")).Return(nil).Once()
+
+		// 2. Act
+		result, err := service.LoginExternal(ctx, testUser, testMeta)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.False(t, result.MFARequired)
+		assert.NotEmpty(t, result.RefreshToken)
+		assert.Equal(t, "Chrome", session.Device)
+
+		claims, err := service.ValidateToken(result.AccessToken, authtoken.TypeAccess)
+		assert.NoError(t, err)
+		assert.Equal(t, testUser.ID, claims.UserID())
+		assert.Equal(t, session.ID, claims.Session())
+	})
+
+	t.Run("MFA Enabled - Still Requires Second Step", func(t *testing.T) {
+		// 1. Setup
+		m.mfa.EXPECT().IsEnabled(ctx, testUser.ID).Return(true, nil).Once()
+
+		// 2. Act
+		result, err := service.LoginExternal(ctx, testUser, testMeta)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.True(t, result.MFARequired)
+		assert.Empty(t, result.AccessToken)
+		_, err = service.ValidateToken(result.MFAToken, authtoken.TypeMFA)
+		assert.NoError(t, err)
+	})
+}
+
+func TestAuthService_LoginLockout(t *testing.T) {
+	service, m := setupAuthService(t)
+	ctx := context.Background()
+
+	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+	testUser := &models.User{ID: uuid.New(), Email: "user@example.com", PasswordHash: string(hashedPassword)}
+	testMeta := models.SessionMetadata{IPAddress: "203.0.113.7"}
+
+	t.Run("Unknown And Existing Email Are Locked The Same Way", func(t *testing.T) {
+		for _, email := range []string{"user@example.com", "ghost@example.com"} {
+			// 1. Setup
+			if email == testUser.Email {
+				m.userRepo.EXPECT().GetUserByEmail(ctx, email).Return(testUser, nil).Times(testLoginPolicy.AccountAttempts)
+			} else {
+				m.userRepo.EXPECT().GetUserByEmail(ctx, email).Return(nil, pgx.ErrNoRows).Times(testLoginPolicy.AccountAttempts)
+			}
+			for i := 0; i < testLoginPolicy.AccountAttempts; i++ {
+				_, err := service.Login(ctx, email, "wrongpassword", testMeta)
+				assert.EqualError(t, err, "invalid credentials")
+			}
+
+			// 2. Act: percobaan berikutnya ditolak tanpa menyentuh database
+			_, err := service.Login(ctx, email, "wrongpassword", testMeta)
+
+			// 3. Assert
+			assert.ErrorIs(t, err, ErrTooManyAttempts)
+			var tooMany *TooManyAttemptsError
+			assert.True(t, errors.As(err, &tooMany))
+			assert.Equal(t, testLoginPolicy.BaseDelay, tooMany.RetryAfter.Round(time.Second))
+		}
+	})
+
+	t.Run("Correct Password Is Also Rejected While Locked", func(t *testing.T) {
+		// 2. Act
+		_, err := service.Login(ctx, "User@Example.com", "password123", testMeta)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrTooManyAttempts)
+	})
+}
+
+func TestAuthService_VerifyMFA(t *testing.T) {
+	service, m := setupAuthService(t)
+	ctx := context.Background()
+
+	testUser := &models.User{ID: uuid.New(), Email: "user@example.com", PasswordHash: "x"}
+	testMeta := models.SessionMetadata{Device: "Pixel 8"}
+
+	// Ambil MFA token lewat Login
+	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+	testUser.PasswordHash = string(hashedPassword)
+	m.userRepo.EXPECT().GetUserByEmail(ctx, "user@example.com").Return(testUser, nil).Once()
+	m.mfa.EXPECT().IsEnabled(ctx, testUser.ID).Return(true, nil).Once()
+	result, err := service.Login(ctx, "user@example.com", "password123", testMeta)
+	assert.NoError(t, err)
+	mfaToken := result.MFAToken
+
+	// Setiap VerifyMFA mengambil user untuk mengecek batas percobaan login
+	m.userRepo.EXPECT().GetUserByID(ctx, testUser.ID).Return(testUser, nil)
+
+	t.Run("Success - Opens Session", func(t *testing.T) {
+		// 1. Setup
+		m.mfa.EXPECT().VerifyCode(ctx, testUser.ID, "123456").Return(true, nil).Once()
+		m.sessionRepo.EXPECT().Create(ctx, mock.AnythingOfType("*models.Session")).Return(nil).Once()
+		m.refreshRepo.EXPECT().Create(ctx, mock.AnythingOfType("*models.RefreshToken")).Return(nil).Once()
+
+		// 2. Act
+		accessToken, refreshToken, err := service.VerifyMFA(ctx, mfaToken, "123456", testMeta)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.NotEmpty(t, accessToken)
+		assert.NotEmpty(t, refreshToken)
+	})
+
+	t.Run("Fail - Wrong Code", func(t *testing.T) {
+		// 1. Setup
+		m.mfa.EXPECT().VerifyCode(ctx, testUser.ID, "000000").Return(false, nil).Once()
+
+		// 2. Act
+		_, _, err := service.VerifyMFA(ctx, mfaToken, "000000", testMeta)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrInvalidMFACode)
+	})
+
+	t.Run("Fail - Access Token Is Not An MFA Token", func(t *testing.T) {
+		// 2. Act
+		_, _, err := service.VerifyMFA(ctx, "not-a-token", "123456", testMeta)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrInvalidMFAToken)
+	})
+
+	t.Run("Fail - Too Many Wrong Codes", func(t *testing.T) {
+		// 1. Setup: satu kode salah sudah tercatat di subtest sebelumnya
+		m.mfa.EXPECT().VerifyCode(ctx, testUser.ID, "000000").Return(false, nil).Times(testLoginPolicy.AccountAttempts - 1)
+		for i := 1; i < testLoginPolicy.AccountAttempts; i++ {
+			_, _, err := service.VerifyMFA(ctx, mfaToken, "000000", testMeta)
+			assert.ErrorIs(t, err, ErrInvalidMFACode)
+		}
+
+		// 2. Act
+		_, _, err := service.VerifyMFA(ctx, mfaToken, "123456", testMeta)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrTooManyAttempts)
+	})
+}
+
+func TestAuthService_RefreshToken(t *testing.T) {
+	service, m := setupAuthService(t)
+	ctx := context.Background()
+
+	userID := uuid.New()
+	familyID := uuid.New()
+
+	t.Run("Success - Rotates Within Family", func(t *testing.T) {
+		// 1. Setup
+		m.refreshRepo.EXPECT().
+			Consume(ctx, hashToken("old-token")).
+			Return(&models.RefreshToken{ID: 1, UserID: userID, FamilyID: familyID}, nil).
+			Once()
+		m.sessionRepo.EXPECT().Touch(ctx, familyID).Return(nil).Once()
+		verifiedAt := time.Now()
+		m.userRepo.EXPECT().GetUserByID(ctx, userID).Return(&models.User{ID: userID, EmailVerifiedAt: &verifiedAt}, nil).Once()
+		m.refreshRepo.EXPECT().
+			Create(ctx, mock.MatchedBy(func(token *models.RefreshToken) bool {
+				return token.UserID == userID && token.FamilyID == familyID
+			})).
+			Return(nil).
+			Once()
+
+		// 2. Act
+		accessToken, refreshToken, err := service.RefreshToken(ctx, "old-token")
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.NotEqual(t, "old-token", refreshToken)
+		claims, err := service.ValidateToken(accessToken, authtoken.TypeAccess)
+		assert.NoError(t, err)
+		assert.Equal(t, userID, claims.UserID())
+
+		// Status verifikasi diambil ulang dari database saat refresh
+		assert.True(t, claims.EmailVerified)
+		assert.Equal(t, familyID, claims.Session())
+	})
+
+	t.Run("Reused Token Revokes Family", func(t *testing.T) {
+		// 1. Setup
+		revokedAt := time.Now().Add(-time.Minute)
+		m.refreshRepo.EXPECT().
+			Consume(ctx, hashToken("rotated-token")).
+			Return(nil, pgx.ErrNoRows).
+			Once()
+		m.refreshRepo.EXPECT().
+			GetByHash(ctx, hashToken("rotated-token")).
+			Return(&models.RefreshToken{ID: 1, UserID: userID, FamilyID: familyID, RevokedAt: &revokedAt}, nil).
+			Once()
+		m.sessionRepo.EXPECT().Revoke(ctx, familyID).Return(nil).Once()
+
+		// 2. Act
+		_, _, err := service.RefreshToken(ctx, "rotated-token")
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrRefreshTokenReused)
+	})
+
+	t.Run("Expired Token", func(t *testing.T) {
+		// 1. Setup
+		m.refreshRepo.EXPECT().
+			Consume(ctx, hashToken("expired-token")).
+			Return(nil, pgx.ErrNoRows).
+			Once()
+		m.refreshRepo.EXPECT().
+			GetByHash(ctx, hashToken("expired-token")).
+			Return(&models.RefreshToken{ID: 2, UserID: userID, FamilyID: familyID}, nil).
+			Once()
+
+		// 2. Act
+		_, _, err := service.RefreshToken(ctx, "expired-token")
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrInvalidRefreshToken)
+	})
+
+	t.Run("Unknown Token", func(t *testing.T) {
+		// 1. Setup
+		m.refreshRepo.EXPECT().Consume(ctx, hashToken("unknown")).Return(nil, pgx.ErrNoRows).Once()
+		m.refreshRepo.EXPECT().GetByHash(ctx, hashToken("unknown")).Return(nil, pgx.ErrNoRows).Once()
+
+		// 2. Act
+		_, _, err := service.RefreshToken(ctx, "unknown")
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrInvalidRefreshToken)
+	})
+}
+
+func TestAuthService_Logout(t *testing.T) {
+	service, m := setupAuthService(t)
+	ctx := context.Background()
+
+	t.Run("Success - Revokes Family", func(t *testing.T) {
+		// 1. Setup
+		familyID := uuid.New()
+		m.refreshRepo.EXPECT().
+			GetByHash(ctx, hashToken("token")).
+			Return(&models.RefreshToken{FamilyID: familyID}, nil).
+			Once()
+		m.sessionRepo.EXPECT().Revoke(ctx, familyID).Return(nil).Once()
+
+		// 2. Act
+		err := service.Logout(ctx, "token")
+
+		// 3. Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Unknown Token", func(t *testing.T) {
+		// 1. Setup
+		m.refreshRepo.EXPECT().GetByHash(ctx, hashToken("unknown")).Return(nil, pgx.ErrNoRows).Once()
+
+		// 2. Act
+		err := service.Logout(ctx, "unknown")
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrInvalidRefreshToken)
+	})
+
+	t.Run("Logout All", func(t *testing.T) {
+		// 1. Setup
+		userID := uuid.New()
+		m.sessionRepo.EXPECT().RevokeAllByUserID(ctx, userID).Return(nil).Once()
+		m.patRepo.EXPECT().RevokeAllByUserID(ctx, userID).Return(nil).Once()
+
+		// 2. Act
+		err := service.LogoutAll(ctx, userID)
+
+		// 3. Assert
+		assert.NoError(t, err)
+	})
 }
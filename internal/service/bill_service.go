@@ -0,0 +1,389 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/repository"
+	"github.com/google/uuid"
+)
+
+const (
+	// billReminderBatchSize adalah jumlah pengingat yang dikirim per putaran worker
+	billReminderBatchSize = 200
+	// maxUpcomingPerBill membatasi jumlah jatuh tempo satu tagihan di /bills/upcoming
+	maxUpcomingPerBill = 100
+)
+
+type BillService interface {
+	CreateBill(ctx context.Context, req models.CreateBillRequest, userID uuid.UUID) (*models.Bill, error)
+	GetUserBills(ctx context.Context, userID uuid.UUID) ([]models.Bill, error)
+	UpdateBill(ctx context.Context, billID int64, req models.UpdateBillRequest, userID uuid.UUID) (*models.Bill, error)
+	DeleteBill(ctx context.Context, billID int64, userID uuid.UUID) error
+	PayBill(ctx context.Context, billID int64, req models.PayBillRequest, userID uuid.UUID) (*models.BillPayment, error)
+	GetUpcomingBills(ctx context.Context, userID uuid.UUID, query models.UpcomingBillsQuery) (*models.UpcomingBillsReport, error)
+	SendDueReminders(ctx context.Context, now time.Time) (int, error)
+}
+
+type billService struct {
+	db               txBeginner
+	billRepo         repository.BillRepository
+	categoryRepo     repository.CategoryRepository
+	notificationRepo repository.NotificationRepository
+	now              func() time.Time
+	*transactionWriter
+}
+
+func NewBillService(db txBeginner, billRepo repository.BillRepository, trxRepo repository.TransactionRepository, walletRepo repository.WalletRepository, categoryRepo repository.CategoryRepository, envelopeRepo repository.EnvelopeRepository, budgetRepo repository.BudgetRepository, notificationRepo repository.NotificationRepository, prefsRepo repository.PreferencesRepository) BillService {
+	return &billService{
+		db:                db,
+		billRepo:          billRepo,
+		categoryRepo:      categoryRepo,
+		notificationRepo:  notificationRepo,
+		now:               time.Now,
+		transactionWriter: newTransactionWriter(trxRepo, walletRepo, envelopeRepo, budgetRepo, notificationRepo, prefsRepo),
+	}
+}
+
+func (s *billService) CreateBill(ctx context.Context, req models.CreateBillRequest, userID uuid.UUID) (*models.Bill, error) {
+	wallet, category, err := s.validateTarget(ctx, req.WalletID, req.CategoryID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	frequency := models.RecurrenceFrequency(req.Frequency)
+	if frequency == "" {
+		frequency = models.FrequencyOnce
+	}
+	remindDays := models.DefaultBillRemindDays
+	if req.RemindDaysBefore != nil {
+		remindDays = *req.RemindDaysBefore
+	}
+
+	bill := &models.Bill{
+		UserID:     userID,
+		WalletID:   req.WalletID,
+		CategoryID: req.CategoryID,
+		Name:       req.Name,
+		Amount:     req.Amount,
+		Schedule: models.Schedule{
+			Frequency: frequency,
+			Interval:  max(req.Interval, 1),
+			StartDate: req.DueDate,
+			EndDate:   req.EndDate,
+			Count:     req.Count,
+		},
+		RemindDaysBefore: remindDays,
+		WalletName:       wallet.Name,
+		CategoryName:     category.Name,
+	}
+
+	if err := bill.Schedule.Validate(); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, ErrInvalidSchedule)
+	}
+	loc, err := loadLocation(ctx, s.prefsRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+	bill.DueDate = bill.NextDueDate(loc)
+	if bill.DueDate == nil {
+		return nil, fmt.Errorf("schedule has no due dates: %w", ErrInvalidSchedule)
+	}
+
+	if err := s.billRepo.Create(ctx, bill); err != nil {
+		return nil, err
+	}
+	return bill, nil
+}
+
+func (s *billService) GetUserBills(ctx context.Context, userID uuid.UUID) ([]models.Bill, error) {
+	bills, err := s.billRepo.GetAllByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if bills == nil {
+		bills = []models.Bill{}
+	}
+	return bills, nil
+}
+
+func (s *billService) UpdateBill(ctx context.Context, billID int64, req models.UpdateBillRequest, userID uuid.UUID) (*models.Bill, error) {
+	bill, err := s.billRepo.CheckOwnership(ctx, billID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("bill ownership validation failed: %w", ErrForbidden)
+	}
+
+	wallet, category, err := s.validateTarget(ctx, req.WalletID, req.CategoryID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	bill.WalletID = req.WalletID
+	bill.CategoryID = req.CategoryID
+	bill.Name = req.Name
+	bill.Amount = req.Amount
+	bill.EndDate = req.EndDate
+	bill.Count = req.Count
+	if req.RemindDaysBefore != nil {
+		bill.RemindDaysBefore = *req.RemindDaysBefore
+	}
+	bill.WalletName = wallet.Name
+	bill.CategoryName = category.Name
+
+	if err := bill.Schedule.Validate(); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, ErrInvalidSchedule)
+	}
+	loc, err := loadLocation(ctx, s.prefsRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+	bill.DueDate = bill.NextDueDate(loc)
+
+	if err := s.billRepo.Update(ctx, bill); err != nil {
+		return nil, err
+	}
+	return bill, nil
+}
+
+func (s *billService) DeleteBill(ctx context.Context, billID int64, userID uuid.UUID) error {
+	if _, err := s.billRepo.CheckOwnership(ctx, billID, userID); err != nil {
+		return ErrForbidden
+	}
+
+	return s.billRepo.Delete(ctx, billID)
+}
+
+// PayBill melunasi jatuh tempo berikutnya: mencatat transaksi pengeluaran (dengan efek yang
+// sama seperti CreateTransaction) lalu memajukan tagihan ke jatuh tempo selanjutnya.
+func (s *billService) PayBill(ctx context.Context, billID int64, req models.PayBillRequest, userID uuid.UUID) (*models.BillPayment, error) {
+	bill, err := s.billRepo.CheckOwnership(ctx, billID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("bill ownership validation failed: %w", ErrForbidden)
+	}
+
+	walletID := bill.WalletID
+	if req.WalletID != nil {
+		walletID = *req.WalletID
+	}
+	wallet, err := s.walletRepo.CheckOwnership(ctx, walletID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("wallet ownership validation failed: %w", ErrForbidden)
+	}
+	if wallet.IsArchived() {
+		return nil, ErrWalletArchived
+	}
+	loc, err := loadLocation(ctx, s.prefsRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback(ctx)
+
+	bill, err = s.billRepo.GetForUpdateTx(ctx, tx, billID)
+	if err != nil {
+		return nil, err
+	}
+	if bill.NextDueDate(loc) == nil {
+		return nil, fmt.Errorf("bill has no unpaid due date: %w", ErrConflict)
+	}
+
+	// Kind kategori bisa berubah setelah tagihan dibuat; pembayaran selalu dicatat sebagai pengeluaran
+	category, err := s.categoryRepo.CheckOwnership(ctx, bill.CategoryID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("category ownership validation failed: %w", ErrForbidden)
+	}
+	if category.Kind != models.TransactionExpense {
+		return nil, fmt.Errorf("bill requires an expense category: %w", ErrCategoryKindMismatch)
+	}
+
+	description := bill.Name
+	t := &models.Transaction{
+		UserID:       userID,
+		WalletID:     wallet.ID,
+		CategoryID:   bill.CategoryID,
+		Amount:       bill.Amount,
+		Type:         models.TransactionExpense,
+		Description:  &description,
+		WalletName:   wallet.Name,
+		CategoryName: category.Name,
+	}
+	if req.Amount != nil {
+		t.Amount = *req.Amount
+	}
+	if req.PaidAt != nil {
+		t.TransactionDate = *req.PaidAt
+	} else {
+		t.TransactionDate = s.now()
+	}
+
+	if err := s.createTx(ctx, tx, t); err != nil {
+		return nil, err
+	}
+
+	bill.PaidCount++
+	bill.DueDate = bill.NextDueDate(loc)
+	if err := s.billRepo.MarkPaidTx(ctx, tx, bill.ID, bill.PaidCount, bill.DueDate); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return &models.BillPayment{Bill: bill, Transaction: t}, nil
+}
+
+// GetUpcomingBills mengembalikan semua jatuh tempo yang belum dibayar sampai `days` hari ke depan
+// (termasuk yang sudah lewat), diurutkan berdasarkan tanggal, beserta proyeksi saldo dompet.
+func (s *billService) GetUpcomingBills(ctx context.Context, userID uuid.UUID, query models.UpcomingBillsQuery) (*models.UpcomingBillsReport, error) {
+	days := query.Days
+	if days == 0 {
+		days = models.DefaultUpcomingBillDays
+	}
+	now := s.now()
+	endDate := now.AddDate(0, 0, days)
+	loc, err := loadLocation(ctx, s.prefsRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	bills, err := s.billRepo.GetAllByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []models.UpcomingBill{}
+	for _, b := range bills {
+		for n := b.PaidCount; n < b.PaidCount+maxUpcomingPerBill && b.HasOccurrence(n, loc); n++ {
+			due := b.Occurrence(n, loc)
+			if due.After(endDate) {
+				break
+			}
+			items = append(items, models.UpcomingBill{
+				BillID:       b.ID,
+				Name:         b.Name,
+				WalletID:     b.WalletID,
+				WalletName:   b.WalletName,
+				CategoryID:   b.CategoryID,
+				CategoryName: b.CategoryName,
+				Amount:       b.Amount,
+				DueDate:      due,
+				Overdue:      due.Before(now),
+			})
+		}
+	}
+	slices.SortStableFunc(items, func(a, b models.UpcomingBill) int {
+		return a.DueDate.Compare(b.DueDate)
+	})
+
+	wallets, err := s.walletRepo.GetAllByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.UpcomingBillsReport{
+		StartDate: now,
+		EndDate:   endDate,
+		Bills:     items,
+		Wallets:   []models.WalletBillCoverage{},
+	}
+
+	coverage := make(map[int64]*models.WalletBillCoverage)
+	var order []int64
+	for _, w := range wallets {
+		report.TotalBalance += w.Balance
+		coverage[w.ID] = &models.WalletBillCoverage{WalletID: w.ID, WalletName: w.Name, Balance: w.Balance}
+	}
+
+	for i := range items {
+		c, ok := coverage[items[i].WalletID]
+		if !ok {
+			// Dompet yang diarsipkan tidak ada di daftar dompet aktif
+			wallet, err := s.walletRepo.CheckOwnership(ctx, items[i].WalletID, userID)
+			if err != nil {
+				return nil, err
+			}
+			c = &models.WalletBillCoverage{WalletID: wallet.ID, WalletName: wallet.Name, Balance: wallet.Balance}
+			coverage[wallet.ID] = c
+		}
+		if c.TotalDue == 0 {
+			order = append(order, c.WalletID)
+		}
+
+		c.TotalDue += items[i].Amount
+		items[i].ProjectedBalance = c.Balance - c.TotalDue
+		report.TotalDue += items[i].Amount
+	}
+
+	for _, walletID := range order {
+		c := coverage[walletID]
+		c.Shortfall = max(c.TotalDue-c.Balance, 0)
+		report.TotalShortfall += c.Shortfall
+		report.Wallets = append(report.Wallets, *c)
+	}
+
+	return report, nil
+}
+
+// SendDueReminders membuat notifikasi untuk tagihan yang jatuh tempo dalam RemindDaysBefore hari.
+// Setiap jatuh tempo hanya diingatkan sekali. Tanggal di pesan memakai zona waktu pemilik tagihan.
+func (s *billService) SendDueReminders(ctx context.Context, now time.Time) (int, error) {
+	bills, err := s.billRepo.GetDueForReminder(ctx, now, billReminderBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	var errs []error
+	locations := make(map[uuid.UUID]*time.Location)
+	for _, b := range bills {
+		loc, ok := locations[b.UserID]
+		if !ok {
+			loc, err = loadLocation(ctx, s.prefsRepo, b.UserID)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("bill %d: %w", b.ID, err))
+				continue
+			}
+			locations[b.UserID] = loc
+		}
+
+		created, err := s.notificationRepo.Create(ctx, models.NewBillReminderNotification(b, loc))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("bill %d: %w", b.ID, err))
+			continue
+		}
+		if created {
+			sent++
+		}
+	}
+
+	return sent, errors.Join(errs...)
+}
+
+// validateTarget memastikan dompet & kategori milik user dan kategori adalah kategori pengeluaran.
+func (s *billService) validateTarget(ctx context.Context, walletID int64, categoryID int64, userID uuid.UUID) (*models.Wallet, *models.Category, error) {
+	wallet, err := s.walletRepo.CheckOwnership(ctx, walletID, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("wallet ownership validation failed: %w", ErrForbidden)
+	}
+	if wallet.IsArchived() {
+		return nil, nil, ErrWalletArchived
+	}
+	category, err := s.categoryRepo.CheckOwnership(ctx, categoryID, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("category ownership validation failed: %w", ErrForbidden)
+	}
+	if category.Kind != models.TransactionExpense {
+		return nil, nil, fmt.Errorf("bill requires an expense category: %w", ErrCategoryKindMismatch)
+	}
+	return wallet, category, nil
+}
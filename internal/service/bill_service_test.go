@@ -0,0 +1,348 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	repoMocks "github.com/Udean777/uang-bijak-go/internal/repository/mocks"
+)
+
+// billTestNow adalah "sekarang" untuk tes tagihan: 10 Mei 2026, 12:00 WIB
+var billTestNow = time.Date(2026, time.May, 10, 5, 0, 0, 0, time.UTC)
+
+type billMocks struct {
+	billRepo         *repoMocks.MockBillRepository
+	trxRepo          *repoMocks.MockTransactionRepository
+	walletRepo       *repoMocks.MockWalletRepository
+	categoryRepo     *repoMocks.MockCategoryRepository
+	envelopeRepo     *repoMocks.MockEnvelopeRepository
+	budgetRepo       *repoMocks.MockBudgetRepository
+	notificationRepo *repoMocks.MockNotificationRepository
+	prefsRepo        *repoMocks.MockPreferencesRepository
+	tx               *fakeTx
+}
+
+// Helper setup
+func setupBillService(t *testing.T) (BillService, billMocks) {
+	m := billMocks{
+		billRepo:         repoMocks.NewMockBillRepository(t),
+		trxRepo:          repoMocks.NewMockTransactionRepository(t),
+		walletRepo:       repoMocks.NewMockWalletRepository(t),
+		categoryRepo:     repoMocks.NewMockCategoryRepository(t),
+		envelopeRepo:     repoMocks.NewMockEnvelopeRepository(t),
+		budgetRepo:       repoMocks.NewMockBudgetRepository(t),
+		notificationRepo: repoMocks.NewMockNotificationRepository(t),
+		prefsRepo:        repoMocks.NewMockPreferencesRepository(t),
+		tx:               &fakeTx{},
+	}
+	service := NewBillService(&fakeDB{tx: m.tx}, m.billRepo, m.trxRepo, m.walletRepo, m.categoryRepo,
+		m.envelopeRepo, m.budgetRepo, m.notificationRepo, m.prefsRepo).(*billService)
+	service.now = func() time.Time { return billTestNow }
+	return service, m
+}
+
+func TestBillService_CreateBill(t *testing.T) {
+	service, m := setupBillService(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+	dueDate := time.Date(2026, time.May, 20, 0, 0, 0, 0, time.UTC)
+	req := models.CreateBillRequest{WalletID: 1, CategoryID: 2, Name: "Listrik PLN", Amount: 450000, DueDate: dueDate}
+
+	t.Run("Success - Defaults To One-off With Default Reminder", func(t *testing.T) {
+		// 1. Setup
+		m.walletRepo.EXPECT().CheckOwnership(ctx, req.WalletID, testUserID).Return(&models.Wallet{ID: 1, Name: "BCA"}, nil).Once()
+		m.categoryRepo.EXPECT().
+			CheckOwnership(ctx, req.CategoryID, testUserID).
+			Return(&models.Category{ID: 2, Name: "Listrik", Kind: models.TransactionExpense}, nil).
+			Once()
+		m.prefsRepo.EXPECT().GetByUserID(ctx, testUserID).Return(nil, pgx.ErrNoRows).Once()
+		m.billRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*models.Bill")).
+			Run(func(ctx context.Context, b *models.Bill) {
+				assert.Equal(t, models.FrequencyOnce, b.Frequency)
+				assert.Equal(t, models.DefaultBillRemindDays, b.RemindDaysBefore)
+				assert.True(t, b.DueDate.Equal(dueDate))
+				b.ID = 5
+			}).
+			Return(nil).
+			Once()
+
+		// 2. Act
+		bill, err := service.CreateBill(ctx, req, testUserID)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), bill.ID)
+	})
+
+	t.Run("Fail - Income Category", func(t *testing.T) {
+		// 1. Setup
+		m.walletRepo.EXPECT().CheckOwnership(ctx, req.WalletID, testUserID).Return(&models.Wallet{ID: 1}, nil).Once()
+		m.categoryRepo.EXPECT().
+			CheckOwnership(ctx, req.CategoryID, testUserID).
+			Return(&models.Category{ID: 2, Name: "Gaji", Kind: models.TransactionIncome}, nil).
+			Once()
+
+		// 2. Act
+		_, err := service.CreateBill(ctx, req, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrCategoryKindMismatch)
+	})
+}
+
+func TestBillService_UpdateBill(t *testing.T) {
+	service, m := setupBillService(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+	dueDate := time.Date(2026, time.May, 20, 0, 0, 0, 0, time.UTC)
+	existing := func() *models.Bill {
+		return &models.Bill{
+			ID: 5, WalletID: 1, CategoryID: 2, Name: "Listrik PLN", Amount: 450000, RemindDaysBefore: 7,
+			Schedule: models.Schedule{Frequency: models.FrequencyOnce, Interval: 1, StartDate: dueDate},
+		}
+	}
+	req := models.UpdateBillRequest{WalletID: 1, CategoryID: 2, Name: "Listrik PLN", Amount: 500000}
+
+	expectTarget := func() {
+		m.walletRepo.EXPECT().CheckOwnership(ctx, req.WalletID, testUserID).Return(&models.Wallet{ID: 1, Name: "BCA"}, nil).Once()
+		m.categoryRepo.EXPECT().
+			CheckOwnership(ctx, req.CategoryID, testUserID).
+			Return(&models.Category{ID: 2, Name: "Listrik", Kind: models.TransactionExpense}, nil).
+			Once()
+		m.prefsRepo.EXPECT().GetByUserID(ctx, testUserID).Return(nil, pgx.ErrNoRows).Once()
+	}
+
+	t.Run("Success - Keeps Reminder When Omitted", func(t *testing.T) {
+		// 1. Setup
+		m.billRepo.EXPECT().CheckOwnership(ctx, int64(5), testUserID).Return(existing(), nil).Once()
+		expectTarget()
+		m.billRepo.EXPECT().Update(ctx, mock.AnythingOfType("*models.Bill")).Return(nil).Once()
+
+		// 2. Act
+		bill, err := service.UpdateBill(ctx, 5, req, testUserID)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(500000), bill.Amount)
+		assert.Equal(t, 7, bill.RemindDaysBefore)
+	})
+
+	t.Run("Success - Reminder Can Be Set To Zero", func(t *testing.T) {
+		// 1. Setup
+		zero := 0
+		withReminder := req
+		withReminder.RemindDaysBefore = &zero
+		m.billRepo.EXPECT().CheckOwnership(ctx, int64(5), testUserID).Return(existing(), nil).Once()
+		expectTarget()
+		m.billRepo.EXPECT().Update(ctx, mock.AnythingOfType("*models.Bill")).Return(nil).Once()
+
+		// 2. Act
+		bill, err := service.UpdateBill(ctx, 5, withReminder, testUserID)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 0, bill.RemindDaysBefore)
+	})
+}
+
+// Skenario sukses PayBill membutuhkan tx database (Integration Test)
+func TestBillService_PayBill_Failure(t *testing.T) {
+	service, m := setupBillService(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+
+	t.Run("Fail - Bill Not Owned", func(t *testing.T) {
+		// 1. Setup
+		m.billRepo.EXPECT().CheckOwnership(ctx, int64(1), testUserID).Return(nil, errors.New("not found")).Once()
+
+		// 2. Act
+		_, err := service.PayBill(ctx, 1, models.PayBillRequest{}, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("Fail - Other User's Wallet", func(t *testing.T) {
+		// 1. Setup
+		otherWallet := int64(99)
+		m.billRepo.EXPECT().CheckOwnership(ctx, int64(1), testUserID).Return(&models.Bill{ID: 1, WalletID: 1}, nil).Once()
+		m.walletRepo.EXPECT().CheckOwnership(ctx, otherWallet, testUserID).Return(nil, errors.New("not found")).Once()
+
+		// 2. Act
+		_, err := service.PayBill(ctx, 1, models.PayBillRequest{WalletID: &otherWallet}, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("Fail - Category Changed To Income", func(t *testing.T) {
+		// 1. Setup
+		bill := &models.Bill{
+			ID: 1, WalletID: 1, CategoryID: 2, Name: "Listrik PLN", Amount: 450000,
+			Schedule: models.Schedule{Frequency: models.FrequencyMonthly, Interval: 1, StartDate: billTestNow},
+		}
+		m.billRepo.EXPECT().CheckOwnership(ctx, int64(1), testUserID).Return(bill, nil).Once()
+		m.walletRepo.EXPECT().CheckOwnership(ctx, int64(1), testUserID).Return(&models.Wallet{ID: 1, Name: "BCA"}, nil).Once()
+		m.prefsRepo.EXPECT().GetByUserID(ctx, testUserID).Return(nil, pgx.ErrNoRows).Once()
+		m.billRepo.EXPECT().GetForUpdateTx(ctx, m.tx, int64(1)).Return(bill, nil).Once()
+		m.categoryRepo.EXPECT().
+			CheckOwnership(ctx, int64(2), testUserID).
+			Return(&models.Category{ID: 2, Name: "Listrik", Kind: models.TransactionIncome}, nil).
+			Once()
+
+		// 2. Act
+		_, err := service.PayBill(ctx, 1, models.PayBillRequest{}, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrCategoryKindMismatch)
+		m.trxRepo.AssertNotCalled(t, "CreateTx", ctx, m.tx, mock.Anything)
+	})
+}
+
+func TestBillService_PayBill(t *testing.T) {
+	service, m := setupBillService(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+
+	t.Run("Success - Records Expense With Category Name", func(t *testing.T) {
+		// 1. Setup
+		bill := &models.Bill{
+			ID: 1, WalletID: 1, CategoryID: 2, Name: "Listrik PLN", Amount: 450000,
+			Schedule: models.Schedule{Frequency: models.FrequencyMonthly, Interval: 1, StartDate: billTestNow},
+		}
+		m.billRepo.EXPECT().CheckOwnership(ctx, int64(1), testUserID).Return(bill, nil).Once()
+		m.walletRepo.EXPECT().CheckOwnership(ctx, int64(1), testUserID).Return(&models.Wallet{ID: 1, Name: "BCA"}, nil).Once()
+		m.prefsRepo.EXPECT().GetByUserID(ctx, testUserID).Return(nil, pgx.ErrNoRows).Once()
+		m.billRepo.EXPECT().GetForUpdateTx(ctx, m.tx, int64(1)).Return(bill, nil).Once()
+		m.categoryRepo.EXPECT().
+			CheckOwnership(ctx, int64(2), testUserID).
+			Return(&models.Category{ID: 2, Name: "Listrik", Kind: models.TransactionExpense}, nil).
+			Once()
+
+		m.walletRepo.EXPECT().UpdateBalanceTx(ctx, m.tx, int64(1), int64(-450000)).Return(nil).Once()
+		m.envelopeRepo.EXPECT().DrawDownTx(ctx, m.tx, int64(2), int64(450000)).Return(nil, nil).Once()
+		m.trxRepo.EXPECT().CreateTx(ctx, m.tx, mock.AnythingOfType("*models.Transaction")).Return(nil).Once()
+		m.prefsRepo.EXPECT().GetByUserID(ctx, testUserID).Return(nil, pgx.ErrNoRows).Once()
+		m.budgetRepo.EXPECT().
+			GetUsageForCategoryTx(ctx, m.tx, testUserID, int64(2), mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, nil).
+			Once()
+		m.billRepo.EXPECT().MarkPaidTx(ctx, m.tx, int64(1), 1, mock.AnythingOfType("*time.Time")).Return(nil).Once()
+
+		// 2. Act
+		payment, err := service.PayBill(ctx, 1, models.PayBillRequest{}, testUserID)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.True(t, m.tx.committed)
+		assert.Equal(t, "Listrik", payment.Transaction.CategoryName)
+		assert.Equal(t, "BCA", payment.Transaction.WalletName)
+		assert.True(t, payment.Transaction.TransactionDate.Equal(billTestNow))
+	})
+}
+
+func TestBillService_GetUpcomingBills(t *testing.T) {
+	service, m := setupBillService(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+	now := billTestNow
+
+	t.Run("Success - Projects Balance Per Wallet", func(t *testing.T) {
+		// 1. Setup
+		bills := []models.Bill{
+			{
+				// Internet bulanan: jatuh tempo 10 hari lagi, lalu ~40 hari lagi (di luar rentang)
+				ID: 1, WalletID: 1, WalletName: "BCA", Name: "Internet", Amount: 300000,
+				Schedule: models.Schedule{Frequency: models.FrequencyMonthly, StartDate: now.AddDate(0, 0, 10)},
+			},
+			{
+				// Kartu kredit sudah lewat 2 hari dan belum dibayar
+				ID: 2, WalletID: 1, WalletName: "BCA", Name: "Kartu Kredit", Amount: 900000,
+				Schedule: models.Schedule{Frequency: models.FrequencyOnce, StartDate: now.AddDate(0, 0, -2)},
+			},
+			{
+				ID: 3, WalletID: 2, WalletName: "Tunai", Name: "Iuran RT", Amount: 50000,
+				Schedule: models.Schedule{Frequency: models.FrequencyOnce, StartDate: now.AddDate(0, 0, 5)},
+			},
+		}
+		m.prefsRepo.EXPECT().GetByUserID(ctx, testUserID).Return(nil, pgx.ErrNoRows).Once()
+		m.billRepo.EXPECT().GetAllByUserID(ctx, testUserID).Return(bills, nil).Once()
+		m.walletRepo.EXPECT().
+			GetAllByUserID(ctx, testUserID).
+			Return([]models.Wallet{{ID: 1, Name: "BCA", Balance: 1000000}, {ID: 2, Name: "Tunai", Balance: 200000}}, nil).
+			Once()
+
+		// 2. Act
+		report, err := service.GetUpcomingBills(ctx, testUserID, models.UpcomingBillsQuery{})
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Len(t, report.Bills, 3)
+
+		assert.Equal(t, "Kartu Kredit", report.Bills[0].Name)
+		assert.True(t, report.Bills[0].Overdue)
+		assert.Equal(t, int64(100000), report.Bills[0].ProjectedBalance)
+
+		assert.Equal(t, "Iuran RT", report.Bills[1].Name)
+		assert.Equal(t, int64(150000), report.Bills[1].ProjectedBalance)
+
+		assert.Equal(t, "Internet", report.Bills[2].Name)
+		assert.Equal(t, int64(-200000), report.Bills[2].ProjectedBalance)
+
+		assert.Equal(t, int64(1250000), report.TotalDue)
+		assert.Equal(t, int64(1200000), report.TotalBalance)
+		assert.Equal(t, int64(200000), report.TotalShortfall)
+		assert.Len(t, report.Wallets, 2)
+		assert.Equal(t, int64(200000), report.Wallets[0].Shortfall)
+		assert.Equal(t, int64(0), report.Wallets[1].Shortfall)
+	})
+}
+
+func TestBillService_SendDueReminders(t *testing.T) {
+	service, m := setupBillService(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+	now := time.Date(2026, time.February, 18, 20, 0, 0, 0, time.UTC)
+
+	t.Run("Counts Only Newly Created Reminders", func(t *testing.T) {
+		// 1. Setup: kedua tagihan milik user yang sama, preferensi cukup dimuat sekali
+		bills := []models.Bill{
+			{ID: 1, UserID: testUserID, Name: "Internet", Schedule: models.Schedule{Frequency: models.FrequencyOnce, StartDate: now}},
+			{ID: 2, UserID: testUserID, Name: "Listrik", Schedule: models.Schedule{Frequency: models.FrequencyOnce, StartDate: now}},
+		}
+		m.billRepo.EXPECT().GetDueForReminder(ctx, now, billReminderBatchSize).Return(bills, nil).Once()
+		m.prefsRepo.EXPECT().
+			GetByUserID(ctx, testUserID).
+			Return(&models.UserPreferences{UserID: testUserID, Timezone: "Asia/Tokyo"}, nil).
+			Once()
+		// Tanggal di pesan mengikuti zona waktu user (20:00 UTC = 05:00 keesokan harinya di Tokyo)
+		m.notificationRepo.EXPECT().
+			Create(ctx, mock.MatchedBy(func(n *models.Notification) bool {
+				return n.DedupKey == "bill:1:0" && strings.Contains(n.Message, "2026-02-19")
+			})).
+			Return(true, nil).
+			Once()
+		// Pengingat kedua sudah pernah dibuat (balapan antar instance)
+		m.notificationRepo.EXPECT().
+			Create(ctx, mock.MatchedBy(func(n *models.Notification) bool { return n.DedupKey == "bill:2:0" })).
+			Return(false, nil).
+			Once()
+
+		// 2. Act
+		sent, err := service.SendDueReminders(ctx, now)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 1, sent)
+	})
+}
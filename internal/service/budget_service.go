@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/repository"
+	"github.com/google/uuid"
+)
+
+type BudgetService interface {
+	CreateBudget(ctx context.Context, req models.CreateBudgetRequest, userID uuid.UUID) (*models.Budget, error)
+	// year/month 0 (juga pada GetBudgetStatus) berarti periode bulan keuangan yang sedang berjalan
+	// menurut preferensi user
+	GetUserBudgets(ctx context.Context, userID uuid.UUID, year int, month int) ([]models.Budget, error)
+	UpdateBudget(ctx context.Context, budgetID int64, req models.UpdateBudgetRequest, userID uuid.UUID) error
+	DeleteBudget(ctx context.Context, budgetID int64, userID uuid.UUID) error
+	GetBudgetStatus(ctx context.Context, userID uuid.UUID, year int, month int) (*models.BudgetStatusReport, error)
+}
+
+type budgetService struct {
+	budgetRepo   repository.BudgetRepository
+	categoryRepo repository.CategoryRepository
+	trxRepo      repository.TransactionRepository
+	prefsRepo    repository.PreferencesRepository
+	now          func() time.Time
+}
+
+func NewBudgetService(budgetRepo repository.BudgetRepository, categoryRepo repository.CategoryRepository, trxRepo repository.TransactionRepository, prefsRepo repository.PreferencesRepository) BudgetService {
+	return &budgetService{
+		budgetRepo:   budgetRepo,
+		categoryRepo: categoryRepo,
+		trxRepo:      trxRepo,
+		prefsRepo:    prefsRepo,
+		now:          time.Now,
+	}
+}
+
+func (s *budgetService) CreateBudget(ctx context.Context, req models.CreateBudgetRequest, userID uuid.UUID) (*models.Budget, error) {
+	category, err := s.categoryRepo.CheckOwnership(ctx, req.CategoryID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("category ownership validation failed: %w", ErrForbidden)
+	}
+	// Anggaran hanya masuk akal untuk kategori pengeluaran
+	if category.Kind != models.TransactionExpense {
+		return nil, fmt.Errorf("budget requires an expense category: %w", ErrCategoryKindMismatch)
+	}
+
+	budget := &models.Budget{
+		UserID:       userID,
+		CategoryID:   req.CategoryID,
+		CategoryName: category.Name,
+		Year:         req.Year,
+		Month:        req.Month,
+		Amount:       req.Amount,
+		Rollover:     req.Rollover,
+	}
+	if req.AlertThresholds == nil {
+		budget.AlertThresholds = slices.Clone(models.DefaultAlertThresholds)
+	} else {
+		budget.AlertThresholds = normalizeThresholds(req.AlertThresholds)
+	}
+
+	if err := s.budgetRepo.Create(ctx, budget); err != nil {
+		if errors.Is(err, repository.ErrDuplicate) {
+			return nil, fmt.Errorf("budget for this category and month already exists: %w", ErrConflict)
+		}
+		return nil, err
+	}
+	return budget, nil
+}
+
+func (s *budgetService) GetUserBudgets(ctx context.Context, userID uuid.UUID, year int, month int) ([]models.Budget, error) {
+	prefs, err := loadPreferences(ctx, s.prefsRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+	year, month = prefs.ResolvePeriod(year, month, s.now())
+
+	return s.budgetRepo.GetAllByUserIDAndPeriod(ctx, userID, year, month)
+}
+
+func (s *budgetService) UpdateBudget(ctx context.Context, budgetID int64, req models.UpdateBudgetRequest, userID uuid.UUID) error {
+	budget, err := s.budgetRepo.CheckOwnership(ctx, budgetID, userID)
+	if err != nil {
+		return ErrForbidden
+	}
+
+	rollover := budget.Rollover
+	if req.Rollover != nil {
+		rollover = *req.Rollover
+	}
+	thresholds := budget.AlertThresholds
+	if req.AlertThresholds != nil {
+		thresholds = normalizeThresholds(req.AlertThresholds)
+	}
+
+	return s.budgetRepo.Update(ctx, budgetID, req.Amount, rollover, thresholds)
+}
+
+// normalizeThresholds mengurutkan ambang notifikasi dan membuang duplikat.
+func normalizeThresholds(thresholds []int) []int {
+	normalized := slices.Clone(thresholds)
+	slices.Sort(normalized)
+	return slices.Compact(normalized)
+}
+
+func (s *budgetService) DeleteBudget(ctx context.Context, budgetID int64, userID uuid.UUID) error {
+	if _, err := s.budgetRepo.CheckOwnership(ctx, budgetID, userID); err != nil {
+		return ErrForbidden
+	}
+
+	return s.budgetRepo.Delete(ctx, budgetID)
+}
+
+// GetBudgetStatus menghitung anggaran vs. pengeluaran untuk satu bulan keuangan. Batas bulan
+// mengikuti preferensi user (zona waktu, tanggal awal bulan) seperti dashboard.
+func (s *budgetService) GetBudgetStatus(ctx context.Context, userID uuid.UUID, year int, month int) (*models.BudgetStatusReport, error) {
+	prefs, err := loadPreferences(ctx, s.prefsRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+	year, month = prefs.ResolvePeriod(year, month, s.now())
+
+	budgets, err := s.budgetRepo.GetAllByUserIDAndPeriod(ctx, userID, year, month)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime, endTime := prefs.MonthRange(year, month)
+
+	report := &models.BudgetStatusReport{
+		Year:      year,
+		Month:     month,
+		StartDate: startTime,
+		EndDate:   endTime,
+		Budgets:   make([]models.BudgetStatus, 0, len(budgets)),
+	}
+	if len(budgets) == 0 {
+		return report, nil
+	}
+
+	spending := newSpendingCache(s.trxRepo, userID, prefs)
+
+	spent, err := spending.forPeriod(ctx, models.PeriodIndex(year, month))
+	if err != nil {
+		return nil, err
+	}
+
+	carryOver, err := rolloverCarryOver(ctx, s.budgetRepo, userID, year, month, budgets, spending)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, b := range budgets {
+		status := models.BudgetStatus{
+			BudgetID:     b.ID,
+			CategoryID:   b.CategoryID,
+			CategoryName: b.CategoryName,
+			Budgeted:     b.Amount,
+			CarryOver:    carryOver[b.ID],
+			Spent:        spent[b.CategoryID],
+		}
+		status.Available = status.Budgeted + status.CarryOver
+		status.Remaining = status.Available - status.Spent
+
+		report.TotalBudgeted += status.Budgeted
+		report.TotalCarryOver += status.CarryOver
+		report.TotalSpent += status.Spent
+		report.TotalRemaining += status.Remaining
+		report.Budgets = append(report.Budgets, status)
+	}
+
+	return report, nil
+}
+
+// rolloverCarryOver menghitung sisa yang dibawa ke bulan ini untuk setiap anggaran ber-rollover.
+// Rantai dihitung dari bulan ber-rollover paling awal yang berurutan (tanpa bulan kosong),
+// maksimal models.MaxRolloverMonths ke belakang: sisa = anggaran + sisa sebelumnya - terpakai.
+// Dipakai bersama oleh status anggaran dan notifikasi ambang agar angkanya selalu sama.
+func rolloverCarryOver(ctx context.Context, budgetRepo repository.BudgetRepository, userID uuid.UUID, year int, month int, budgets []models.Budget, spending *spendingCache) (map[int64]int64, error) {
+	result := make(map[int64]int64)
+
+	hasRollover := false
+	for _, b := range budgets {
+		hasRollover = hasRollover || b.Rollover
+	}
+	if !hasRollover {
+		return result, nil
+	}
+
+	history, err := budgetRepo.GetRolloverHistory(ctx, userID, year, month, models.MaxRolloverMonths)
+	if err != nil {
+		return nil, err
+	}
+
+	// byCategory[categoryID][periodIndex] = anggaran bulan tersebut
+	byCategory := make(map[int64]map[int]models.Budget)
+	for _, h := range history {
+		if byCategory[h.CategoryID] == nil {
+			byCategory[h.CategoryID] = make(map[int]models.Budget)
+		}
+		byCategory[h.CategoryID][models.PeriodIndex(h.Year, h.Month)] = h
+	}
+
+	current := models.PeriodIndex(year, month)
+	for _, b := range budgets {
+		if !b.Rollover {
+			continue
+		}
+
+		periods := byCategory[b.CategoryID]
+		start := current
+		for {
+			if _, ok := periods[start-1]; !ok {
+				break
+			}
+			start--
+		}
+
+		var carry int64
+		for p := start; p < current; p++ {
+			spent, err := spending.forPeriod(ctx, p)
+			if err != nil {
+				return nil, err
+			}
+			carry = periods[p].Amount + carry - spent[b.CategoryID]
+		}
+		result[b.ID] = carry
+	}
+
+	return result, nil
+}
+
+// spendingCache menyimpan pengeluaran per kategori per bulan keuangan user agar setiap bulan
+// hanya di-query sekali.
+type spendingCache struct {
+	trxRepo  repository.TransactionRepository
+	userID   uuid.UUID
+	prefs    *models.UserPreferences
+	byPeriod map[int]map[int64]int64
+}
+
+func newSpendingCache(trxRepo repository.TransactionRepository, userID uuid.UUID, prefs *models.UserPreferences) *spendingCache {
+	return &spendingCache{trxRepo: trxRepo, userID: userID, prefs: prefs, byPeriod: make(map[int]map[int64]int64)}
+}
+
+func (c *spendingCache) forPeriod(ctx context.Context, periodIndex int) (map[int64]int64, error) {
+	if spent, ok := c.byPeriod[periodIndex]; ok {
+		return spent, nil
+	}
+
+	startTime, endTime := c.prefs.MonthRange(periodIndex/12, periodIndex%12+1)
+
+	summaries, err := c.trxRepo.GetTotalsByCategory(ctx, c.userID, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	spent := expenseWithDescendants(summaries)
+	c.byPeriod[periodIndex] = spent
+	return spent, nil
+}
+
+// expenseWithDescendants mengembalikan total pengeluaran per kategori termasuk sub-kategorinya.
+func expenseWithDescendants(summaries []models.CategorySummary) map[int64]int64 {
+	totals := make(map[int64]int64, len(summaries))
+
+	var walk func(nodes []models.CategorySummary)
+	walk = func(nodes []models.CategorySummary) {
+		for _, n := range nodes {
+			totals[n.CategoryID] = n.TotalExpense
+			walk(n.Children)
+		}
+	}
+	walk(models.RollupCategorySummaries(summaries))
+
+	return totals
+}
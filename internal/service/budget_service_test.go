@@ -0,0 +1,295 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/repository"
+	repoMocks "github.com/Udean777/uang-bijak-go/internal/repository/mocks"
+)
+
+// budgetTestNow adalah "sekarang" untuk tes anggaran: 10 Oktober 2025, 12:00 WIB
+var budgetTestNow = time.Date(2025, time.October, 10, 5, 0, 0, 0, time.UTC)
+
+// Helper setup
+func setupBudgetService(t *testing.T) (BudgetService, *repoMocks.MockBudgetRepository, *repoMocks.MockCategoryRepository, *repoMocks.MockTransactionRepository, *repoMocks.MockPreferencesRepository) {
+	mockBudgetRepo := repoMocks.NewMockBudgetRepository(t)
+	mockCategoryRepo := repoMocks.NewMockCategoryRepository(t)
+	mockTrxRepo := repoMocks.NewMockTransactionRepository(t)
+	mockPrefsRepo := repoMocks.NewMockPreferencesRepository(t)
+	service := NewBudgetService(mockBudgetRepo, mockCategoryRepo, mockTrxRepo, mockPrefsRepo).(*budgetService)
+	service.now = func() time.Time { return budgetTestNow }
+	return service, mockBudgetRepo, mockCategoryRepo, mockTrxRepo, mockPrefsRepo
+}
+
+func TestBudgetService_CreateBudget(t *testing.T) {
+	service, mockBudgetRepo, mockCategoryRepo, _, _ := setupBudgetService(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+	req := models.CreateBudgetRequest{CategoryID: 1, Year: 2025, Month: 10, Amount: 1500000}
+
+	t.Run("Success", func(t *testing.T) {
+		// 1. Setup
+		mockCategoryRepo.EXPECT().
+			CheckOwnership(ctx, req.CategoryID, testUserID).
+			Return(&models.Category{ID: 1, Name: "Makanan", Kind: models.TransactionExpense}, nil).
+			Once()
+
+		mockBudgetRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*models.Budget")).
+			Run(func(ctx context.Context, b *models.Budget) {
+				assert.Equal(t, testUserID, b.UserID)
+				assert.Equal(t, 10, b.Month)
+				assert.Equal(t, models.DefaultAlertThresholds, b.AlertThresholds)
+				b.ID = 7
+			}).
+			Return(nil).
+			Once()
+
+		// 2. Act
+		budget, err := service.CreateBudget(ctx, req, testUserID)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), budget.ID)
+		assert.Equal(t, "Makanan", budget.CategoryName)
+	})
+
+	t.Run("Fail - Category Not Owned", func(t *testing.T) {
+		// 1. Setup
+		mockCategoryRepo.EXPECT().
+			CheckOwnership(ctx, req.CategoryID, testUserID).
+			Return(nil, errors.New("not found")).
+			Once()
+
+		// 2. Act
+		_, err := service.CreateBudget(ctx, req, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("Fail - Income Category", func(t *testing.T) {
+		// 1. Setup
+		mockCategoryRepo.EXPECT().
+			CheckOwnership(ctx, req.CategoryID, testUserID).
+			Return(&models.Category{ID: 1, Name: "Gaji", Kind: models.TransactionIncome}, nil).
+			Once()
+
+		// 2. Act
+		_, err := service.CreateBudget(ctx, req, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrCategoryKindMismatch)
+	})
+
+	t.Run("Fail - Duplicate Period", func(t *testing.T) {
+		// 1. Setup
+		mockCategoryRepo.EXPECT().
+			CheckOwnership(ctx, req.CategoryID, testUserID).
+			Return(&models.Category{ID: 1, Name: "Makanan", Kind: models.TransactionExpense}, nil).
+			Once()
+
+		mockBudgetRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*models.Budget")).
+			Return(repository.ErrDuplicate).
+			Once()
+
+		// 2. Act
+		_, err := service.CreateBudget(ctx, req, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrConflict)
+	})
+}
+
+func TestBudgetService_UpdateAndDeleteBudget(t *testing.T) {
+	service, mockBudgetRepo, _, _, _ := setupBudgetService(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+	budgetID := int64(7)
+
+	t.Run("Success - Update", func(t *testing.T) {
+		// 1. Setup
+		// Rollover & ambang tidak dikirim, jadi nilai lama dipertahankan
+		existing := &models.Budget{ID: budgetID, Rollover: true, AlertThresholds: []int{50, 90}}
+		mockBudgetRepo.EXPECT().CheckOwnership(ctx, budgetID, testUserID).Return(existing, nil).Once()
+		mockBudgetRepo.EXPECT().Update(ctx, budgetID, int64(2000000), true, []int{50, 90}).Return(nil).Once()
+
+		// 2. Act
+		err := service.UpdateBudget(ctx, budgetID, models.UpdateBudgetRequest{Amount: 2000000}, testUserID)
+
+		// 3. Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Success - Update Normalizes Thresholds", func(t *testing.T) {
+		// 1. Setup
+		existing := &models.Budget{ID: budgetID, AlertThresholds: models.DefaultAlertThresholds}
+		mockBudgetRepo.EXPECT().CheckOwnership(ctx, budgetID, testUserID).Return(existing, nil).Once()
+		mockBudgetRepo.EXPECT().Update(ctx, budgetID, int64(2000000), false, []int{50, 75, 100}).Return(nil).Once()
+
+		// 2. Act
+		req := models.UpdateBudgetRequest{Amount: 2000000, AlertThresholds: []int{100, 50, 75, 50}}
+		err := service.UpdateBudget(ctx, budgetID, req, testUserID)
+
+		// 3. Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fail - Delete Not Owner", func(t *testing.T) {
+		// 1. Setup
+		mockBudgetRepo.EXPECT().CheckOwnership(ctx, budgetID, testUserID).Return(nil, errors.New("not found")).Once()
+
+		// 2. Act
+		err := service.DeleteBudget(ctx, budgetID, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrForbidden)
+		mockBudgetRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
+
+func TestBudgetService_GetBudgetStatus(t *testing.T) {
+	service, mockBudgetRepo, _, mockTrxRepo, mockPrefsRepo := setupBudgetService(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+	transportID := int64(1)
+
+	// Bulan keuangan user dimulai tanggal 25
+	prefs := models.DefaultUserPreferences(testUserID)
+	prefs.MonthStartDay = 25
+
+	t.Run("Success - Includes Subcategory Spending", func(t *testing.T) {
+		// 1. Setup
+		mockPrefsRepo.EXPECT().GetByUserID(ctx, testUserID).Return(prefs, nil).Once()
+		mockBudgetRepo.EXPECT().
+			GetAllByUserIDAndPeriod(ctx, testUserID, 2025, 10).
+			Return([]models.Budget{
+				{ID: 1, CategoryID: transportID, CategoryName: "Transportasi", Amount: 500000},
+				{ID: 2, CategoryID: 3, CategoryName: "Makanan", Amount: 1000000},
+			}, nil).
+			Once()
+
+		// Rentang waktu mengikuti bulan keuangan user: 25 Oktober - 24 November 2025
+		startTime, endTime := prefs.MonthRange(2025, 10)
+
+		mockTrxRepo.EXPECT().
+			GetTotalsByCategory(ctx, testUserID, startTime, endTime).
+			Return([]models.CategorySummary{
+				{CategoryID: transportID, Name: "Transportasi", TotalExpense: 100000},
+				{CategoryID: 2, ParentID: &transportID, Name: "Bensin", TotalExpense: 450000},
+				{CategoryID: 3, Name: "Makanan", TotalExpense: 250000},
+			}, nil).
+			Once()
+
+		// 2. Act
+		report, err := service.GetBudgetStatus(ctx, testUserID, 2025, 10)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Len(t, report.Budgets, 2)
+		assert.Equal(t, int64(550000), report.Budgets[0].Spent)
+		assert.Equal(t, int64(-50000), report.Budgets[0].Remaining)
+		assert.Equal(t, int64(750000), report.Budgets[1].Remaining)
+		assert.Equal(t, int64(1500000), report.TotalBudgeted)
+		assert.Equal(t, int64(800000), report.TotalSpent)
+		assert.Equal(t, startTime, report.StartDate)
+	})
+
+	t.Run("Success - No Budgets", func(t *testing.T) {
+		// 1. Setup
+		mockPrefsRepo.EXPECT().GetByUserID(ctx, testUserID).Return(prefs, nil).Once()
+		mockBudgetRepo.EXPECT().
+			GetAllByUserIDAndPeriod(ctx, testUserID, 2025, 11).
+			Return(nil, nil).
+			Once()
+
+		// 2. Act
+		report, err := service.GetBudgetStatus(ctx, testUserID, 2025, 11)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Empty(t, report.Budgets)
+	})
+
+	t.Run("Success - Defaults To Current Financial Month", func(t *testing.T) {
+		// 1. Setup: 10 Oktober masih termasuk bulan keuangan September (25 Sep - 24 Okt)
+		mockPrefsRepo.EXPECT().GetByUserID(ctx, testUserID).Return(prefs, nil).Once()
+		mockBudgetRepo.EXPECT().
+			GetAllByUserIDAndPeriod(ctx, testUserID, 2025, 9).
+			Return(nil, nil).
+			Once()
+
+		// 2. Act
+		report, err := service.GetBudgetStatus(ctx, testUserID, 0, 0)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 2025, report.Year)
+		assert.Equal(t, 9, report.Month)
+	})
+}
+
+func TestBudgetService_GetBudgetStatus_Rollover(t *testing.T) {
+	service, mockBudgetRepo, _, mockTrxRepo, mockPrefsRepo := setupBudgetService(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+	servisID := int64(5)
+
+	// Tanpa preferensi tersimpan: bulan kalender Asia/Jakarta
+	prefs := models.DefaultUserPreferences(testUserID)
+
+	spentIn := func(year, month int, amount int64) {
+		startTime, endTime := prefs.MonthRange(year, month)
+		mockTrxRepo.EXPECT().
+			GetTotalsByCategory(ctx, testUserID, startTime, endTime).
+			Return([]models.CategorySummary{{CategoryID: servisID, Name: "Servis Motor", TotalExpense: amount}}, nil).
+			Once()
+	}
+
+	t.Run("Success - Carries Unspent And Overspent Amounts", func(t *testing.T) {
+		// 1. Setup: anggaran 300.000/bulan sejak November 2025, rantai melewati pergantian tahun
+		mockPrefsRepo.EXPECT().GetByUserID(ctx, testUserID).Return(nil, pgx.ErrNoRows).Once()
+		mockBudgetRepo.EXPECT().
+			GetAllByUserIDAndPeriod(ctx, testUserID, 2026, 1).
+			Return([]models.Budget{
+				{ID: 4, CategoryID: servisID, CategoryName: "Servis Motor", Year: 2026, Month: 1, Amount: 300000, Rollover: true},
+			}, nil).
+			Once()
+
+		// September 2025 tidak ber-rollover/terputus, jadi rantai dimulai November
+		mockBudgetRepo.EXPECT().
+			GetRolloverHistory(ctx, testUserID, 2026, 1, models.MaxRolloverMonths).
+			Return([]models.Budget{
+				{ID: 1, CategoryID: servisID, Year: 2025, Month: 9, Amount: 300000, Rollover: true},
+				{ID: 2, CategoryID: servisID, Year: 2025, Month: 11, Amount: 300000, Rollover: true},
+				{ID: 3, CategoryID: servisID, Year: 2025, Month: 12, Amount: 300000, Rollover: true},
+			}, nil).
+			Once()
+
+		spentIn(2026, 1, 100000)
+		spentIn(2025, 11, 0)      // sisa 300.000
+		spentIn(2025, 12, 700000) // 300.000 + 300.000 - 700.000 = -100.000
+
+		// 2. Act
+		report, err := service.GetBudgetStatus(ctx, testUserID, 2026, 1)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Len(t, report.Budgets, 1)
+		status := report.Budgets[0]
+		assert.Equal(t, int64(-100000), status.CarryOver)
+		assert.Equal(t, int64(200000), status.Available)
+		assert.Equal(t, int64(100000), status.Remaining)
+		assert.Equal(t, int64(-100000), report.TotalCarryOver)
+	})
+}
@@ -3,33 +3,94 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/Udean777/uang-bijak-go/internal/models"
 	"github.com/Udean777/uang-bijak-go/internal/repository"
 	"github.com/google/uuid"
 )
 
-var ErrForbidden = errors.New("forbidden access")
+var (
+	ErrForbidden       = errors.New("forbidden access")
+	ErrSameCategory    = errors.New("target category must be different from the source category")
+	ErrCategoryCycle   = errors.New("category cannot be its own ancestor")
+	ErrCategoryTooDeep = fmt.Errorf("category hierarchy cannot be deeper than %d levels", models.MaxCategoryDepth)
+	// ErrInvalidParentCategory membungkus ErrForbidden agar handler bisa membedakannya dari
+	// kategori yang diubah bukan milik user
+	ErrInvalidParentCategory = fmt.Errorf("invalid parent category: %w", ErrForbidden)
+
+	// ErrCategoryKindMismatch: tipe transaksi/kategori tidak sesuai dengan kind kategori
+	ErrCategoryKindMismatch = errors.New("category kind mismatch")
+)
+
+// CategoryInUseError dikembalikan saat kategori yang akan dihapus masih dipakai transaksi,
+// transaksi berulang, tagihan, anggaran, atau masih punya saldo amplop.
+type CategoryInUseError struct {
+	TransactionCount int64
+	RecurringCount   int64
+	BillCount        int64
+	BudgetCount      int64
+	EnvelopeBalance  int64
+}
+
+func (e *CategoryInUseError) Error() string {
+	return fmt.Sprintf("category still has %d transactions, %d recurring transactions, %d bills, %d budgets and an envelope balance of %d",
+		e.TransactionCount, e.RecurringCount, e.BillCount, e.BudgetCount, e.EnvelopeBalance)
+}
+
+func (e *CategoryInUseError) Is(target error) bool {
+	return target == ErrConflict
+}
 
 type CategoryService interface {
 	CreateCategory(ctx context.Context, req models.UpsertCategoryRequest, userID uuid.UUID) (*models.Category, error)
-	GetUserCategories(ctx context.Context, userID uuid.UUID) ([]models.Category, error)
+	GetUserCategories(ctx context.Context, userID uuid.UUID, query models.CategoryQuery) ([]models.Category, error)
 	UpdateCategory(ctx context.Context, categoryID int64, req models.UpsertCategoryRequest, userID uuid.UUID) error
 	DeleteCategory(ctx context.Context, categoryID int64, userID uuid.UUID) error
+	MergeCategory(ctx context.Context, categoryID int64, targetCategoryID int64, userID uuid.UUID) (movedTransactions int64, err error)
+	ApplyDefaultCategories(ctx context.Context, userID uuid.UUID, locale string) ([]models.Category, error)
 }
 
 type categoryService struct {
-	categoryRepo repository.CategoryRepository
+	db               txBeginner
+	categoryRepo     repository.CategoryRepository
+	trxRepo          repository.TransactionRepository
+	recurringRepo    repository.RecurringRepository
+	billRepo         repository.BillRepository
+	envelopeRepo     repository.EnvelopeRepository
+	budgetRepo       repository.BudgetRepository
+	categoryTemplate models.CategoryTemplate
 }
 
-func NewCategoryService(repo repository.CategoryRepository) CategoryService {
-	return &categoryService{categoryRepo: repo}
+func NewCategoryService(db txBeginner, repo repository.CategoryRepository, trxRepo repository.TransactionRepository, recurringRepo repository.RecurringRepository, billRepo repository.BillRepository, envelopeRepo repository.EnvelopeRepository, budgetRepo repository.BudgetRepository, categoryTemplate models.CategoryTemplate) CategoryService {
+	return &categoryService{
+		db:               db,
+		categoryRepo:     repo,
+		trxRepo:          trxRepo,
+		recurringRepo:    recurringRepo,
+		billRepo:         billRepo,
+		envelopeRepo:     envelopeRepo,
+		budgetRepo:       budgetRepo,
+		categoryTemplate: categoryTemplate,
+	}
 }
 
 func (s *categoryService) CreateCategory(ctx context.Context, req models.UpsertCategoryRequest, userID uuid.UUID) (*models.Category, error) {
+	kind := models.TransactionExpense
+	if req.Kind != "" {
+		kind = models.TransactionType(req.Kind)
+	}
+
+	if err := s.validateParent(ctx, userID, 0, req.ParentID, kind, false); err != nil {
+		return nil, err
+	}
+
 	cat := &models.Category{
-		UserID: userID,
-		Name:   req.Name,
+		UserID:   userID,
+		ParentID: req.ParentID,
+		Name:     req.Name,
+		Kind:     kind,
 	}
 
 	_, err := s.categoryRepo.Create(ctx, cat)
@@ -39,30 +100,363 @@ func (s *categoryService) CreateCategory(ctx context.Context, req models.UpsertC
 	return cat, nil
 }
 
-func (s *categoryService) GetUserCategories(ctx context.Context, userID uuid.UUID) ([]models.Category, error) {
-	return s.categoryRepo.GetAllByUserID(ctx, userID)
+// GetUserCategories mengembalikan kategori user dalam bentuk pohon (root beserta children).
+// Jika query.Kind diisi, hanya kategori dengan kind tersebut yang disertakan.
+func (s *categoryService) GetUserCategories(ctx context.Context, userID uuid.UUID, query models.CategoryQuery) ([]models.Category, error) {
+	categories, err := s.categoryRepo.GetAllByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.Kind != "" {
+		filtered := make([]models.Category, 0, len(categories))
+		for _, c := range categories {
+			if c.Kind == models.TransactionType(query.Kind) {
+				filtered = append(filtered, c)
+			}
+		}
+		categories = filtered
+	}
+
+	return models.BuildCategoryTree(categories), nil
 }
 
-func (s *categoryService) checkOwnership(ctx context.Context, categoryID int64, userID uuid.UUID) error {
-	_, err := s.categoryRepo.CheckOwnership(ctx, categoryID, userID)
+func (s *categoryService) UpdateCategory(ctx context.Context, categoryID int64, req models.UpsertCategoryRequest, userID uuid.UUID) error {
+	current, err := s.categoryRepo.CheckOwnership(ctx, categoryID, userID)
 	if err != nil {
 		return ErrForbidden
 	}
+
+	kind := current.Kind
+	if req.Kind != "" && models.TransactionType(req.Kind) != current.Kind {
+		// Transaksi lama, kemunculan transaksi berulang, dan pembayaran tagihan berikutnya akan
+		// bertentangan dengan kind baru
+		trxCount, err := s.trxRepo.CountByCategoryID(ctx, categoryID)
+		if err != nil {
+			return err
+		}
+		recurringCount, err := s.recurringRepo.CountByCategoryID(ctx, categoryID)
+		if err != nil {
+			return err
+		}
+		billCount, err := s.billRepo.CountByCategoryID(ctx, categoryID)
+		if err != nil {
+			return err
+		}
+		if trxCount > 0 || recurringCount > 0 || billCount > 0 {
+			return fmt.Errorf("category kind cannot change while it has %d transactions, %d recurring transactions and %d bills: %w",
+				trxCount, recurringCount, billCount, ErrConflict)
+		}
+		kind = models.TransactionType(req.Kind)
+	}
+
+	if err := s.validateParent(ctx, userID, categoryID, req.ParentID, kind, kind != current.Kind); err != nil {
+		return err
+	}
+
+	return s.categoryRepo.Update(ctx, categoryID, req.Name, req.ParentID, kind)
+}
+
+// validateParent memastikan parentID milik user, tidak menimbulkan siklus, kedalaman pohon
+// setelah perubahan tidak melebihi models.MaxCategoryDepth, dan kind kategori sama dengan
+// parent (serta sub-kategorinya jika kind berubah) agar rollup tidak mencampur pemasukan dan
+// pengeluaran. categoryID = 0 untuk kategori baru.
+func (s *categoryService) validateParent(ctx context.Context, userID uuid.UUID, categoryID int64, parentID *int64, kind models.TransactionType, kindChanged bool) error {
+	if parentID == nil && !kindChanged {
+		return nil
+	}
+	if parentID != nil && *parentID == categoryID {
+		return ErrCategoryCycle
+	}
+
+	categories, err := s.categoryRepo.GetAllByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	byID := make(map[int64]models.Category, len(categories))
+	childrenOf := make(map[int64][]int64)
+	for _, c := range categories {
+		byID[c.ID] = c
+		if c.ParentID != nil {
+			childrenOf[*c.ParentID] = append(childrenOf[*c.ParentID], c.ID)
+		}
+	}
+
+	if kindChanged {
+		for _, childID := range childrenOf[categoryID] {
+			if byID[childID].Kind != kind {
+				return fmt.Errorf("sub-category %q is %s: %w", byID[childID].Name, byID[childID].Kind, ErrCategoryKindMismatch)
+			}
+		}
+	}
+	if parentID == nil {
+		return nil
+	}
+
+	newParent, ok := byID[*parentID]
+	if !ok {
+		return ErrInvalidParentCategory
+	}
+	if newParent.Kind != kind {
+		return fmt.Errorf("%s category cannot be under %s category: %w", kind, newParent.Kind, ErrCategoryKindMismatch)
+	}
+
+	// Telusuri leluhur parent baru; jika bertemu kategori ini berarti terjadi siklus
+	depth := 0
+	visited := make(map[int64]bool)
+	for id := parentID; id != nil; {
+		if *id == categoryID || visited[*id] {
+			return ErrCategoryCycle
+		}
+		visited[*id] = true
+		depth++
+
+		parent, ok := byID[*id]
+		if !ok {
+			break
+		}
+		id = parent.ParentID
+	}
+
+	if depth+subtreeHeight(childrenOf, categoryID, map[int64]bool{}) > models.MaxCategoryDepth {
+		return ErrCategoryTooDeep
+	}
 	return nil
 }
 
-func (s *categoryService) UpdateCategory(ctx context.Context, categoryID int64, req models.UpsertCategoryRequest, userID uuid.UUID) error {
-	if err := s.checkOwnership(ctx, categoryID, userID); err != nil {
+// subtreeHeight menghitung tinggi subtree (daun = 1).
+func subtreeHeight(childrenOf map[int64][]int64, id int64, visited map[int64]bool) int {
+	if visited[id] {
+		return 0
+	}
+	visited[id] = true
+
+	height := 0
+	for _, child := range childrenOf[id] {
+		if h := subtreeHeight(childrenOf, child, visited); h > height {
+			height = h
+		}
+	}
+	return height + 1
+}
+
+// validateChildrenMove memastikan sub-kategori source bisa dipindahkan ke bawah target:
+// target bukan keturunan source, kind sub-kategori sama dengan target, dan kedalaman pohon
+// setelah dipindah tidak melebihi models.MaxCategoryDepth.
+func (s *categoryService) validateChildrenMove(ctx context.Context, userID uuid.UUID, sourceID int64, target *models.Category) error {
+	categories, err := s.categoryRepo.GetAllByUserID(ctx, userID)
+	if err != nil {
 		return err
 	}
 
-	return s.categoryRepo.Update(ctx, categoryID, req.Name)
+	byID := make(map[int64]models.Category, len(categories))
+	childrenOf := make(map[int64][]int64)
+	for _, c := range categories {
+		byID[c.ID] = c
+		if c.ParentID != nil {
+			childrenOf[*c.ParentID] = append(childrenOf[*c.ParentID], c.ID)
+		}
+	}
+	if len(childrenOf[sourceID]) == 0 {
+		return nil
+	}
+
+	for _, childID := range childrenOf[sourceID] {
+		if byID[childID].Kind != target.Kind {
+			return fmt.Errorf("sub-category %q is %s: %w", byID[childID].Name, byID[childID].Kind, ErrCategoryKindMismatch)
+		}
+	}
+
+	// Telusuri leluhur target; jika bertemu source berarti sub-kategori akan menjadi leluhurnya sendiri
+	depth := 0
+	visited := make(map[int64]bool)
+	for id := &target.ID; id != nil; {
+		if *id == sourceID || visited[*id] {
+			return ErrCategoryCycle
+		}
+		visited[*id] = true
+		depth++
+
+		c, ok := byID[*id]
+		if !ok {
+			break
+		}
+		id = c.ParentID
+	}
+
+	// Tinggi subtree source dikurangi source itu sendiri = tinggi sub-kategori yang dipindah
+	if depth+subtreeHeight(childrenOf, sourceID, map[int64]bool{})-1 > models.MaxCategoryDepth {
+		return ErrCategoryTooDeep
+	}
+	return nil
 }
 
+// DeleteCategory menghapus kategori yang tidak lagi dipakai. Anggaran dan amplop bersaldo ikut
+// dihitung sebagai pemakaian agar tidak hilang lewat ON DELETE CASCADE; gunakan MergeCategory untuk
+// memindahkannya. Sub-kategorinya naik satu level ke induk kategori yang dihapus (lihat
+// CategoryRepository.DeleteTx).
 func (s *categoryService) DeleteCategory(ctx context.Context, categoryID int64, userID uuid.UUID) error {
-	if err := s.checkOwnership(ctx, categoryID, userID); err != nil {
+	if _, err := s.categoryRepo.CheckOwnership(ctx, categoryID, userID); err != nil {
+		return ErrForbidden
+	}
+
+	trxCount, err := s.trxRepo.CountByCategoryID(ctx, categoryID)
+	if err != nil {
+		return err
+	}
+	recurringCount, err := s.recurringRepo.CountByCategoryID(ctx, categoryID)
+	if err != nil {
+		return err
+	}
+	billCount, err := s.billRepo.CountByCategoryID(ctx, categoryID)
+	if err != nil {
 		return err
 	}
+	budgetCount, err := s.budgetRepo.CountByCategoryID(ctx, categoryID)
+	if err != nil {
+		return err
+	}
+	envelopeBalance, err := s.envelopeRepo.GetBalanceByCategoryID(ctx, categoryID)
+	if err != nil {
+		return err
+	}
+	if trxCount > 0 || recurringCount > 0 || billCount > 0 || budgetCount > 0 || envelopeBalance != 0 {
+		return &CategoryInUseError{
+			TransactionCount: trxCount,
+			RecurringCount:   recurringCount,
+			BillCount:        billCount,
+			BudgetCount:      budgetCount,
+			EnvelopeBalance:  envelopeBalance,
+		}
+	}
 
 	return s.categoryRepo.Delete(ctx, categoryID)
 }
+
+// MergeCategory memindahkan semua transaksi, transaksi berulang, tagihan, anggaran, amplop & sub-kategori ke
+// kategori target lalu menghapus kategori asal dalam satu pgx.Tx. Anggaran di periode yang sama dan
+// saldo amplop digabung ke milik target. Dipakai juga oleh DELETE dengan reassign_to.
+func (s *categoryService) MergeCategory(ctx context.Context, categoryID int64, targetCategoryID int64, userID uuid.UUID) (int64, error) {
+	if categoryID == targetCategoryID {
+		return 0, ErrSameCategory
+	}
+
+	source, err := s.categoryRepo.CheckOwnership(ctx, categoryID, userID)
+	if err != nil {
+		return 0, ErrForbidden
+	}
+	target, err := s.categoryRepo.CheckOwnership(ctx, targetCategoryID, userID)
+	if err != nil {
+		return 0, fmt.Errorf("target category ownership validation failed: %w", ErrForbidden)
+	}
+	if source.Kind != target.Kind {
+		return 0, fmt.Errorf("cannot merge %s category into %s category: %w", source.Kind, target.Kind, ErrCategoryKindMismatch)
+	}
+	if err := s.validateChildrenMove(ctx, userID, categoryID, target); err != nil {
+		return 0, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	defer tx.Rollback(ctx)
+
+	moved, err := s.trxRepo.ReassignCategoryTx(ctx, tx, categoryID, targetCategoryID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := s.recurringRepo.ReassignCategoryTx(ctx, tx, categoryID, targetCategoryID); err != nil {
+		return 0, err
+	}
+
+	if err := s.billRepo.ReassignCategoryTx(ctx, tx, categoryID, targetCategoryID); err != nil {
+		return 0, err
+	}
+
+	if err := s.budgetRepo.ReassignCategoryTx(ctx, tx, categoryID, targetCategoryID); err != nil {
+		return 0, err
+	}
+
+	if err := s.envelopeRepo.ReassignCategoryTx(ctx, tx, categoryID, targetCategoryID); err != nil {
+		return 0, err
+	}
+
+	// Sub-kategori pindah ke bawah target, bukan naik ke induk kategori asal saat DeleteTx
+	if err := s.categoryRepo.ReparentChildrenTx(ctx, tx, categoryID, targetCategoryID); err != nil {
+		return 0, err
+	}
+
+	if err := s.categoryRepo.DeleteTx(ctx, tx, categoryID); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return moved, nil
+}
+
+// ApplyDefaultCategories menambahkan kategori dari template yang belum dimiliki user (berdasarkan
+// nama tanpa membedakan huruf besar/kecil, dan kind). Sub-kategori template yang induknya sudah ada
+// diletakkan di bawah kategori milik user tersebut. Jika user sudah punya kategori bernama sama
+// dengan kind berbeda, node template beserta sub-kategorinya dilewati agar pohon tidak
+// mencampur pemasukan dan pengeluaran. Mengembalikan kategori yang baru dibuat.
+func (s *categoryService) ApplyDefaultCategories(ctx context.Context, userID uuid.UUID, locale string) ([]models.Category, error) {
+	categories, err := s.categoryRepo.GetAllByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]models.Category, len(categories))
+	parentOf := make(map[int64]*int64, len(categories))
+	for _, c := range categories {
+		existing[strings.ToLower(c.Name)] = c
+		parentOf[c.ID] = c.ParentID
+	}
+
+	depthOf := func(id int64) int {
+		depth := 0
+		for cur := &id; cur != nil && depth <= models.MaxCategoryDepth; cur = parentOf[*cur] {
+			depth++
+		}
+		return depth
+	}
+
+	var missingFrom func(nodes []models.Category, parentID *int64, depth int) []models.Category
+	missingFrom = func(nodes []models.Category, parentID *int64, depth int) []models.Category {
+		if depth > models.MaxCategoryDepth {
+			return nil
+		}
+
+		missing := []models.Category{}
+		for _, node := range nodes {
+			if match, ok := existing[strings.ToLower(node.Name)]; ok {
+				if match.Kind != node.Kind {
+					continue
+				}
+				id := match.ID
+				missing = append(missing, missingFrom(node.Children, &id, depthOf(id)+1)...)
+				continue
+			}
+			node.ParentID = parentID
+			node.Children = missingFrom(node.Children, nil, depth+1)
+			missing = append(missing, node)
+		}
+		return missing
+	}
+
+	missing := missingFrom(s.categoryTemplate.Build(locale), nil, 1)
+	if len(missing) == 0 {
+		return missing, nil
+	}
+
+	if err := s.categoryRepo.CreateTree(ctx, userID, missing); err != nil {
+		return nil, err
+	}
+	return missing, nil
+}
@@ -15,11 +15,39 @@ import (
 
 // Helper setup
 func setupCategoryService(t *testing.T) (CategoryService, *mocks.MockCategoryRepository) {
-	mockRepo := mocks.NewMockCategoryRepository(t)
-	service := NewCategoryService(mockRepo)
+	service, mockRepo, _ := setupCategoryServiceWithTrx(t)
 	return service, mockRepo
 }
 
+func setupCategoryServiceWithTrx(t *testing.T) (CategoryService, *mocks.MockCategoryRepository, *mocks.MockTransactionRepository) {
+	service, m := setupCategoryServiceWithMocks(t)
+	return service, m.categoryRepo, m.trxRepo
+}
+
+type categoryMocks struct {
+	categoryRepo  *mocks.MockCategoryRepository
+	trxRepo       *mocks.MockTransactionRepository
+	recurringRepo *mocks.MockRecurringRepository
+	billRepo      *mocks.MockBillRepository
+	envelopeRepo  *mocks.MockEnvelopeRepository
+	budgetRepo    *mocks.MockBudgetRepository
+	tx            *fakeTx
+}
+
+func setupCategoryServiceWithMocks(t *testing.T) (CategoryService, categoryMocks) {
+	m := categoryMocks{
+		categoryRepo:  mocks.NewMockCategoryRepository(t),
+		trxRepo:       mocks.NewMockTransactionRepository(t),
+		recurringRepo: mocks.NewMockRecurringRepository(t),
+		billRepo:      mocks.NewMockBillRepository(t),
+		envelopeRepo:  mocks.NewMockEnvelopeRepository(t),
+		budgetRepo:    mocks.NewMockBudgetRepository(t),
+		tx:            &fakeTx{},
+	}
+	service := NewCategoryService(&fakeDB{tx: m.tx}, m.categoryRepo, m.trxRepo, m.recurringRepo, m.billRepo, m.envelopeRepo, m.budgetRepo, models.DefaultCategoryTemplate)
+	return service, m
+}
+
 func TestCategoryService_CreateCategory(t *testing.T) {
 	service, mockRepo := setupCategoryService(t)
 	ctx := context.Background()
@@ -82,12 +110,12 @@ func TestCategoryService_UpdateCategory(t *testing.T) {
 		// Harapkan panggilan ke checkOwnership (sukses)
 		mockRepo.EXPECT().
 			CheckOwnership(ctx, categoryID, testUserID).
-			Return(&models.Category{ID: categoryID, UserID: testUserID}, nil).
+			Return(&models.Category{ID: categoryID, UserID: testUserID, Kind: models.TransactionExpense}, nil).
 			Once()
 
 		// Harapkan panggilan ke Update (sukses)
 		mockRepo.EXPECT().
-			Update(ctx, categoryID, "Updated Makanan").
+			Update(ctx, categoryID, "Updated Makanan", (*int64)(nil), models.TransactionExpense).
 			Return(nil).
 			Once()
 
@@ -118,8 +146,127 @@ func TestCategoryService_UpdateCategory(t *testing.T) {
 	})
 }
 
+func TestCategoryService_ParentValidation(t *testing.T) {
+	service, m := setupCategoryServiceWithMocks(t)
+	mockRepo, mockTrxRepo := m.categoryRepo, m.trxRepo
+	ctx := context.Background()
+	testUserID := uuid.New()
+
+	ptr := func(id int64) *int64 { return &id }
+
+	// Transportasi(1) > Bensin(2) > Pertamax(3); Makanan(4) berdiri sendiri
+	existing := []models.Category{
+		{ID: 1, Name: "Transportasi", Kind: models.TransactionExpense},
+		{ID: 2, ParentID: ptr(1), Name: "Bensin", Kind: models.TransactionExpense},
+		{ID: 3, ParentID: ptr(2), Name: "Pertamax", Kind: models.TransactionExpense},
+		{ID: 4, Name: "Makanan", Kind: models.TransactionExpense},
+		{ID: 5, Name: "Gaji", Kind: models.TransactionIncome},
+	}
+
+	t.Run("Success - Create Child", func(t *testing.T) {
+		// 1. Setup
+		mockRepo.EXPECT().GetAllByUserID(ctx, testUserID).Return(existing, nil).Once()
+		mockRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*models.Category")).
+			Run(func(ctx context.Context, cat *models.Category) {
+				assert.Equal(t, int64(1), *cat.ParentID)
+			}).
+			Return(int64(5), nil).
+			Once()
+
+		// 2. Act
+		_, err := service.CreateCategory(ctx, models.UpsertCategoryRequest{Name: "Parkir", ParentID: ptr(1)}, testUserID)
+
+		// 3. Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fail - Create Too Deep", func(t *testing.T) {
+		// 1. Setup
+		mockRepo.EXPECT().GetAllByUserID(ctx, testUserID).Return(existing, nil).Once()
+
+		// 2. Act
+		_, err := service.CreateCategory(ctx, models.UpsertCategoryRequest{Name: "Shell", ParentID: ptr(3)}, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrCategoryTooDeep)
+	})
+
+	t.Run("Fail - Parent Not Owned", func(t *testing.T) {
+		// 1. Setup
+		mockRepo.EXPECT().GetAllByUserID(ctx, testUserID).Return(existing, nil).Once()
+
+		// 2. Act
+		_, err := service.CreateCategory(ctx, models.UpsertCategoryRequest{Name: "Parkir", ParentID: ptr(99)}, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("Fail - Create Kind Differs From Parent", func(t *testing.T) {
+		// 1. Setup
+		mockRepo.EXPECT().GetAllByUserID(ctx, testUserID).Return(existing, nil).Once()
+
+		// 2. Act: kategori pemasukan di bawah kategori pengeluaran
+		_, err := service.CreateCategory(ctx, models.UpsertCategoryRequest{Name: "Cashback", ParentID: ptr(1), Kind: "income"}, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrCategoryKindMismatch)
+	})
+
+	t.Run("Fail - Update Kind Differs From Sub-Categories", func(t *testing.T) {
+		// 1. Setup
+		mockRepo.EXPECT().CheckOwnership(ctx, int64(1), testUserID).Return(&existing[0], nil).Once()
+		mockTrxRepo.EXPECT().CountByCategoryID(ctx, int64(1)).Return(int64(0), nil).Once()
+		m.recurringRepo.EXPECT().CountByCategoryID(ctx, int64(1)).Return(int64(0), nil).Once()
+		m.billRepo.EXPECT().CountByCategoryID(ctx, int64(1)).Return(int64(0), nil).Once()
+		mockRepo.EXPECT().GetAllByUserID(ctx, testUserID).Return(existing, nil).Once()
+
+		// 2. Act: Transportasi menjadi pemasukan sementara Bensin tetap pengeluaran
+		err := service.UpdateCategory(ctx, 1, models.UpsertCategoryRequest{Name: "Transportasi", Kind: "income"}, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrCategoryKindMismatch)
+	})
+
+	t.Run("Fail - Update Creates Cycle", func(t *testing.T) {
+		// 1. Setup
+		mockRepo.EXPECT().CheckOwnership(ctx, int64(1), testUserID).Return(&existing[0], nil).Once()
+		mockRepo.EXPECT().GetAllByUserID(ctx, testUserID).Return(existing, nil).Once()
+
+		// 2. Act: Transportasi dipindah ke bawah cucunya sendiri
+		err := service.UpdateCategory(ctx, 1, models.UpsertCategoryRequest{Name: "Transportasi", ParentID: ptr(3)}, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrCategoryCycle)
+	})
+
+	t.Run("Fail - Update Self Parent", func(t *testing.T) {
+		// 1. Setup
+		mockRepo.EXPECT().CheckOwnership(ctx, int64(4), testUserID).Return(&existing[3], nil).Once()
+
+		// 2. Act
+		err := service.UpdateCategory(ctx, 4, models.UpsertCategoryRequest{Name: "Makanan", ParentID: ptr(4)}, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrCategoryCycle)
+	})
+
+	t.Run("Fail - Update Subtree Too Deep", func(t *testing.T) {
+		// 1. Setup
+		mockRepo.EXPECT().CheckOwnership(ctx, int64(1), testUserID).Return(&existing[0], nil).Once()
+		mockRepo.EXPECT().GetAllByUserID(ctx, testUserID).Return(existing, nil).Once()
+
+		// 2. Act: subtree Transportasi (3 level) di bawah Makanan menjadi 4 level
+		err := service.UpdateCategory(ctx, 1, models.UpsertCategoryRequest{Name: "Transportasi", ParentID: ptr(4)}, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrCategoryTooDeep)
+	})
+}
+
 func TestCategoryService_DeleteCategory(t *testing.T) {
-	service, mockRepo := setupCategoryService(t)
+	service, m := setupCategoryServiceWithMocks(t)
 	ctx := context.Background()
 
 	testUserID := uuid.New()
@@ -129,13 +276,39 @@ func TestCategoryService_DeleteCategory(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		// 1. Setup
 		// Harapkan panggilan ke checkOwnership (sukses)
-		mockRepo.EXPECT().
+		m.categoryRepo.EXPECT().
 			CheckOwnership(ctx, categoryID, testUserID).
 			Return(&models.Category{ID: categoryID, UserID: testUserID}, nil).
 			Once()
 
+		// Kategori belum dipakai transaksi, transaksi berulang, tagihan, anggaran, maupun amplop
+		m.trxRepo.EXPECT().
+			CountByCategoryID(ctx, categoryID).
+			Return(int64(0), nil).
+			Once()
+
+		m.recurringRepo.EXPECT().
+			CountByCategoryID(ctx, categoryID).
+			Return(int64(0), nil).
+			Once()
+
+		m.billRepo.EXPECT().
+			CountByCategoryID(ctx, categoryID).
+			Return(int64(0), nil).
+			Once()
+
+		m.budgetRepo.EXPECT().
+			CountByCategoryID(ctx, categoryID).
+			Return(int64(0), nil).
+			Once()
+
+		m.envelopeRepo.EXPECT().
+			GetBalanceByCategoryID(ctx, categoryID).
+			Return(int64(0), nil).
+			Once()
+
 		// Harapkan panggilan ke Delete (sukses)
-		mockRepo.EXPECT().
+		m.categoryRepo.EXPECT().
 			Delete(ctx, categoryID).
 			Return(nil).
 			Once()
@@ -150,7 +323,7 @@ func TestCategoryService_DeleteCategory(t *testing.T) {
 	t.Run("Fail - Forbidden (Not Owner)", func(t *testing.T) {
 		// 1. Setup
 		// Harapkan panggilan ke checkOwnership (gagal)
-		mockRepo.EXPECT().
+		m.categoryRepo.EXPECT().
 			CheckOwnership(ctx, categoryID, otherUserID).
 			Return(nil, errors.New("not found")).
 			Once()
@@ -163,6 +336,463 @@ func TestCategoryService_DeleteCategory(t *testing.T) {
 		assert.Equal(t, ErrForbidden, err)
 
 		// Pastikan Delete TIDAK pernah dipanggil
-		mockRepo.AssertNotCalled(t, "Delete")
+		m.categoryRepo.AssertNotCalled(t, "Delete")
+	})
+	t.Run("Fail - Conflict (Has Transactions)", func(t *testing.T) {
+		// 1. Setup
+		m.categoryRepo.EXPECT().
+			CheckOwnership(ctx, int64(2), testUserID).
+			Return(&models.Category{ID: 2, UserID: testUserID}, nil).
+			Once()
+
+		m.trxRepo.EXPECT().
+			CountByCategoryID(ctx, int64(2)).
+			Return(int64(7), nil).
+			Once()
+
+		m.recurringRepo.EXPECT().
+			CountByCategoryID(ctx, int64(2)).
+			Return(int64(0), nil).
+			Once()
+
+		m.billRepo.EXPECT().
+			CountByCategoryID(ctx, int64(2)).
+			Return(int64(0), nil).
+			Once()
+
+		m.budgetRepo.EXPECT().
+			CountByCategoryID(ctx, int64(2)).
+			Return(int64(0), nil).
+			Once()
+
+		m.envelopeRepo.EXPECT().
+			GetBalanceByCategoryID(ctx, int64(2)).
+			Return(int64(0), nil).
+			Once()
+
+		// 2. Act
+		err := service.DeleteCategory(ctx, 2, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrConflict)
+		var inUse *CategoryInUseError
+		assert.ErrorAs(t, err, &inUse)
+		assert.Equal(t, int64(7), inUse.TransactionCount)
+	})
+
+	t.Run("Fail - Conflict (Has Recurring Transactions And Bills)", func(t *testing.T) {
+		// 1. Setup
+		m.categoryRepo.EXPECT().
+			CheckOwnership(ctx, int64(3), testUserID).
+			Return(&models.Category{ID: 3, UserID: testUserID}, nil).
+			Once()
+
+		m.trxRepo.EXPECT().CountByCategoryID(ctx, int64(3)).Return(int64(0), nil).Once()
+
+		// Template berulang & tagihan akan ikut terhapus (ON DELETE CASCADE) bila kategori dihapus
+		m.recurringRepo.EXPECT().CountByCategoryID(ctx, int64(3)).Return(int64(1), nil).Once()
+		m.billRepo.EXPECT().CountByCategoryID(ctx, int64(3)).Return(int64(2), nil).Once()
+		m.budgetRepo.EXPECT().CountByCategoryID(ctx, int64(3)).Return(int64(0), nil).Once()
+		m.envelopeRepo.EXPECT().GetBalanceByCategoryID(ctx, int64(3)).Return(int64(0), nil).Once()
+
+		// 2. Act
+		err := service.DeleteCategory(ctx, 3, testUserID)
+
+		// 3. Assert
+		var inUse *CategoryInUseError
+		assert.ErrorAs(t, err, &inUse)
+		assert.Equal(t, int64(1), inUse.RecurringCount)
+		assert.Equal(t, int64(2), inUse.BillCount)
+		m.categoryRepo.AssertNotCalled(t, "Delete", ctx, int64(3))
+	})
+
+	t.Run("Fail - Conflict (Has Budgets And Envelope Balance)", func(t *testing.T) {
+		// 1. Setup
+		m.categoryRepo.EXPECT().
+			CheckOwnership(ctx, int64(4), testUserID).
+			Return(&models.Category{ID: 4, UserID: testUserID}, nil).
+			Once()
+
+		m.trxRepo.EXPECT().CountByCategoryID(ctx, int64(4)).Return(int64(0), nil).Once()
+		m.recurringRepo.EXPECT().CountByCategoryID(ctx, int64(4)).Return(int64(0), nil).Once()
+		m.billRepo.EXPECT().CountByCategoryID(ctx, int64(4)).Return(int64(0), nil).Once()
+
+		// Anggaran & amplop juga ON DELETE CASCADE; saldo amplop akan hilang bila kategori dihapus
+		m.budgetRepo.EXPECT().CountByCategoryID(ctx, int64(4)).Return(int64(3), nil).Once()
+		m.envelopeRepo.EXPECT().GetBalanceByCategoryID(ctx, int64(4)).Return(int64(150000), nil).Once()
+
+		// 2. Act
+		err := service.DeleteCategory(ctx, 4, testUserID)
+
+		// 3. Assert
+		var inUse *CategoryInUseError
+		assert.ErrorAs(t, err, &inUse)
+		assert.Equal(t, int64(3), inUse.BudgetCount)
+		assert.Equal(t, int64(150000), inUse.EnvelopeBalance)
+		m.categoryRepo.AssertNotCalled(t, "Delete", ctx, int64(4))
+	})
+}
+
+func TestCategoryService_MergeCategory(t *testing.T) {
+	service, m := setupCategoryServiceWithMocks(t)
+	tx := m.tx
+	ctx := context.Background()
+	testUserID := uuid.New()
+
+	ptr := func(id int64) *int64 { return &id }
+
+	t.Run("Success - Moves Transactions, Recurring Transactions, Bills, Budgets, Envelopes And Sub-Categories", func(t *testing.T) {
+		// 1. Setup
+		m.categoryRepo.EXPECT().
+			CheckOwnership(ctx, int64(1), testUserID).
+			Return(&models.Category{ID: 1, Kind: models.TransactionExpense}, nil).
+			Once()
+
+		m.categoryRepo.EXPECT().
+			CheckOwnership(ctx, int64(2), testUserID).
+			Return(&models.Category{ID: 2, Kind: models.TransactionExpense}, nil).
+			Once()
+
+		// Kategori 1 punya sub-kategori 3 yang akan pindah ke bawah kategori 2
+		m.categoryRepo.EXPECT().
+			GetAllByUserID(ctx, testUserID).
+			Return([]models.Category{
+				{ID: 1, Kind: models.TransactionExpense},
+				{ID: 2, Kind: models.TransactionExpense},
+				{ID: 3, ParentID: ptr(1), Kind: models.TransactionExpense},
+			}, nil).
+			Once()
+
+		m.trxRepo.EXPECT().ReassignCategoryTx(ctx, tx, int64(1), int64(2)).Return(int64(4), nil).Once()
+		m.recurringRepo.EXPECT().ReassignCategoryTx(ctx, tx, int64(1), int64(2)).Return(nil).Once()
+		m.billRepo.EXPECT().ReassignCategoryTx(ctx, tx, int64(1), int64(2)).Return(nil).Once()
+		m.budgetRepo.EXPECT().ReassignCategoryTx(ctx, tx, int64(1), int64(2)).Return(nil).Once()
+		m.envelopeRepo.EXPECT().ReassignCategoryTx(ctx, tx, int64(1), int64(2)).Return(nil).Once()
+		m.categoryRepo.EXPECT().ReparentChildrenTx(ctx, tx, int64(1), int64(2)).Return(nil).Once()
+		m.categoryRepo.EXPECT().DeleteTx(ctx, tx, int64(1)).Return(nil).Once()
+
+		// 2. Act
+		moved, err := service.MergeCategory(ctx, 1, 2, testUserID)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), moved)
+		assert.True(t, tx.committed)
+	})
+
+	t.Run("Fail - Same Category", func(t *testing.T) {
+		// 2. Act
+		_, err := service.MergeCategory(ctx, 1, 1, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrSameCategory)
+	})
+
+	t.Run("Fail - Target Not Owned", func(t *testing.T) {
+		// 1. Setup
+		m.categoryRepo.EXPECT().
+			CheckOwnership(ctx, int64(1), testUserID).
+			Return(&models.Category{ID: 1}, nil).
+			Once()
+
+		m.categoryRepo.EXPECT().
+			CheckOwnership(ctx, int64(99), testUserID).
+			Return(nil, errors.New("not found")).
+			Once()
+
+		// 2. Act
+		_, err := service.MergeCategory(ctx, 1, 99, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("Fail - Different Kind", func(t *testing.T) {
+		// 1. Setup
+		m.categoryRepo.EXPECT().
+			CheckOwnership(ctx, int64(1), testUserID).
+			Return(&models.Category{ID: 1, Kind: models.TransactionIncome}, nil).
+			Once()
+
+		m.categoryRepo.EXPECT().
+			CheckOwnership(ctx, int64(2), testUserID).
+			Return(&models.Category{ID: 2, Kind: models.TransactionExpense}, nil).
+			Once()
+
+		// 2. Act
+		_, err := service.MergeCategory(ctx, 1, 2, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrCategoryKindMismatch)
+	})
+
+	t.Run("Fail - Target Is Descendant", func(t *testing.T) {
+		// 1. Setup: menggabungkan "Transportasi" ke sub-kategorinya sendiri "Bensin"
+		m.categoryRepo.EXPECT().
+			CheckOwnership(ctx, int64(1), testUserID).
+			Return(&models.Category{ID: 1, Kind: models.TransactionExpense}, nil).
+			Once()
+
+		m.categoryRepo.EXPECT().
+			CheckOwnership(ctx, int64(3), testUserID).
+			Return(&models.Category{ID: 3, ParentID: ptr(1), Kind: models.TransactionExpense}, nil).
+			Once()
+
+		m.categoryRepo.EXPECT().
+			GetAllByUserID(ctx, testUserID).
+			Return([]models.Category{
+				{ID: 1, Kind: models.TransactionExpense},
+				{ID: 3, ParentID: ptr(1), Kind: models.TransactionExpense},
+				{ID: 4, ParentID: ptr(1), Kind: models.TransactionExpense},
+			}, nil).
+			Once()
+
+		// 2. Act
+		_, err := service.MergeCategory(ctx, 1, 3, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrCategoryCycle)
+	})
+
+	t.Run("Fail - Sub-Categories Too Deep Under Target", func(t *testing.T) {
+		// 1. Setup: target sudah di level 3, sub-kategori source tidak muat di bawahnya
+		m.categoryRepo.EXPECT().
+			CheckOwnership(ctx, int64(1), testUserID).
+			Return(&models.Category{ID: 1, Kind: models.TransactionExpense}, nil).
+			Once()
+
+		m.categoryRepo.EXPECT().
+			CheckOwnership(ctx, int64(12), testUserID).
+			Return(&models.Category{ID: 12, ParentID: ptr(11), Kind: models.TransactionExpense}, nil).
+			Once()
+
+		m.categoryRepo.EXPECT().
+			GetAllByUserID(ctx, testUserID).
+			Return([]models.Category{
+				{ID: 1, Kind: models.TransactionExpense},
+				{ID: 3, ParentID: ptr(1), Kind: models.TransactionExpense},
+				{ID: 10, Kind: models.TransactionExpense},
+				{ID: 11, ParentID: ptr(10), Kind: models.TransactionExpense},
+				{ID: 12, ParentID: ptr(11), Kind: models.TransactionExpense},
+			}, nil).
+			Once()
+
+		// 2. Act
+		_, err := service.MergeCategory(ctx, 1, 12, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrCategoryTooDeep)
+	})
+}
+
+func TestCategoryService_Kind(t *testing.T) {
+	service, m := setupCategoryServiceWithMocks(t)
+	mockRepo, mockTrxRepo := m.categoryRepo, m.trxRepo
+	ctx := context.Background()
+	testUserID := uuid.New()
+
+	t.Run("Success - Create Defaults To Expense", func(t *testing.T) {
+		// 1. Setup
+		mockRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*models.Category")).
+			Return(int64(1), nil).
+			Once()
+
+		// 2. Act
+		cat, err := service.CreateCategory(ctx, models.UpsertCategoryRequest{Name: "Makanan"}, testUserID)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Equal(t, models.TransactionExpense, cat.Kind)
+	})
+
+	t.Run("Success - List Filtered By Kind", func(t *testing.T) {
+		// 1. Setup
+		mockRepo.EXPECT().
+			GetAllByUserID(ctx, testUserID).
+			Return([]models.Category{
+				{ID: 1, Name: "Gaji", Kind: models.TransactionIncome},
+				{ID: 2, Name: "Makanan", Kind: models.TransactionExpense},
+			}, nil).
+			Once()
+
+		// 2. Act
+		categories, err := service.GetUserCategories(ctx, testUserID, models.CategoryQuery{Kind: "income"})
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Len(t, categories, 1)
+		assert.Equal(t, "Gaji", categories[0].Name)
+	})
+
+	t.Run("Fail - Change Kind With Transactions", func(t *testing.T) {
+		// 1. Setup
+		mockRepo.EXPECT().
+			CheckOwnership(ctx, int64(1), testUserID).
+			Return(&models.Category{ID: 1, Kind: models.TransactionExpense}, nil).
+			Once()
+
+		mockTrxRepo.EXPECT().
+			CountByCategoryID(ctx, int64(1)).
+			Return(int64(3), nil).
+			Once()
+
+		m.recurringRepo.EXPECT().
+			CountByCategoryID(ctx, int64(1)).
+			Return(int64(0), nil).
+			Once()
+
+		m.billRepo.EXPECT().
+			CountByCategoryID(ctx, int64(1)).
+			Return(int64(0), nil).
+			Once()
+
+		// 2. Act
+		err := service.UpdateCategory(ctx, 1, models.UpsertCategoryRequest{Name: "Gaji", Kind: "income"}, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrConflict)
+	})
+
+	t.Run("Fail - Change Kind With Recurring Transactions", func(t *testing.T) {
+		// 1. Setup: belum ada transaksi, tetapi kemunculan berikutnya akan bertentangan dengan kind baru
+		mockRepo.EXPECT().
+			CheckOwnership(ctx, int64(2), testUserID).
+			Return(&models.Category{ID: 2, Kind: models.TransactionExpense}, nil).
+			Once()
+
+		mockTrxRepo.EXPECT().
+			CountByCategoryID(ctx, int64(2)).
+			Return(int64(0), nil).
+			Once()
+
+		m.recurringRepo.EXPECT().
+			CountByCategoryID(ctx, int64(2)).
+			Return(int64(1), nil).
+			Once()
+
+		m.billRepo.EXPECT().
+			CountByCategoryID(ctx, int64(2)).
+			Return(int64(0), nil).
+			Once()
+
+		// 2. Act
+		err := service.UpdateCategory(ctx, 2, models.UpsertCategoryRequest{Name: "Langganan", Kind: "income"}, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrConflict)
+		mockRepo.AssertNotCalled(t, "Update", ctx, int64(2), mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Fail - Change Kind With Bills", func(t *testing.T) {
+		// 1. Setup: pembayaran tagihan selalu pengeluaran
+		mockRepo.EXPECT().
+			CheckOwnership(ctx, int64(3), testUserID).
+			Return(&models.Category{ID: 3, Kind: models.TransactionExpense}, nil).
+			Once()
+
+		mockTrxRepo.EXPECT().CountByCategoryID(ctx, int64(3)).Return(int64(0), nil).Once()
+		m.recurringRepo.EXPECT().CountByCategoryID(ctx, int64(3)).Return(int64(0), nil).Once()
+		m.billRepo.EXPECT().CountByCategoryID(ctx, int64(3)).Return(int64(2), nil).Once()
+
+		// 2. Act
+		err := service.UpdateCategory(ctx, 3, models.UpsertCategoryRequest{Name: "Listrik", Kind: "income"}, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrConflict)
+		mockRepo.AssertNotCalled(t, "Update", ctx, int64(3), mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestCategoryService_ApplyDefaultCategories(t *testing.T) {
+	mockRepo := mocks.NewMockCategoryRepository(t)
+	template := models.CategoryTemplate{
+		DefaultLocale: "id",
+		Categories: []models.CategoryTemplateItem{
+			{Names: map[string]string{"id": "Gaji"}, Kind: models.TransactionIncome},
+			{
+				Names: map[string]string{"id": "Transportasi"},
+				Kind:  models.TransactionExpense,
+				Children: []models.CategoryTemplateItem{
+					{Names: map[string]string{"id": "Bensin"}, Kind: models.TransactionExpense},
+					{Names: map[string]string{"id": "Parkir"}, Kind: models.TransactionExpense},
+				},
+			},
+		},
+	}
+	service := NewCategoryService(nil, mockRepo, mocks.NewMockTransactionRepository(t), mocks.NewMockRecurringRepository(t), mocks.NewMockBillRepository(t), mocks.NewMockEnvelopeRepository(t), mocks.NewMockBudgetRepository(t), template)
+	ctx := context.Background()
+	testUserID := uuid.New()
+
+	t.Run("Success - Only Missing Categories", func(t *testing.T) {
+		// 1. Setup: user sudah punya "transportasi" dan "Bensin"
+		transportID := int64(10)
+		mockRepo.EXPECT().
+			GetAllByUserID(ctx, testUserID).
+			Return([]models.Category{
+				{ID: transportID, Name: "transportasi", Kind: models.TransactionExpense},
+				{ID: 11, ParentID: &transportID, Name: "Bensin", Kind: models.TransactionExpense},
+			}, nil).
+			Once()
+
+		mockRepo.EXPECT().
+			CreateTree(ctx, testUserID, mock.AnythingOfType("[]models.Category")).
+			Run(func(ctx context.Context, userID uuid.UUID, categories []models.Category) {
+				assert.Len(t, categories, 2)
+				assert.Equal(t, "Gaji", categories[0].Name)
+				assert.Nil(t, categories[0].ParentID)
+				// "Parkir" diletakkan di bawah kategori Transportasi milik user
+				assert.Equal(t, "Parkir", categories[1].Name)
+				assert.Equal(t, transportID, *categories[1].ParentID)
+			}).
+			Return(nil).
+			Once()
+
+		// 2. Act
+		created, err := service.ApplyDefaultCategories(ctx, testUserID, "id")
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Len(t, created, 2)
+	})
+
+	t.Run("Success - Nothing Missing", func(t *testing.T) {
+		// 1. Setup
+		mockRepo.EXPECT().
+			GetAllByUserID(ctx, testUserID).
+			Return([]models.Category{
+				{ID: 1, Name: "Gaji", Kind: models.TransactionIncome},
+				{ID: 2, Name: "Transportasi", Kind: models.TransactionExpense},
+				{ID: 3, Name: "Bensin", Kind: models.TransactionExpense},
+				{ID: 4, Name: "Parkir", Kind: models.TransactionExpense},
+			}, nil).
+			Once()
+
+		// 2. Act
+		created, err := service.ApplyDefaultCategories(ctx, testUserID, "id")
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Empty(t, created)
+		mockRepo.AssertNotCalled(t, "CreateTree")
+	})
+
+	t.Run("Success - Skips Same Name With Different Kind", func(t *testing.T) {
+		// 1. Setup: "Transportasi" milik user adalah kategori pemasukan (mis. uang transport kantor)
+		mockRepo.EXPECT().
+			GetAllByUserID(ctx, testUserID).
+			Return([]models.Category{
+				{ID: 1, Name: "Gaji", Kind: models.TransactionIncome},
+				{ID: 20, Name: "Transportasi", Kind: models.TransactionIncome},
+			}, nil).
+			Once()
+
+		// 2. Act
+		created, err := service.ApplyDefaultCategories(ctx, testUserID, "id")
+
+		// 3. Assert: Bensin & Parkir tidak ditempel di bawah kategori pemasukan
+		assert.NoError(t, err)
+		assert.Empty(t, created)
+		mockRepo.AssertNotCalled(t, "CreateTree")
 	})
 }
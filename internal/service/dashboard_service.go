@@ -11,25 +11,36 @@ import (
 
 // DashboardService interface
 type DashboardService interface {
-	GetDashboardSummary(ctx context.Context, userID uuid.UUID, startTime time.Time, endTime time.Time) (*models.DashboardSummary, error)
+	GetDashboardSummary(ctx context.Context, userID uuid.UUID, query models.DashboardQuery) (*models.DashboardSummary, error)
+	GetCategoryBreakdown(ctx context.Context, userID uuid.UUID, query models.CategoryBreakdownQuery) ([]models.CategorySummary, error)
 }
 
 // dashboardService struct
 type dashboardService struct {
 	walletRepo repository.WalletRepository
 	trxRepo    repository.TransactionRepository
+	prefsRepo  repository.PreferencesRepository
+	now        func() time.Time
 }
 
 // NewDashboardService constructor
-func NewDashboardService(walletRepo repository.WalletRepository, trxRepo repository.TransactionRepository) DashboardService {
+func NewDashboardService(walletRepo repository.WalletRepository, trxRepo repository.TransactionRepository, prefsRepo repository.PreferencesRepository) DashboardService {
 	return &dashboardService{
 		walletRepo: walletRepo,
 		trxRepo:    trxRepo,
+		prefsRepo:  prefsRepo,
+		now:        time.Now,
 	}
 }
 
-// GetDashboardSummary implementation
-func (s *dashboardService) GetDashboardSummary(ctx context.Context, userID uuid.UUID, startTime time.Time, endTime time.Time) (*models.DashboardSummary, error) {
+// GetDashboardSummary menghitung ringkasan untuk periode query. Batas periode mengikuti
+// preferensi user (zona waktu, awal bulan keuangan, hari pertama minggu).
+func (s *dashboardService) GetDashboardSummary(ctx context.Context, userID uuid.UUID, query models.DashboardQuery) (*models.DashboardSummary, error) {
+	prefs, err := loadPreferences(ctx, s.prefsRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+	startTime, endTime := query.RangeFor(prefs, s.now())
 
 	// 1. Ambil Total Saldo
 	totalBalance, err := s.walletRepo.GetTotalBalanceByUserID(ctx, userID)
@@ -48,7 +59,30 @@ func (s *dashboardService) GetDashboardSummary(ctx context.Context, userID uuid.
 		TotalBalance: totalBalance,
 		TotalIncome:  totalIncome,
 		TotalExpense: totalExpense,
+		Currency:     prefs.Currency,
+		PeriodStart:  startTime,
+		PeriodEnd:    endTime,
 	}
 
 	return summary, nil
 }
+
+// GetCategoryBreakdown mengembalikan total per kategori. Jika rollup, hasilnya berupa pohon
+// di mana total kategori induk sudah mencakup pengeluaran/pemasukan sub-kategorinya.
+func (s *dashboardService) GetCategoryBreakdown(ctx context.Context, userID uuid.UUID, query models.CategoryBreakdownQuery) ([]models.CategorySummary, error) {
+	prefs, err := loadPreferences(ctx, s.prefsRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+	startTime, endTime := query.RangeFor(prefs, s.now())
+
+	summaries, err := s.trxRepo.GetTotalsByCategory(ctx, userID, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	if !query.Rollup {
+		return summaries, nil
+	}
+	return models.RollupCategorySummaries(summaries), nil
+}
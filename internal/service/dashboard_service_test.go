@@ -7,28 +7,40 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/Udean777/uang-bijak-go/internal/models"
 	repoMocks "github.com/Udean777/uang-bijak-go/internal/repository/mocks"
 )
 
+// dashboardTestNow adalah "sekarang" untuk tes dashboard: 10 Oktober 2025, 12:00 WIB
+var dashboardTestNow = time.Date(2025, time.October, 10, 5, 0, 0, 0, time.UTC)
+
 // Helper setup
-func setupDashboardService(t *testing.T) (DashboardService, *repoMocks.MockWalletRepository, *repoMocks.MockTransactionRepository) {
+func setupDashboardService(t *testing.T) (DashboardService, *repoMocks.MockWalletRepository, *repoMocks.MockTransactionRepository, *repoMocks.MockPreferencesRepository) {
 	mockWalletRepo := repoMocks.NewMockWalletRepository(t)
 	mockTrxRepo := repoMocks.NewMockTransactionRepository(t)
-	service := NewDashboardService(mockWalletRepo, mockTrxRepo)
-	return service, mockWalletRepo, mockTrxRepo
+	mockPrefsRepo := repoMocks.NewMockPreferencesRepository(t)
+	service := NewDashboardService(mockWalletRepo, mockTrxRepo, mockPrefsRepo).(*dashboardService)
+	service.now = func() time.Time { return dashboardTestNow }
+	return service, mockWalletRepo, mockTrxRepo, mockPrefsRepo
 }
 
 func TestDashboardService_GetDashboardSummary(t *testing.T) {
-	service, mockWalletRepo, mockTrxRepo := setupDashboardService(t)
+	service, mockWalletRepo, mockTrxRepo, mockPrefsRepo := setupDashboardService(t)
 	ctx := context.Background()
 	testUserID := uuid.New()
-	startTime := time.Now()
-	endTime := time.Now()
+
+	// Tanpa preferensi tersimpan: bulan kalender Asia/Jakarta
+	loc, _ := time.LoadLocation("Asia/Jakarta")
+	startTime := time.Date(2025, time.October, 1, 0, 0, 0, 0, loc)
+	endTime := time.Date(2025, time.October, 31, 23, 59, 59, 999999999, loc)
 
 	t.Run("Success", func(t *testing.T) {
 		// 1. Setup Mock
+		mockPrefsRepo.EXPECT().GetByUserID(ctx, testUserID).Return(nil, pgx.ErrNoRows).Once()
+
 		// Harapkan panggilan ke WalletRepo, kembalikan total saldo 1.000.000
 		mockWalletRepo.EXPECT().
 			GetTotalBalanceByUserID(ctx, testUserID).
@@ -42,7 +54,7 @@ func TestDashboardService_GetDashboardSummary(t *testing.T) {
 			Once()
 
 		// 2. Act
-		summary, err := service.GetDashboardSummary(ctx, testUserID, startTime, endTime)
+		summary, err := service.GetDashboardSummary(ctx, testUserID, models.DashboardQuery{})
 
 		// 3. Assert
 		assert.NoError(t, err)
@@ -50,17 +62,61 @@ func TestDashboardService_GetDashboardSummary(t *testing.T) {
 		assert.Equal(t, int64(1000000), summary.TotalBalance)
 		assert.Equal(t, int64(500000), summary.TotalIncome)
 		assert.Equal(t, int64(150000), summary.TotalExpense)
+		assert.Equal(t, "IDR", summary.Currency)
+		assert.Equal(t, startTime, summary.PeriodStart)
+		assert.Equal(t, endTime, summary.PeriodEnd)
+	})
+
+	t.Run("Success - Financial Month From Payday", func(t *testing.T) {
+		// 1. Setup: bulan keuangan mulai tanggal 25, zona WITA
+		prefs := models.DefaultUserPreferences(testUserID)
+		prefs.Currency = "USD"
+		prefs.Timezone = "Asia/Makassar"
+		prefs.MonthStartDay = 25
+		mockPrefsRepo.EXPECT().GetByUserID(ctx, testUserID).Return(prefs, nil).Once()
+
+		// 10 Oktober masih termasuk periode yang dimulai 25 September
+		wita, _ := time.LoadLocation("Asia/Makassar")
+		expectedStart := time.Date(2025, time.September, 25, 0, 0, 0, 0, wita)
+		expectedEnd := time.Date(2025, time.October, 24, 23, 59, 59, 999999999, wita)
+
+		mockWalletRepo.EXPECT().GetTotalBalanceByUserID(ctx, testUserID).Return(int64(1000000), nil).Once()
+		mockTrxRepo.EXPECT().
+			GetTotalIncomeAndExpense(ctx, testUserID, expectedStart, expectedEnd).
+			Return(int64(500000), int64(150000), nil).
+			Once()
+
+		// 2. Act
+		summary, err := service.GetDashboardSummary(ctx, testUserID, models.DashboardQuery{})
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "USD", summary.Currency)
+		assert.Equal(t, expectedStart, summary.PeriodStart)
+	})
+
+	t.Run("Fail - Preferences Repo Fails", func(t *testing.T) {
+		// 1. Setup Mock
+		mockPrefsRepo.EXPECT().GetByUserID(ctx, testUserID).Return(nil, errors.New("db error")).Once()
+
+		// 2. Act
+		summary, err := service.GetDashboardSummary(ctx, testUserID, models.DashboardQuery{})
+
+		// 3. Assert
+		assert.Error(t, err)
+		assert.Nil(t, summary)
 	})
 
 	t.Run("Fail - WalletRepo Fails", func(t *testing.T) {
 		// 1. Setup Mock
+		mockPrefsRepo.EXPECT().GetByUserID(ctx, testUserID).Return(nil, pgx.ErrNoRows).Once()
 		mockWalletRepo.EXPECT().
 			GetTotalBalanceByUserID(ctx, testUserID).
 			Return(int64(0), errors.New("db error")).
 			Once()
 
 		// 2. Act
-		summary, err := service.GetDashboardSummary(ctx, testUserID, startTime, endTime)
+		summary, err := service.GetDashboardSummary(ctx, testUserID, models.DashboardQuery{})
 
 		// 3. Assert
 		assert.Error(t, err)
@@ -71,6 +127,7 @@ func TestDashboardService_GetDashboardSummary(t *testing.T) {
 
 	t.Run("Fail - TrxRepo Fails", func(t *testing.T) {
 		// 1. Setup Mock
+		mockPrefsRepo.EXPECT().GetByUserID(ctx, testUserID).Return(nil, pgx.ErrNoRows).Once()
 		mockWalletRepo.EXPECT().
 			GetTotalBalanceByUserID(ctx, testUserID).
 			Return(int64(1000000), nil). // Ini sukses
@@ -82,10 +139,81 @@ func TestDashboardService_GetDashboardSummary(t *testing.T) {
 			Once()
 
 		// 2. Act
-		summary, err := service.GetDashboardSummary(ctx, testUserID, startTime, endTime)
+		summary, err := service.GetDashboardSummary(ctx, testUserID, models.DashboardQuery{})
 
 		// 3. Assert
 		assert.Error(t, err)
 		assert.Nil(t, summary)
 	})
 }
+
+func TestDashboardService_GetCategoryBreakdown(t *testing.T) {
+	service, _, mockTrxRepo, mockPrefsRepo := setupDashboardService(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+	parentID := int64(1)
+
+	// Minggu mulai Minggu (0): minggu yang memuat Jumat 10 Oktober 2025 adalah 5-11 Oktober
+	prefs := models.DefaultUserPreferences(testUserID)
+	prefs.FirstDayOfWeek = 0
+	loc, _ := time.LoadLocation("Asia/Jakarta")
+	startTime := time.Date(2025, time.October, 5, 0, 0, 0, 0, loc)
+	endTime := time.Date(2025, time.October, 11, 23, 59, 59, 999999999, loc)
+	weekly := models.DashboardQuery{Period: "week"}
+
+	summaries := []models.CategorySummary{
+		{CategoryID: 1, Name: "Transportasi", TotalExpense: 10000},
+		{CategoryID: 2, ParentID: &parentID, Name: "Bensin", TotalExpense: 50000},
+	}
+
+	t.Run("Success - Flat", func(t *testing.T) {
+		// 1. Setup
+		mockPrefsRepo.EXPECT().GetByUserID(ctx, testUserID).Return(prefs, nil).Once()
+		mockTrxRepo.EXPECT().
+			GetTotalsByCategory(ctx, testUserID, startTime, endTime).
+			Return(summaries, nil).
+			Once()
+
+		// 2. Act
+		result, err := service.GetCategoryBreakdown(ctx, testUserID, models.CategoryBreakdownQuery{DashboardQuery: weekly})
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, int64(10000), result[0].TotalExpense)
+	})
+
+	t.Run("Success - Rollup", func(t *testing.T) {
+		// 1. Setup
+		mockPrefsRepo.EXPECT().GetByUserID(ctx, testUserID).Return(prefs, nil).Once()
+		mockTrxRepo.EXPECT().
+			GetTotalsByCategory(ctx, testUserID, startTime, endTime).
+			Return(summaries, nil).
+			Once()
+
+		// 2. Act
+		result, err := service.GetCategoryBreakdown(ctx, testUserID, models.CategoryBreakdownQuery{DashboardQuery: weekly, Rollup: true})
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, int64(60000), result[0].TotalExpense)
+		assert.Len(t, result[0].Children, 1)
+	})
+
+	t.Run("Fail - Repo Error", func(t *testing.T) {
+		// 1. Setup
+		mockPrefsRepo.EXPECT().GetByUserID(ctx, testUserID).Return(prefs, nil).Once()
+		mockTrxRepo.EXPECT().
+			GetTotalsByCategory(ctx, testUserID, startTime, endTime).
+			Return(nil, errors.New("db error")).
+			Once()
+
+		// 2. Act
+		result, err := service.GetCategoryBreakdown(ctx, testUserID, models.CategoryBreakdownQuery{DashboardQuery: weekly, Rollup: true})
+
+		// 3. Assert
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
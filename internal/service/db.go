@@ -0,0 +1,13 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// txBeginner adalah bagian dari *pgxpool.Pool yang dipakai service untuk membuka pgx.Tx,
+// sehingga alur transaksi bisa diuji tanpa database.
+type txBeginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
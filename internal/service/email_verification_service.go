@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Udean777/uang-bijak-go/internal/mailer"
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/repository"
+)
+
+// verificationResendCooldown adalah jeda minimum antar pengiriman email verifikasi per user
+const verificationResendCooldown = time.Minute
+
+var (
+	ErrInvalidVerificationToken = errors.New("invalid or expired verification token")
+	ErrEmailAlreadyVerified     = errors.New("email is already verified")
+	ErrVerificationCooldown     = errors.New("verification email was sent recently, please wait before retrying")
+)
+
+type EmailVerificationService interface {
+	SendVerification(ctx context.Context, user *models.User) error
+	ResendVerification(ctx context.Context, userID uuid.UUID) error
+	VerifyEmail(ctx context.Context, token string) error
+}
+
+type emailVerificationService struct {
+	userRepo         repository.UserRepository
+	verificationRepo repository.EmailVerificationRepository
+	mailer           mailer.Mailer
+	appBaseURL       string
+	tokenTTL         time.Duration
+}
+
+func NewEmailVerificationService(userRepo repository.UserRepository, verificationRepo repository.EmailVerificationRepository, m mailer.Mailer, appBaseURL string, tokenTTL time.Duration) EmailVerificationService {
+	return &emailVerificationService{
+		userRepo:         userRepo,
+		verificationRepo: verificationRepo,
+		mailer:           m,
+		appBaseURL:       appBaseURL,
+		tokenTTL:         tokenTTL,
+	}
+}
+
+// SendVerification membuat token verifikasi untuk email user saat ini dan mengirim link-nya.
+func (s *emailVerificationService) SendVerification(ctx context.Context, user *models.User) error {
+	token, err := generateOpaqueToken()
+	if err != nil {
+		return err
+	}
+
+	err = s.verificationRepo.Create(ctx, &models.EmailVerificationToken{
+		UserID:    user.ID,
+		Email:     user.Email,
+		TokenHash: hashToken(token),
+		ExpiresAt: time.Now().Add(s.tokenTTL),
+	})
+	if err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf("%s/verify-email?token=%s", s.appBaseURL, url.QueryEscape(token))
+	return s.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf("Hi %s,\n\nPlease confirm that %s is your email address by opening the link below. The link expires in %d hours.\n\n%s\n\nIf you did not create an account, you can ignore this email.\n",
+			user.Name, user.Email, int(s.tokenTTL.Hours()), link),
+	})
+}
+
+// ResendVerification mengirim ulang email verifikasi, dibatasi satu kali per verificationResendCooldown.
+func (s *emailVerificationService) ResendVerification(ctx context.Context, userID uuid.UUID) error {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.EmailVerified() {
+		return ErrEmailAlreadyVerified
+	}
+
+	lastSentAt, err := s.verificationRepo.GetLastSentAt(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if lastSentAt != nil && time.Since(*lastSentAt) < verificationResendCooldown {
+		return ErrVerificationCooldown
+	}
+
+	return s.SendVerification(ctx, user)
+}
+
+// VerifyEmail memakai token verifikasi (sekali pakai). Token hanya berlaku untuk email yang
+// tercatat saat token dibuat; jika user sudah mengganti email, token ditolak.
+func (s *emailVerificationService) VerifyEmail(ctx context.Context, token string) error {
+	stored, err := s.verificationRepo.Consume(ctx, hashToken(token))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrInvalidVerificationToken
+	}
+	if err != nil {
+		return err
+	}
+
+	verified, err := s.userRepo.MarkEmailVerified(ctx, stored.UserID, stored.Email)
+	if err != nil {
+		return err
+	}
+	if !verified {
+		return ErrInvalidVerificationToken
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Udean777/uang-bijak-go/internal/mailer"
+	mailerMocks "github.com/Udean777/uang-bijak-go/internal/mailer/mocks"
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	repoMocks "github.com/Udean777/uang-bijak-go/internal/repository/mocks"
+)
+
+type emailVerificationMocks struct {
+	userRepo         *repoMocks.MockUserRepository
+	verificationRepo *repoMocks.MockEmailVerificationRepository
+	mailer           *mailerMocks.MockMailer
+}
+
+func setupEmailVerificationService(t *testing.T) (EmailVerificationService, emailVerificationMocks) {
+	m := emailVerificationMocks{
+		userRepo:         repoMocks.NewMockUserRepository(t),
+		verificationRepo: repoMocks.NewMockEmailVerificationRepository(t),
+		mailer:           mailerMocks.NewMockMailer(t),
+	}
+	service := NewEmailVerificationService(m.userRepo, m.verificationRepo, m.mailer, "https://app.uangbijak.test", 24*time.Hour)
+	return service, m
+}
+
+func TestEmailVerificationService_SendVerification(t *testing.T) {
+	service, m := setupEmailVerificationService(t)
+	ctx := context.Background()
+	testUser := &models.User{ID: uuid.New(), Name: "Budi", Email: "budi@example.com"}
+
+	t.Run("Success - Token Bound To Current Email", func(t *testing.T) {
+		// 1. Setup
+		var stored *models.EmailVerificationToken
+		m.verificationRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*models.EmailVerificationToken")).
+			Run(func(ctx context.Context, token *models.EmailVerificationToken) { stored = token }).
+			Return(nil).
+			Once()
+
+		var sent mailer.Message
+		m.mailer.EXPECT().
+			Send(ctx, mock.AnythingOfType("mailer.Message")).
+			Run(func(ctx context.Context, msg mailer.Message) { sent = msg }).
+			Return(nil).
+			Once()
+
+		// 2. Act
+		err := service.SendVerification(ctx, testUser)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Equal(t, testUser.ID, stored.UserID)
+		assert.Equal(t, "budi@example.com", stored.Email)
+		assert.Equal(t, "budi@example.com", sent.To)
+		assert.Contains(t, sent.Body, "https://app.uangbijak.test/verify-email?token=")
+	})
+}
+
+func TestEmailVerificationService_ResendVerification(t *testing.T) {
+	service, m := setupEmailVerificationService(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+
+	t.Run("Success - After Cooldown", func(t *testing.T) {
+		// 1. Setup
+		lastSentAt := time.Now().Add(-5 * time.Minute)
+		m.userRepo.EXPECT().GetUserByID(ctx, testUserID).Return(&models.User{ID: testUserID, Email: "budi@example.com"}, nil).Once()
+		m.verificationRepo.EXPECT().GetLastSentAt(ctx, testUserID).Return(&lastSentAt, nil).Once()
+		m.verificationRepo.EXPECT().Create(ctx, mock.AnythingOfType("*models.EmailVerificationToken")).Return(nil).Once()
+		m.mailer.EXPECT().Send(ctx, mock.AnythingOfType("mailer.Message")).Return(nil).Once()
+
+		// 2. Act
+		err := service.ResendVerification(ctx, testUserID)
+
+		// 3. Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fail - Within Cooldown", func(t *testing.T) {
+		// 1. Setup
+		lastSentAt := time.Now().Add(-10 * time.Second)
+		m.userRepo.EXPECT().GetUserByID(ctx, testUserID).Return(&models.User{ID: testUserID}, nil).Once()
+		m.verificationRepo.EXPECT().GetLastSentAt(ctx, testUserID).Return(&lastSentAt, nil).Once()
+
+		// 2. Act
+		err := service.ResendVerification(ctx, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrVerificationCooldown)
+	})
+
+	t.Run("Fail - Already Verified", func(t *testing.T) {
+		// 1. Setup
+		verifiedAt := time.Now()
+		m.userRepo.EXPECT().GetUserByID(ctx, testUserID).Return(&models.User{ID: testUserID, EmailVerifiedAt: &verifiedAt}, nil).Once()
+
+		// 2. Act
+		err := service.ResendVerification(ctx, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrEmailAlreadyVerified)
+	})
+}
+
+func TestEmailVerificationService_VerifyEmail(t *testing.T) {
+	service, m := setupEmailVerificationService(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// 1. Setup
+		m.verificationRepo.EXPECT().
+			Consume(ctx, hashToken("verify-token")).
+			Return(&models.EmailVerificationToken{UserID: testUserID, Email: "budi@example.com"}, nil).
+			Once()
+		m.userRepo.EXPECT().MarkEmailVerified(ctx, testUserID, "budi@example.com").Return(true, nil).Once()
+
+		// 2. Act
+		err := service.VerifyEmail(ctx, "verify-token")
+
+		// 3. Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fail - Email Changed Since Token Was Sent", func(t *testing.T) {
+		// 1. Setup
+		m.verificationRepo.EXPECT().
+			Consume(ctx, hashToken("stale-token")).
+			Return(&models.EmailVerificationToken{UserID: testUserID, Email: "old@example.com"}, nil).
+			Once()
+		m.userRepo.EXPECT().MarkEmailVerified(ctx, testUserID, "old@example.com").Return(false, nil).Once()
+
+		// 2. Act
+		err := service.VerifyEmail(ctx, "stale-token")
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrInvalidVerificationToken)
+	})
+
+	t.Run("Fail - Used Or Expired Token", func(t *testing.T) {
+		// 1. Setup
+		m.verificationRepo.EXPECT().Consume(ctx, hashToken("used-token")).Return(nil, pgx.ErrNoRows).Once()
+
+		// 2. Act
+		err := service.VerifyEmail(ctx, "used-token")
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrInvalidVerificationToken)
+	})
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/repository"
+	"github.com/google/uuid"
+)
+
+var ErrInsufficientFunds = errors.New("insufficient funds")
+
+type EnvelopeService interface {
+	GetEnvelopes(ctx context.Context, userID uuid.UUID) (*models.EnvelopeSummary, error)
+	AssignEnvelope(ctx context.Context, req models.AssignEnvelopeRequest, userID uuid.UUID) (*models.Envelope, error)
+	DeleteEnvelope(ctx context.Context, envelopeID int64, userID uuid.UUID) error
+}
+
+type envelopeService struct {
+	db           txBeginner
+	envelopeRepo repository.EnvelopeRepository
+	walletRepo   repository.WalletRepository
+	categoryRepo repository.CategoryRepository
+}
+
+func NewEnvelopeService(db txBeginner, envelopeRepo repository.EnvelopeRepository, walletRepo repository.WalletRepository, categoryRepo repository.CategoryRepository) EnvelopeService {
+	return &envelopeService{
+		db:           db,
+		envelopeRepo: envelopeRepo,
+		walletRepo:   walletRepo,
+		categoryRepo: categoryRepo,
+	}
+}
+
+func (s *envelopeService) GetEnvelopes(ctx context.Context, userID uuid.UUID) (*models.EnvelopeSummary, error) {
+	envelopes, err := s.envelopeRepo.GetAllByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	readyToAssign, err := s.readyToAssign(ctx, userID, envelopes)
+	if err != nil {
+		return nil, err
+	}
+
+	if envelopes == nil {
+		envelopes = []models.Envelope{}
+	}
+	return &models.EnvelopeSummary{ReadyToAssign: readyToAssign, Envelopes: envelopes}, nil
+}
+
+// AssignEnvelope memindahkan dana dari saldo dompet yang belum dialokasikan ke amplop kategori
+// (atau sebaliknya jika Amount negatif). Pengecekan dana dan penulisan saldo amplop dilakukan
+// dalam satu pgx.Tx yang mengunci amplop user, agar alokasi bersamaan tidak melebihi dana.
+func (s *envelopeService) AssignEnvelope(ctx context.Context, req models.AssignEnvelopeRequest, userID uuid.UUID) (*models.Envelope, error) {
+	category, err := s.categoryRepo.CheckOwnership(ctx, req.CategoryID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("category ownership validation failed: %w", ErrForbidden)
+	}
+	if category.Kind != models.TransactionExpense {
+		return nil, fmt.Errorf("envelope requires an expense category: %w", ErrCategoryKindMismatch)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback(ctx)
+
+	envelopes, err := s.envelopeRepo.GetAllByUserIDForUpdateTx(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Amount > 0 {
+		readyToAssign, err := s.readyToAssign(ctx, userID, envelopes)
+		if err != nil {
+			return nil, err
+		}
+		if req.Amount > readyToAssign {
+			return nil, fmt.Errorf("only %d is ready to assign: %w", readyToAssign, ErrInsufficientFunds)
+		}
+	} else {
+		// Dana yang dikembalikan tidak boleh melebihi saldo amplop
+		var balance int64
+		for _, e := range envelopes {
+			if e.CategoryID == req.CategoryID {
+				balance = e.Balance
+			}
+		}
+		if -req.Amount > balance {
+			return nil, fmt.Errorf("envelope only holds %d: %w", balance, ErrInsufficientFunds)
+		}
+	}
+
+	envelope, err := s.envelopeRepo.AssignTx(ctx, tx, userID, req.CategoryID, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	envelope.CategoryName = category.Name
+	return envelope, nil
+}
+
+// DeleteEnvelope menghapus amplop; saldo positifnya otomatis kembali menjadi dana bebas.
+func (s *envelopeService) DeleteEnvelope(ctx context.Context, envelopeID int64, userID uuid.UUID) error {
+	if _, err := s.envelopeRepo.CheckOwnership(ctx, envelopeID, userID); err != nil {
+		return ErrForbidden
+	}
+
+	return s.envelopeRepo.Delete(ctx, envelopeID)
+}
+
+// readyToAssign adalah total saldo dompet dikurangi dana yang masih tersimpan di amplop.
+// Amplop yang terlampaui (saldo negatif) tidak menambah dana bebas.
+func (s *envelopeService) readyToAssign(ctx context.Context, userID uuid.UUID, envelopes []models.Envelope) (int64, error) {
+	totalBalance, err := s.walletRepo.GetTotalBalanceByUserID(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, e := range envelopes {
+		if e.Balance > 0 {
+			totalBalance -= e.Balance
+		}
+	}
+	return totalBalance, nil
+}
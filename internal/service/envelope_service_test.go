@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	repoMocks "github.com/Udean777/uang-bijak-go/internal/repository/mocks"
+)
+
+// Helper setup
+func setupEnvelopeService(t *testing.T) (EnvelopeService, *repoMocks.MockEnvelopeRepository, *repoMocks.MockWalletRepository, *repoMocks.MockCategoryRepository) {
+	service, mockEnvelopeRepo, mockWalletRepo, mockCategoryRepo, _ := setupEnvelopeServiceWithTx(t)
+	return service, mockEnvelopeRepo, mockWalletRepo, mockCategoryRepo
+}
+
+func setupEnvelopeServiceWithTx(t *testing.T) (EnvelopeService, *repoMocks.MockEnvelopeRepository, *repoMocks.MockWalletRepository, *repoMocks.MockCategoryRepository, *fakeTx) {
+	mockEnvelopeRepo := repoMocks.NewMockEnvelopeRepository(t)
+	mockWalletRepo := repoMocks.NewMockWalletRepository(t)
+	mockCategoryRepo := repoMocks.NewMockCategoryRepository(t)
+	tx := &fakeTx{}
+	service := NewEnvelopeService(&fakeDB{tx: tx}, mockEnvelopeRepo, mockWalletRepo, mockCategoryRepo)
+	return service, mockEnvelopeRepo, mockWalletRepo, mockCategoryRepo, tx
+}
+
+func TestEnvelopeService_GetEnvelopes(t *testing.T) {
+	service, mockEnvelopeRepo, mockWalletRepo, _ := setupEnvelopeService(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+
+	t.Run("Success - Overspent Envelope Does Not Add Funds", func(t *testing.T) {
+		// 1. Setup
+		mockEnvelopeRepo.EXPECT().
+			GetAllByUserID(ctx, testUserID).
+			Return([]models.Envelope{
+				{ID: 1, CategoryID: 1, Balance: 400000},
+				{ID: 2, CategoryID: 2, Balance: -50000},
+			}, nil).
+			Once()
+		mockWalletRepo.EXPECT().GetTotalBalanceByUserID(ctx, testUserID).Return(int64(1000000), nil).Once()
+
+		// 2. Act
+		summary, err := service.GetEnvelopes(ctx, testUserID)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(600000), summary.ReadyToAssign)
+		assert.Len(t, summary.Envelopes, 2)
+	})
+}
+
+func TestEnvelopeService_AssignEnvelope(t *testing.T) {
+	service, mockEnvelopeRepo, mockWalletRepo, mockCategoryRepo, tx := setupEnvelopeServiceWithTx(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+	makanan := &models.Category{ID: 1, Name: "Makanan", Kind: models.TransactionExpense}
+
+	t.Run("Success", func(t *testing.T) {
+		// 1. Setup
+		req := models.AssignEnvelopeRequest{CategoryID: 1, Amount: 500000}
+		mockCategoryRepo.EXPECT().CheckOwnership(ctx, req.CategoryID, testUserID).Return(makanan, nil).Once()
+		mockEnvelopeRepo.EXPECT().GetAllByUserIDForUpdateTx(ctx, tx, testUserID).Return([]models.Envelope{}, nil).Once()
+		mockWalletRepo.EXPECT().GetTotalBalanceByUserID(ctx, testUserID).Return(int64(500000), nil).Once()
+		mockEnvelopeRepo.EXPECT().
+			AssignTx(ctx, tx, testUserID, req.CategoryID, req.Amount).
+			Return(&models.Envelope{ID: 1, CategoryID: 1, Balance: 500000}, nil).
+			Once()
+
+		// 2. Act
+		envelope, err := service.AssignEnvelope(ctx, req, testUserID)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "Makanan", envelope.CategoryName)
+		assert.Equal(t, int64(500000), envelope.Balance)
+		assert.True(t, tx.committed)
+	})
+
+	t.Run("Fail - Not Enough Ready To Assign", func(t *testing.T) {
+		// 1. Setup
+		req := models.AssignEnvelopeRequest{CategoryID: 1, Amount: 500000}
+		mockCategoryRepo.EXPECT().CheckOwnership(ctx, req.CategoryID, testUserID).Return(makanan, nil).Once()
+		mockEnvelopeRepo.EXPECT().
+			GetAllByUserIDForUpdateTx(ctx, tx, testUserID).
+			Return([]models.Envelope{{ID: 2, CategoryID: 2, Balance: 300000}}, nil).
+			Once()
+		mockWalletRepo.EXPECT().GetTotalBalanceByUserID(ctx, testUserID).Return(int64(600000), nil).Once()
+
+		// 2. Act
+		envelope, err := service.AssignEnvelope(ctx, req, testUserID)
+
+		// 3. Assert
+		assert.Nil(t, envelope)
+		assert.True(t, errors.Is(err, ErrInsufficientFunds))
+	})
+
+	t.Run("Fail - Return More Than Envelope Holds", func(t *testing.T) {
+		// 1. Setup
+		req := models.AssignEnvelopeRequest{CategoryID: 1, Amount: -200000}
+		mockCategoryRepo.EXPECT().CheckOwnership(ctx, req.CategoryID, testUserID).Return(makanan, nil).Once()
+		mockEnvelopeRepo.EXPECT().
+			GetAllByUserIDForUpdateTx(ctx, tx, testUserID).
+			Return([]models.Envelope{{ID: 1, CategoryID: 1, Balance: 100000}}, nil).
+			Once()
+
+		// 2. Act
+		envelope, err := service.AssignEnvelope(ctx, req, testUserID)
+
+		// 3. Assert
+		assert.Nil(t, envelope)
+		assert.True(t, errors.Is(err, ErrInsufficientFunds))
+	})
+
+	t.Run("Fail - Income Category", func(t *testing.T) {
+		// 1. Setup
+		req := models.AssignEnvelopeRequest{CategoryID: 3, Amount: 100000}
+		mockCategoryRepo.EXPECT().
+			CheckOwnership(ctx, req.CategoryID, testUserID).
+			Return(&models.Category{ID: 3, Name: "Gaji", Kind: models.TransactionIncome}, nil).
+			Once()
+
+		// 2. Act
+		envelope, err := service.AssignEnvelope(ctx, req, testUserID)
+
+		// 3. Assert
+		assert.Nil(t, envelope)
+		assert.True(t, errors.Is(err, ErrCategoryKindMismatch))
+	})
+
+	t.Run("Fail - Category Not Owned", func(t *testing.T) {
+		// 1. Setup
+		req := models.AssignEnvelopeRequest{CategoryID: 99, Amount: 100000}
+		mockCategoryRepo.EXPECT().CheckOwnership(ctx, req.CategoryID, testUserID).Return(nil, errors.New("not found")).Once()
+
+		// 2. Act
+		_, err := service.AssignEnvelope(ctx, req, testUserID)
+
+		// 3. Assert
+		assert.True(t, errors.Is(err, ErrForbidden))
+	})
+}
+
+func TestEnvelopeService_DeleteEnvelope(t *testing.T) {
+	service, mockEnvelopeRepo, _, _ := setupEnvelopeService(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// 1. Setup
+		mockEnvelopeRepo.EXPECT().CheckOwnership(ctx, int64(1), testUserID).Return(&models.Envelope{ID: 1}, nil).Once()
+		mockEnvelopeRepo.EXPECT().Delete(ctx, int64(1)).Return(nil).Once()
+
+		// 2. Act
+		err := service.DeleteEnvelope(ctx, 1, testUserID)
+
+		// 3. Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fail - Forbidden", func(t *testing.T) {
+		// 1. Setup
+		mockEnvelopeRepo.EXPECT().CheckOwnership(ctx, int64(2), testUserID).Return(nil, errors.New("not found")).Once()
+
+		// 2. Act
+		err := service.DeleteEnvelope(ctx, 2, testUserID)
+
+		// 3. Assert
+		assert.Equal(t, ErrForbidden, err)
+	})
+}
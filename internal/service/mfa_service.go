@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/repository"
+	"github.com/Udean777/uang-bijak-go/internal/totp"
+)
+
+const (
+	recoveryCodeCount = 10
+	// totpSkew: kode dari satu periode sebelum/sesudah masih diterima
+	totpSkew = 1
+)
+
+var (
+	ErrMFAAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+	ErrMFANotEnrolled    = errors.New("two-factor authentication enrolment has not been started")
+	ErrMFANotEnabled     = errors.New("two-factor authentication is not enabled")
+	ErrInvalidMFACode    = errors.New("invalid two-factor authentication code")
+)
+
+type MFAService interface {
+	EnrollTOTP(ctx context.Context, userID uuid.UUID) (*models.TOTPEnrollment, error)
+	ConfirmTOTP(ctx context.Context, userID uuid.UUID, code string) ([]string, error)
+	DisableTOTP(ctx context.Context, userID uuid.UUID, code string) error
+	IsEnabled(ctx context.Context, userID uuid.UUID) (bool, error)
+	VerifyCode(ctx context.Context, userID uuid.UUID, code string) (bool, error)
+}
+
+type mfaService struct {
+	userRepo repository.UserRepository
+	mfaRepo  repository.MFARepository
+	issuer   string
+	now      func() time.Time
+}
+
+func NewMFAService(userRepo repository.UserRepository, mfaRepo repository.MFARepository, issuer string) MFAService {
+	return &mfaService{
+		userRepo: userRepo,
+		mfaRepo:  mfaRepo,
+		issuer:   issuer,
+		now:      time.Now,
+	}
+}
+
+// EnrollTOTP membuat secret baru yang belum aktif sampai dikonfirmasi lewat ConfirmTOTP.
+// Memanggil ulang sebelum konfirmasi akan mengganti secret sebelumnya.
+func (s *mfaService) EnrollTOTP(ctx context.Context, userID uuid.UUID) (*models.TOTPEnrollment, error) {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := s.mfaRepo.SavePendingTOTP(ctx, userID, secret)
+	if err != nil {
+		return nil, err
+	}
+	if !saved {
+		return nil, ErrMFAAlreadyEnabled
+	}
+
+	return &models.TOTPEnrollment{
+		Secret: secret,
+		URI:    totp.URI(s.issuer, user.Email, secret),
+	}, nil
+}
+
+// ConfirmTOTP mengaktifkan TOTP jika code cocok dengan secret yang sedang didaftarkan, lalu
+// mengembalikan recovery code. Recovery code hanya ditampilkan sekali ini; yang disimpan hash-nya.
+func (s *mfaService) ConfirmTOTP(ctx context.Context, userID uuid.UUID, code string) ([]string, error) {
+	credential, err := s.mfaRepo.GetTOTP(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrMFANotEnrolled
+	}
+	if err != nil {
+		return nil, err
+	}
+	if credential.Enabled() {
+		return nil, ErrMFAAlreadyEnabled
+	}
+
+	step, ok := totp.Validate(credential.Secret, normalizeTOTPCode(code), s.now(), totpSkew)
+	if !ok {
+		return nil, ErrInvalidMFACode
+	}
+
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		codes[i], err = generateRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		hashes[i] = hashToken(normalizeRecoveryCode(codes[i]))
+	}
+
+	if err := s.mfaRepo.EnableTOTP(ctx, userID, step, hashes); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// DisableTOTP mematikan 2FA; membutuhkan kode TOTP atau recovery code yang valid.
+func (s *mfaService) DisableTOTP(ctx context.Context, userID uuid.UUID, code string) error {
+	ok, err := s.VerifyCode(ctx, userID, code)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrInvalidMFACode
+	}
+
+	return s.mfaRepo.DisableTOTP(ctx, userID)
+}
+
+func (s *mfaService) IsEnabled(ctx context.Context, userID uuid.UUID) (bool, error) {
+	credential, err := s.mfaRepo.GetTOTP(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return credential.Enabled(), nil
+}
+
+// VerifyCode menerima kode TOTP 6 digit atau recovery code. Kode TOTP yang sudah pernah dipakai
+// dan recovery code yang sudah terpakai ditolak.
+func (s *mfaService) VerifyCode(ctx context.Context, userID uuid.UUID, code string) (bool, error) {
+	credential, err := s.mfaRepo.GetTOTP(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, ErrMFANotEnabled
+	}
+	if err != nil {
+		return false, err
+	}
+	if !credential.Enabled() {
+		return false, ErrMFANotEnabled
+	}
+
+	if totpCode := normalizeTOTPCode(code); len(totpCode) == totp.Digits {
+		step, ok := totp.Validate(credential.Secret, totpCode, s.now(), totpSkew)
+		if !ok {
+			return false, nil
+		}
+		return s.mfaRepo.AdvanceTOTPStep(ctx, userID, step)
+	}
+
+	return s.mfaRepo.ConsumeRecoveryCode(ctx, userID, hashToken(normalizeRecoveryCode(code)))
+}
+
+// generateRecoveryCode membuat kode 64-bit berformat xxxx-xxxx-xxxx-xxxx (hex).
+func generateRecoveryCode() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	h := hex.EncodeToString(b)
+	return h[0:4] + "-" + h[4:8] + "-" + h[8:12] + "-" + h[12:16], nil
+}
+
+func normalizeTOTPCode(code string) string {
+	return strings.ReplaceAll(strings.TrimSpace(code), " ", "")
+}
+
+// normalizeRecoveryCode membuang spasi/tanda hubung dan menyeragamkan huruf agar input user
+// yang diketik ulang tetap cocok.
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	repoMocks "github.com/Udean777/uang-bijak-go/internal/repository/mocks"
+	"github.com/Udean777/uang-bijak-go/internal/totp"
+)
+
+type mfaServiceMocks struct {
+	userRepo *repoMocks.MockUserRepository
+	mfaRepo  *repoMocks.MockMFARepository
+}
+
+var mfaTestNow = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func setupMFAService(t *testing.T) (*mfaService, mfaServiceMocks) {
+	m := mfaServiceMocks{
+		userRepo: repoMocks.NewMockUserRepository(t),
+		mfaRepo:  repoMocks.NewMockMFARepository(t),
+	}
+	service := NewMFAService(m.userRepo, m.mfaRepo, "Uang Bijak").(*mfaService)
+	service.now = func() time.Time { return mfaTestNow }
+	return service, m
+}
+
+func TestMFAService_EnrollTOTP(t *testing.T) {
+	service, m := setupMFAService(t)
+	ctx := context.Background()
+	testUser := &models.User{ID: uuid.New(), Email: "user@example.com"}
+
+	t.Run("Success", func(t *testing.T) {
+		// 1. Setup
+		m.userRepo.EXPECT().GetUserByID(ctx, testUser.ID).Return(testUser, nil).Once()
+		m.mfaRepo.EXPECT().SavePendingTOTP(ctx, testUser.ID, mock.AnythingOfType("string")).Return(true, nil).Once()
+
+		// 2. Act
+		enrollment, err := service.EnrollTOTP(ctx, testUser.ID)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.NotEmpty(t, enrollment.Secret)
+		assert.True(t, strings.HasPrefix(enrollment.URI, "otpauth://totp/"))
+		assert.Contains(t, enrollment.URI, "secret="+enrollment.Secret)
+	})
+
+	t.Run("Fail - Already Enabled", func(t *testing.T) {
+		// 1. Setup
+		m.userRepo.EXPECT().GetUserByID(ctx, testUser.ID).Return(testUser, nil).Once()
+		m.mfaRepo.EXPECT().SavePendingTOTP(ctx, testUser.ID, mock.AnythingOfType("string")).Return(false, nil).Once()
+
+		// 2. Act
+		_, err := service.EnrollTOTP(ctx, testUser.ID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrMFAAlreadyEnabled)
+	})
+}
+
+func TestMFAService_ConfirmTOTP(t *testing.T) {
+	service, m := setupMFAService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+
+	secret, _ := totp.GenerateSecret()
+	pending := &models.TOTPCredential{UserID: userID, Secret: secret}
+	validCode, _ := totp.CodeAt(secret, totp.Step(mfaTestNow))
+
+	t.Run("Success - Returns Recovery Codes", func(t *testing.T) {
+		// 1. Setup
+		m.mfaRepo.EXPECT().GetTOTP(ctx, userID).Return(pending, nil).Once()
+
+		var storedHashes []string
+		m.mfaRepo.EXPECT().
+			EnableTOTP(ctx, userID, totp.Step(mfaTestNow), mock.Anything).
+			Run(func(_ context.Context, _ uuid.UUID, _ int64, hashes []string) { storedHashes = hashes }).
+			Return(nil).
+			Once()
+
+		// 2. Act
+		codes, err := service.ConfirmTOTP(ctx, userID, validCode)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Len(t, codes, recoveryCodeCount)
+		assert.Len(t, storedHashes, recoveryCodeCount)
+		// Yang disimpan hanya hash, bukan kode aslinya
+		assert.Equal(t, hashToken(normalizeRecoveryCode(codes[0])), storedHashes[0])
+		assert.NotContains(t, storedHashes, codes[0])
+	})
+
+	t.Run("Fail - Wrong Code", func(t *testing.T) {
+		// 1. Setup
+		m.mfaRepo.EXPECT().GetTOTP(ctx, userID).Return(pending, nil).Once()
+
+		// 2. Act
+		_, err := service.ConfirmTOTP(ctx, userID, "000000")
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrInvalidMFACode)
+	})
+
+	t.Run("Fail - Not Enrolled", func(t *testing.T) {
+		// 1. Setup
+		m.mfaRepo.EXPECT().GetTOTP(ctx, userID).Return(nil, pgx.ErrNoRows).Once()
+
+		// 2. Act
+		_, err := service.ConfirmTOTP(ctx, userID, validCode)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrMFANotEnrolled)
+	})
+}
+
+func TestMFAService_VerifyCode(t *testing.T) {
+	service, m := setupMFAService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+
+	secret, _ := totp.GenerateSecret()
+	enabledAt := mfaTestNow.Add(-24 * time.Hour)
+	enabled := &models.TOTPCredential{UserID: userID, Secret: secret, EnabledAt: &enabledAt}
+	validCode, _ := totp.CodeAt(secret, totp.Step(mfaTestNow))
+
+	t.Run("Valid TOTP Code", func(t *testing.T) {
+		// 1. Setup
+		m.mfaRepo.EXPECT().GetTOTP(ctx, userID).Return(enabled, nil).Once()
+		m.mfaRepo.EXPECT().AdvanceTOTPStep(ctx, userID, totp.Step(mfaTestNow)).Return(true, nil).Once()
+
+		// 2. Act
+		ok, err := service.VerifyCode(ctx, userID, validCode)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("Replayed TOTP Code Is Rejected", func(t *testing.T) {
+		// 1. Setup: step sudah pernah dipakai sehingga repo menolak
+		m.mfaRepo.EXPECT().GetTOTP(ctx, userID).Return(enabled, nil).Once()
+		m.mfaRepo.EXPECT().AdvanceTOTPStep(ctx, userID, totp.Step(mfaTestNow)).Return(false, nil).Once()
+
+		// 2. Act
+		ok, err := service.VerifyCode(ctx, userID, validCode)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("Recovery Code", func(t *testing.T) {
+		// 1. Setup
+		m.mfaRepo.EXPECT().GetTOTP(ctx, userID).Return(enabled, nil).Once()
+		m.mfaRepo.EXPECT().
+			ConsumeRecoveryCode(ctx, userID, hashToken("0123456789abcdef")).
+			Return(true, nil).
+			Once()
+
+		// 2. Act: format penulisan user tidak harus sama persis
+		ok, err := service.VerifyCode(ctx, userID, " 0123-4567-89AB-CDEF ")
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("Fail - MFA Not Enabled", func(t *testing.T) {
+		// 1. Setup
+		m.mfaRepo.EXPECT().GetTOTP(ctx, userID).Return(&models.TOTPCredential{UserID: userID, Secret: secret}, nil).Once()
+
+		// 2. Act
+		_, err := service.VerifyCode(ctx, userID, validCode)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrMFANotEnabled)
+	})
+}
+
+func TestMFAService_DisableTOTP(t *testing.T) {
+	service, m := setupMFAService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+
+	secret, _ := totp.GenerateSecret()
+	enabledAt := mfaTestNow.Add(-24 * time.Hour)
+	enabled := &models.TOTPCredential{UserID: userID, Secret: secret, EnabledAt: &enabledAt}
+	validCode, _ := totp.CodeAt(secret, totp.Step(mfaTestNow))
+
+	t.Run("Success", func(t *testing.T) {
+		// 1. Setup
+		m.mfaRepo.EXPECT().GetTOTP(ctx, userID).Return(enabled, nil).Once()
+		m.mfaRepo.EXPECT().AdvanceTOTPStep(ctx, userID, totp.Step(mfaTestNow)).Return(true, nil).Once()
+		m.mfaRepo.EXPECT().DisableTOTP(ctx, userID).Return(nil).Once()
+
+		// 2. Act
+		err := service.DisableTOTP(ctx, userID, validCode)
+
+		// 3. Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fail - Wrong Code", func(t *testing.T) {
+		// 1. Setup
+		m.mfaRepo.EXPECT().GetTOTP(ctx, userID).Return(enabled, nil).Once()
+
+		// 2. Act
+		err := service.DisableTOTP(ctx, userID, "000000")
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrInvalidMFACode)
+	})
+}
@@ -8,6 +8,8 @@ import (
 	models "github.com/Udean777/uang-bijak-go/internal/models"
 	mock "github.com/stretchr/testify/mock"
 
+	authtoken "github.com/Udean777/uang-bijak-go/internal/authtoken"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -24,39 +26,34 @@ func (_m *MockAuthService) EXPECT() *MockAuthService_Expecter {
 	return &MockAuthService_Expecter{mock: &_m.Mock}
 }
 
-// Login provides a mock function with given fields: ctx, email, password
-func (_m *MockAuthService) Login(ctx context.Context, email string, password string) (string, string, error) {
-	ret := _m.Called(ctx, email, password)
+// Login provides a mock function with given fields: ctx, email, password, meta
+func (_m *MockAuthService) Login(ctx context.Context, email string, password string, meta models.SessionMetadata) (*models.LoginResult, error) {
+	ret := _m.Called(ctx, email, password, meta)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Login")
 	}
 
-	var r0 string
-	var r1 string
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, string, error)); ok {
-		return rf(ctx, email, password)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
-		r0 = rf(ctx, email, password)
-	} else {
-		r0 = ret.Get(0).(string)
+	var r0 *models.LoginResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, models.SessionMetadata) (*models.LoginResult, error)); ok {
+		return rf(ctx, email, password, meta)
 	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) string); ok {
-		r1 = rf(ctx, email, password)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, models.SessionMetadata) *models.LoginResult); ok {
+		r0 = rf(ctx, email, password, meta)
 	} else {
-		r1 = ret.Get(1).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.LoginResult)
+		}
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
-		r2 = rf(ctx, email, password)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, models.SessionMetadata) error); ok {
+		r1 = rf(ctx, email, password, meta)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
 
-	return r0, r1, r2
+	return r0, r1
 }
 
 // MockAuthService_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
@@ -68,29 +65,184 @@ type MockAuthService_Login_Call struct {
 //   - ctx context.Context
 //   - email string
 //   - password string
-func (_e *MockAuthService_Expecter) Login(ctx interface{}, email interface{}, password interface{}) *MockAuthService_Login_Call {
-	return &MockAuthService_Login_Call{Call: _e.mock.On("Login", ctx, email, password)}
+//   - meta models.SessionMetadata
+func (_e *MockAuthService_Expecter) Login(ctx interface{}, email interface{}, password interface{}, meta interface{}) *MockAuthService_Login_Call {
+	return &MockAuthService_Login_Call{Call: _e.mock.On("Login", ctx, email, password, meta)}
 }
 
-func (_c *MockAuthService_Login_Call) Run(run func(ctx context.Context, email string, password string)) *MockAuthService_Login_Call {
+func (_c *MockAuthService_Login_Call) Run(run func(ctx context.Context, email string, password string, meta models.SessionMetadata)) *MockAuthService_Login_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(models.SessionMetadata))
 	})
 	return _c
 }
 
-func (_c *MockAuthService_Login_Call) Return(accessToken string, refreshToken string, err error) *MockAuthService_Login_Call {
-	_c.Call.Return(accessToken, refreshToken, err)
+func (_c *MockAuthService_Login_Call) Return(_a0 *models.LoginResult, _a1 error) *MockAuthService_Login_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthService_Login_Call) RunAndReturn(run func(context.Context, string, string, models.SessionMetadata) (*models.LoginResult, error)) *MockAuthService_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoginExternal provides a mock function with given fields: ctx, user, meta
+func (_m *MockAuthService) LoginExternal(ctx context.Context, user *models.User, meta models.SessionMetadata) (*models.LoginResult, error) {
+	ret := _m.Called(ctx, user, meta)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginExternal")
+	}
+
+	var r0 *models.LoginResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.User, models.SessionMetadata) (*models.LoginResult, error)); ok {
+		return rf(ctx, user, meta)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.User, models.SessionMetadata) *models.LoginResult); ok {
+		r0 = rf(ctx, user, meta)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.LoginResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.User, models.SessionMetadata) error); ok {
+		r1 = rf(ctx, user, meta)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthService_LoginExternal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginExternal'
+type MockAuthService_LoginExternal_Call struct {
+	*mock.Call
+}
+
+// LoginExternal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *models.User
+//   - meta models.SessionMetadata
+func (_e *MockAuthService_Expecter) LoginExternal(ctx interface{}, user interface{}, meta interface{}) *MockAuthService_LoginExternal_Call {
+	return &MockAuthService_LoginExternal_Call{Call: _e.mock.On("LoginExternal", ctx, user, meta)}
+}
+
+func (_c *MockAuthService_LoginExternal_Call) Run(run func(ctx context.Context, user *models.User, meta models.SessionMetadata)) *MockAuthService_LoginExternal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.User), args[2].(models.SessionMetadata))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_LoginExternal_Call) Return(_a0 *models.LoginResult, _a1 error) *MockAuthService_LoginExternal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthService_LoginExternal_Call) RunAndReturn(run func(context.Context, *models.User, models.SessionMetadata) (*models.LoginResult, error)) *MockAuthService_LoginExternal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Logout provides a mock function with given fields: ctx, refreshToken
+func (_m *MockAuthService) Logout(ctx context.Context, refreshToken string) error {
+	ret := _m.Called(ctx, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logout")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, refreshToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthService_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
+type MockAuthService_Logout_Call struct {
+	*mock.Call
+}
+
+// Logout is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refreshToken string
+func (_e *MockAuthService_Expecter) Logout(ctx interface{}, refreshToken interface{}) *MockAuthService_Logout_Call {
+	return &MockAuthService_Logout_Call{Call: _e.mock.On("Logout", ctx, refreshToken)}
+}
+
+func (_c *MockAuthService_Logout_Call) Run(run func(ctx context.Context, refreshToken string)) *MockAuthService_Logout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_Logout_Call) Return(_a0 error) *MockAuthService_Logout_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockAuthService_Login_Call) RunAndReturn(run func(context.Context, string, string) (string, string, error)) *MockAuthService_Login_Call {
+func (_c *MockAuthService_Logout_Call) RunAndReturn(run func(context.Context, string) error) *MockAuthService_Logout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogoutAll provides a mock function with given fields: ctx, userID
+func (_m *MockAuthService) LogoutAll(ctx context.Context, userID uuid.UUID) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogoutAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthService_LogoutAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogoutAll'
+type MockAuthService_LogoutAll_Call struct {
+	*mock.Call
+}
+
+// LogoutAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockAuthService_Expecter) LogoutAll(ctx interface{}, userID interface{}) *MockAuthService_LogoutAll_Call {
+	return &MockAuthService_LogoutAll_Call{Call: _e.mock.On("LogoutAll", ctx, userID)}
+}
+
+func (_c *MockAuthService_LogoutAll_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockAuthService_LogoutAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_LogoutAll_Call) Return(_a0 error) *MockAuthService_LogoutAll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthService_LogoutAll_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockAuthService_LogoutAll_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // RefreshToken provides a mock function with given fields: ctx, tokenString
-func (_m *MockAuthService) RefreshToken(ctx context.Context, tokenString string) (string, error) {
+func (_m *MockAuthService) RefreshToken(ctx context.Context, tokenString string) (string, string, error) {
 	ret := _m.Called(ctx, tokenString)
 
 	if len(ret) == 0 {
@@ -98,8 +250,9 @@ func (_m *MockAuthService) RefreshToken(ctx context.Context, tokenString string)
 	}
 
 	var r0 string
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, string, error)); ok {
 		return rf(ctx, tokenString)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
@@ -108,13 +261,19 @@ func (_m *MockAuthService) RefreshToken(ctx context.Context, tokenString string)
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, string) string); ok {
 		r1 = rf(ctx, tokenString)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(string)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(ctx, tokenString)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // MockAuthService_RefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshToken'
@@ -136,19 +295,19 @@ func (_c *MockAuthService_RefreshToken_Call) Run(run func(ctx context.Context, t
 	return _c
 }
 
-func (_c *MockAuthService_RefreshToken_Call) Return(_a0 string, _a1 error) *MockAuthService_RefreshToken_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockAuthService_RefreshToken_Call) Return(accessToken string, refreshToken string, err error) *MockAuthService_RefreshToken_Call {
+	_c.Call.Return(accessToken, refreshToken, err)
 	return _c
 }
 
-func (_c *MockAuthService_RefreshToken_Call) RunAndReturn(run func(context.Context, string) (string, error)) *MockAuthService_RefreshToken_Call {
+func (_c *MockAuthService_RefreshToken_Call) RunAndReturn(run func(context.Context, string) (string, string, error)) *MockAuthService_RefreshToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Register provides a mock function with given fields: ctx, name, email, password
-func (_m *MockAuthService) Register(ctx context.Context, name string, email string, password string) (*models.User, error) {
-	ret := _m.Called(ctx, name, email, password)
+// Register provides a mock function with given fields: ctx, name, email, password, locale
+func (_m *MockAuthService) Register(ctx context.Context, name string, email string, password string, locale string) (*models.User, error) {
+	ret := _m.Called(ctx, name, email, password, locale)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Register")
@@ -156,19 +315,19 @@ func (_m *MockAuthService) Register(ctx context.Context, name string, email stri
 
 	var r0 *models.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*models.User, error)); ok {
-		return rf(ctx, name, email, password)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (*models.User, error)); ok {
+		return rf(ctx, name, email, password, locale)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *models.User); ok {
-		r0 = rf(ctx, name, email, password)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) *models.User); ok {
+		r0 = rf(ctx, name, email, password, locale)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
-		r1 = rf(ctx, name, email, password)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(ctx, name, email, password, locale)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -186,13 +345,14 @@ type MockAuthService_Register_Call struct {
 //   - name string
 //   - email string
 //   - password string
-func (_e *MockAuthService_Expecter) Register(ctx interface{}, name interface{}, email interface{}, password interface{}) *MockAuthService_Register_Call {
-	return &MockAuthService_Register_Call{Call: _e.mock.On("Register", ctx, name, email, password)}
+//   - locale string
+func (_e *MockAuthService_Expecter) Register(ctx interface{}, name interface{}, email interface{}, password interface{}, locale interface{}) *MockAuthService_Register_Call {
+	return &MockAuthService_Register_Call{Call: _e.mock.On("Register", ctx, name, email, password, locale)}
 }
 
-func (_c *MockAuthService_Register_Call) Run(run func(ctx context.Context, name string, email string, password string)) *MockAuthService_Register_Call {
+func (_c *MockAuthService_Register_Call) Run(run func(ctx context.Context, name string, email string, password string, locale string)) *MockAuthService_Register_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
 	})
 	return _c
 }
@@ -202,29 +362,29 @@ func (_c *MockAuthService_Register_Call) Return(_a0 *models.User, _a1 error) *Mo
 	return _c
 }
 
-func (_c *MockAuthService_Register_Call) RunAndReturn(run func(context.Context, string, string, string) (*models.User, error)) *MockAuthService_Register_Call {
+func (_c *MockAuthService_Register_Call) RunAndReturn(run func(context.Context, string, string, string, string) (*models.User, error)) *MockAuthService_Register_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ValidateToken provides a mock function with given fields: tokenString, expectedType
-func (_m *MockAuthService) ValidateToken(tokenString string, expectedType string) (uuid.UUID, error) {
+func (_m *MockAuthService) ValidateToken(tokenString string, expectedType string) (*authtoken.Claims, error) {
 	ret := _m.Called(tokenString, expectedType)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ValidateToken")
 	}
 
-	var r0 uuid.UUID
+	var r0 *authtoken.Claims
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string) (uuid.UUID, error)); ok {
+	if rf, ok := ret.Get(0).(func(string, string) (*authtoken.Claims, error)); ok {
 		return rf(tokenString, expectedType)
 	}
-	if rf, ok := ret.Get(0).(func(string, string) uuid.UUID); ok {
+	if rf, ok := ret.Get(0).(func(string, string) *authtoken.Claims); ok {
 		r0 = rf(tokenString, expectedType)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(uuid.UUID)
+			r0 = ret.Get(0).(*authtoken.Claims)
 		}
 	}
 
@@ -256,12 +416,78 @@ func (_c *MockAuthService_ValidateToken_Call) Run(run func(tokenString string, e
 	return _c
 }
 
-func (_c *MockAuthService_ValidateToken_Call) Return(_a0 uuid.UUID, _a1 error) *MockAuthService_ValidateToken_Call {
+func (_c *MockAuthService_ValidateToken_Call) Return(_a0 *authtoken.Claims, _a1 error) *MockAuthService_ValidateToken_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockAuthService_ValidateToken_Call) RunAndReturn(run func(string, string) (uuid.UUID, error)) *MockAuthService_ValidateToken_Call {
+func (_c *MockAuthService_ValidateToken_Call) RunAndReturn(run func(string, string) (*authtoken.Claims, error)) *MockAuthService_ValidateToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyMFA provides a mock function with given fields: ctx, mfaToken, code, meta
+func (_m *MockAuthService) VerifyMFA(ctx context.Context, mfaToken string, code string, meta models.SessionMetadata) (string, string, error) {
+	ret := _m.Called(ctx, mfaToken, code, meta)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyMFA")
+	}
+
+	var r0 string
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, models.SessionMetadata) (string, string, error)); ok {
+		return rf(ctx, mfaToken, code, meta)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, models.SessionMetadata) string); ok {
+		r0 = rf(ctx, mfaToken, code, meta)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, models.SessionMetadata) string); ok {
+		r1 = rf(ctx, mfaToken, code, meta)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, models.SessionMetadata) error); ok {
+		r2 = rf(ctx, mfaToken, code, meta)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAuthService_VerifyMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyMFA'
+type MockAuthService_VerifyMFA_Call struct {
+	*mock.Call
+}
+
+// VerifyMFA is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mfaToken string
+//   - code string
+//   - meta models.SessionMetadata
+func (_e *MockAuthService_Expecter) VerifyMFA(ctx interface{}, mfaToken interface{}, code interface{}, meta interface{}) *MockAuthService_VerifyMFA_Call {
+	return &MockAuthService_VerifyMFA_Call{Call: _e.mock.On("VerifyMFA", ctx, mfaToken, code, meta)}
+}
+
+func (_c *MockAuthService_VerifyMFA_Call) Run(run func(ctx context.Context, mfaToken string, code string, meta models.SessionMetadata)) *MockAuthService_VerifyMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(models.SessionMetadata))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_VerifyMFA_Call) Return(accessToken string, refreshToken string, err error) *MockAuthService_VerifyMFA_Call {
+	_c.Call.Return(accessToken, refreshToken, err)
+	return _c
+}
+
+func (_c *MockAuthService_VerifyMFA_Call) RunAndReturn(run func(context.Context, string, string, models.SessionMetadata) (string, string, error)) *MockAuthService_VerifyMFA_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -24,6 +24,66 @@ func (_m *MockCategoryService) EXPECT() *MockCategoryService_Expecter {
 	return &MockCategoryService_Expecter{mock: &_m.Mock}
 }
 
+// ApplyDefaultCategories provides a mock function with given fields: ctx, userID, locale
+func (_m *MockCategoryService) ApplyDefaultCategories(ctx context.Context, userID uuid.UUID, locale string) ([]models.Category, error) {
+	ret := _m.Called(ctx, userID, locale)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyDefaultCategories")
+	}
+
+	var r0 []models.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) ([]models.Category, error)); ok {
+		return rf(ctx, userID, locale)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) []models.Category); ok {
+		r0 = rf(ctx, userID, locale)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, userID, locale)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCategoryService_ApplyDefaultCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyDefaultCategories'
+type MockCategoryService_ApplyDefaultCategories_Call struct {
+	*mock.Call
+}
+
+// ApplyDefaultCategories is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - locale string
+func (_e *MockCategoryService_Expecter) ApplyDefaultCategories(ctx interface{}, userID interface{}, locale interface{}) *MockCategoryService_ApplyDefaultCategories_Call {
+	return &MockCategoryService_ApplyDefaultCategories_Call{Call: _e.mock.On("ApplyDefaultCategories", ctx, userID, locale)}
+}
+
+func (_c *MockCategoryService_ApplyDefaultCategories_Call) Run(run func(ctx context.Context, userID uuid.UUID, locale string)) *MockCategoryService_ApplyDefaultCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockCategoryService_ApplyDefaultCategories_Call) Return(_a0 []models.Category, _a1 error) *MockCategoryService_ApplyDefaultCategories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCategoryService_ApplyDefaultCategories_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) ([]models.Category, error)) *MockCategoryService_ApplyDefaultCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateCategory provides a mock function with given fields: ctx, req, userID
 func (_m *MockCategoryService) CreateCategory(ctx context.Context, req models.UpsertCategoryRequest, userID uuid.UUID) (*models.Category, error) {
 	ret := _m.Called(ctx, req, userID)
@@ -132,9 +192,9 @@ func (_c *MockCategoryService_DeleteCategory_Call) RunAndReturn(run func(context
 	return _c
 }
 
-// GetUserCategories provides a mock function with given fields: ctx, userID
-func (_m *MockCategoryService) GetUserCategories(ctx context.Context, userID uuid.UUID) ([]models.Category, error) {
-	ret := _m.Called(ctx, userID)
+// GetUserCategories provides a mock function with given fields: ctx, userID, query
+func (_m *MockCategoryService) GetUserCategories(ctx context.Context, userID uuid.UUID, query models.CategoryQuery) ([]models.Category, error) {
+	ret := _m.Called(ctx, userID, query)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetUserCategories")
@@ -142,19 +202,19 @@ func (_m *MockCategoryService) GetUserCategories(ctx context.Context, userID uui
 
 	var r0 []models.Category
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.Category, error)); ok {
-		return rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.CategoryQuery) ([]models.Category, error)); ok {
+		return rf(ctx, userID, query)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.Category); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.CategoryQuery) []models.Category); ok {
+		r0 = rf(ctx, userID, query)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Category)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.CategoryQuery) error); ok {
+		r1 = rf(ctx, userID, query)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -170,13 +230,14 @@ type MockCategoryService_GetUserCategories_Call struct {
 // GetUserCategories is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID uuid.UUID
-func (_e *MockCategoryService_Expecter) GetUserCategories(ctx interface{}, userID interface{}) *MockCategoryService_GetUserCategories_Call {
-	return &MockCategoryService_GetUserCategories_Call{Call: _e.mock.On("GetUserCategories", ctx, userID)}
+//   - query models.CategoryQuery
+func (_e *MockCategoryService_Expecter) GetUserCategories(ctx interface{}, userID interface{}, query interface{}) *MockCategoryService_GetUserCategories_Call {
+	return &MockCategoryService_GetUserCategories_Call{Call: _e.mock.On("GetUserCategories", ctx, userID, query)}
 }
 
-func (_c *MockCategoryService_GetUserCategories_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockCategoryService_GetUserCategories_Call {
+func (_c *MockCategoryService_GetUserCategories_Call) Run(run func(ctx context.Context, userID uuid.UUID, query models.CategoryQuery)) *MockCategoryService_GetUserCategories_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.CategoryQuery))
 	})
 	return _c
 }
@@ -186,7 +247,66 @@ func (_c *MockCategoryService_GetUserCategories_Call) Return(_a0 []models.Catego
 	return _c
 }
 
-func (_c *MockCategoryService_GetUserCategories_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.Category, error)) *MockCategoryService_GetUserCategories_Call {
+func (_c *MockCategoryService_GetUserCategories_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.CategoryQuery) ([]models.Category, error)) *MockCategoryService_GetUserCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeCategory provides a mock function with given fields: ctx, categoryID, targetCategoryID, userID
+func (_m *MockCategoryService) MergeCategory(ctx context.Context, categoryID int64, targetCategoryID int64, userID uuid.UUID) (int64, error) {
+	ret := _m.Called(ctx, categoryID, targetCategoryID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeCategory")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, uuid.UUID) (int64, error)); ok {
+		return rf(ctx, categoryID, targetCategoryID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, uuid.UUID) int64); ok {
+		r0 = rf(ctx, categoryID, targetCategoryID, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, uuid.UUID) error); ok {
+		r1 = rf(ctx, categoryID, targetCategoryID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCategoryService_MergeCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeCategory'
+type MockCategoryService_MergeCategory_Call struct {
+	*mock.Call
+}
+
+// MergeCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - categoryID int64
+//   - targetCategoryID int64
+//   - userID uuid.UUID
+func (_e *MockCategoryService_Expecter) MergeCategory(ctx interface{}, categoryID interface{}, targetCategoryID interface{}, userID interface{}) *MockCategoryService_MergeCategory_Call {
+	return &MockCategoryService_MergeCategory_Call{Call: _e.mock.On("MergeCategory", ctx, categoryID, targetCategoryID, userID)}
+}
+
+func (_c *MockCategoryService_MergeCategory_Call) Run(run func(ctx context.Context, categoryID int64, targetCategoryID int64, userID uuid.UUID)) *MockCategoryService_MergeCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockCategoryService_MergeCategory_Call) Return(movedTransactions int64, err error) *MockCategoryService_MergeCategory_Call {
+	_c.Call.Return(movedTransactions, err)
+	return _c
+}
+
+func (_c *MockCategoryService_MergeCategory_Call) RunAndReturn(run func(context.Context, int64, int64, uuid.UUID) (int64, error)) *MockCategoryService_MergeCategory_Call {
 	_c.Call.Return(run)
 	return _c
 }
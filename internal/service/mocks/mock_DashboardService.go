@@ -8,8 +8,6 @@ import (
 	models "github.com/Udean777/uang-bijak-go/internal/models"
 	mock "github.com/stretchr/testify/mock"
 
-	time "time"
-
 	uuid "github.com/google/uuid"
 )
 
@@ -26,9 +24,69 @@ func (_m *MockDashboardService) EXPECT() *MockDashboardService_Expecter {
 	return &MockDashboardService_Expecter{mock: &_m.Mock}
 }
 
-// GetDashboardSummary provides a mock function with given fields: ctx, userID, startTime, endTime
-func (_m *MockDashboardService) GetDashboardSummary(ctx context.Context, userID uuid.UUID, startTime time.Time, endTime time.Time) (*models.DashboardSummary, error) {
-	ret := _m.Called(ctx, userID, startTime, endTime)
+// GetCategoryBreakdown provides a mock function with given fields: ctx, userID, query
+func (_m *MockDashboardService) GetCategoryBreakdown(ctx context.Context, userID uuid.UUID, query models.CategoryBreakdownQuery) ([]models.CategorySummary, error) {
+	ret := _m.Called(ctx, userID, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategoryBreakdown")
+	}
+
+	var r0 []models.CategorySummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.CategoryBreakdownQuery) ([]models.CategorySummary, error)); ok {
+		return rf(ctx, userID, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.CategoryBreakdownQuery) []models.CategorySummary); ok {
+		r0 = rf(ctx, userID, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CategorySummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.CategoryBreakdownQuery) error); ok {
+		r1 = rf(ctx, userID, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDashboardService_GetCategoryBreakdown_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategoryBreakdown'
+type MockDashboardService_GetCategoryBreakdown_Call struct {
+	*mock.Call
+}
+
+// GetCategoryBreakdown is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - query models.CategoryBreakdownQuery
+func (_e *MockDashboardService_Expecter) GetCategoryBreakdown(ctx interface{}, userID interface{}, query interface{}) *MockDashboardService_GetCategoryBreakdown_Call {
+	return &MockDashboardService_GetCategoryBreakdown_Call{Call: _e.mock.On("GetCategoryBreakdown", ctx, userID, query)}
+}
+
+func (_c *MockDashboardService_GetCategoryBreakdown_Call) Run(run func(ctx context.Context, userID uuid.UUID, query models.CategoryBreakdownQuery)) *MockDashboardService_GetCategoryBreakdown_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.CategoryBreakdownQuery))
+	})
+	return _c
+}
+
+func (_c *MockDashboardService_GetCategoryBreakdown_Call) Return(_a0 []models.CategorySummary, _a1 error) *MockDashboardService_GetCategoryBreakdown_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDashboardService_GetCategoryBreakdown_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.CategoryBreakdownQuery) ([]models.CategorySummary, error)) *MockDashboardService_GetCategoryBreakdown_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDashboardSummary provides a mock function with given fields: ctx, userID, query
+func (_m *MockDashboardService) GetDashboardSummary(ctx context.Context, userID uuid.UUID, query models.DashboardQuery) (*models.DashboardSummary, error) {
+	ret := _m.Called(ctx, userID, query)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetDashboardSummary")
@@ -36,19 +94,19 @@ func (_m *MockDashboardService) GetDashboardSummary(ctx context.Context, userID
 
 	var r0 *models.DashboardSummary
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) (*models.DashboardSummary, error)); ok {
-		return rf(ctx, userID, startTime, endTime)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.DashboardQuery) (*models.DashboardSummary, error)); ok {
+		return rf(ctx, userID, query)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) *models.DashboardSummary); ok {
-		r0 = rf(ctx, userID, startTime, endTime)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.DashboardQuery) *models.DashboardSummary); ok {
+		r0 = rf(ctx, userID, query)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.DashboardSummary)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time, time.Time) error); ok {
-		r1 = rf(ctx, userID, startTime, endTime)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.DashboardQuery) error); ok {
+		r1 = rf(ctx, userID, query)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -64,15 +122,14 @@ type MockDashboardService_GetDashboardSummary_Call struct {
 // GetDashboardSummary is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID uuid.UUID
-//   - startTime time.Time
-//   - endTime time.Time
-func (_e *MockDashboardService_Expecter) GetDashboardSummary(ctx interface{}, userID interface{}, startTime interface{}, endTime interface{}) *MockDashboardService_GetDashboardSummary_Call {
-	return &MockDashboardService_GetDashboardSummary_Call{Call: _e.mock.On("GetDashboardSummary", ctx, userID, startTime, endTime)}
+//   - query models.DashboardQuery
+func (_e *MockDashboardService_Expecter) GetDashboardSummary(ctx interface{}, userID interface{}, query interface{}) *MockDashboardService_GetDashboardSummary_Call {
+	return &MockDashboardService_GetDashboardSummary_Call{Call: _e.mock.On("GetDashboardSummary", ctx, userID, query)}
 }
 
-func (_c *MockDashboardService_GetDashboardSummary_Call) Run(run func(ctx context.Context, userID uuid.UUID, startTime time.Time, endTime time.Time)) *MockDashboardService_GetDashboardSummary_Call {
+func (_c *MockDashboardService_GetDashboardSummary_Call) Run(run func(ctx context.Context, userID uuid.UUID, query models.DashboardQuery)) *MockDashboardService_GetDashboardSummary_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time), args[3].(time.Time))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.DashboardQuery))
 	})
 	return _c
 }
@@ -82,7 +139,7 @@ func (_c *MockDashboardService_GetDashboardSummary_Call) Return(_a0 *models.Dash
 	return _c
 }
 
-func (_c *MockDashboardService_GetDashboardSummary_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time, time.Time) (*models.DashboardSummary, error)) *MockDashboardService_GetDashboardSummary_Call {
+func (_c *MockDashboardService_GetDashboardSummary_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.DashboardQuery) (*models.DashboardSummary, error)) *MockDashboardService_GetDashboardSummary_Call {
 	_c.Call.Return(run)
 	return _c
 }
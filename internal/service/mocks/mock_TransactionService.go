@@ -84,29 +84,77 @@ func (_c *MockTransactionService_CreateTransaction_Call) RunAndReturn(run func(c
 	return _c
 }
 
-// GetUserTransactions provides a mock function with given fields: ctx, userID
-func (_m *MockTransactionService) GetUserTransactions(ctx context.Context, userID uuid.UUID) ([]models.Transaction, error) {
-	ret := _m.Called(ctx, userID)
+// DeleteTransaction provides a mock function with given fields: ctx, transactionID, userID
+func (_m *MockTransactionService) DeleteTransaction(ctx context.Context, transactionID int64, userID uuid.UUID) error {
+	ret := _m.Called(ctx, transactionID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uuid.UUID) error); ok {
+		r0 = rf(ctx, transactionID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTransactionService_DeleteTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTransaction'
+type MockTransactionService_DeleteTransaction_Call struct {
+	*mock.Call
+}
+
+// DeleteTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionID int64
+//   - userID uuid.UUID
+func (_e *MockTransactionService_Expecter) DeleteTransaction(ctx interface{}, transactionID interface{}, userID interface{}) *MockTransactionService_DeleteTransaction_Call {
+	return &MockTransactionService_DeleteTransaction_Call{Call: _e.mock.On("DeleteTransaction", ctx, transactionID, userID)}
+}
+
+func (_c *MockTransactionService_DeleteTransaction_Call) Run(run func(ctx context.Context, transactionID int64, userID uuid.UUID)) *MockTransactionService_DeleteTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTransactionService_DeleteTransaction_Call) Return(_a0 error) *MockTransactionService_DeleteTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTransactionService_DeleteTransaction_Call) RunAndReturn(run func(context.Context, int64, uuid.UUID) error) *MockTransactionService_DeleteTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserTransactions provides a mock function with given fields: ctx, userID, filter
+func (_m *MockTransactionService) GetUserTransactions(ctx context.Context, userID uuid.UUID, filter models.TransactionFilter) (*models.TransactionPage, error) {
+	ret := _m.Called(ctx, userID, filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetUserTransactions")
 	}
 
-	var r0 []models.Transaction
+	var r0 *models.TransactionPage
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.Transaction, error)); ok {
-		return rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.TransactionFilter) (*models.TransactionPage, error)); ok {
+		return rf(ctx, userID, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.Transaction); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.TransactionFilter) *models.TransactionPage); ok {
+		r0 = rf(ctx, userID, filter)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Transaction)
+			r0 = ret.Get(0).(*models.TransactionPage)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.TransactionFilter) error); ok {
+		r1 = rf(ctx, userID, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -122,23 +170,85 @@ type MockTransactionService_GetUserTransactions_Call struct {
 // GetUserTransactions is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID uuid.UUID
-func (_e *MockTransactionService_Expecter) GetUserTransactions(ctx interface{}, userID interface{}) *MockTransactionService_GetUserTransactions_Call {
-	return &MockTransactionService_GetUserTransactions_Call{Call: _e.mock.On("GetUserTransactions", ctx, userID)}
+//   - filter models.TransactionFilter
+func (_e *MockTransactionService_Expecter) GetUserTransactions(ctx interface{}, userID interface{}, filter interface{}) *MockTransactionService_GetUserTransactions_Call {
+	return &MockTransactionService_GetUserTransactions_Call{Call: _e.mock.On("GetUserTransactions", ctx, userID, filter)}
+}
+
+func (_c *MockTransactionService_GetUserTransactions_Call) Run(run func(ctx context.Context, userID uuid.UUID, filter models.TransactionFilter)) *MockTransactionService_GetUserTransactions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.TransactionFilter))
+	})
+	return _c
+}
+
+func (_c *MockTransactionService_GetUserTransactions_Call) Return(_a0 *models.TransactionPage, _a1 error) *MockTransactionService_GetUserTransactions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionService_GetUserTransactions_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.TransactionFilter) (*models.TransactionPage, error)) *MockTransactionService_GetUserTransactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTransaction provides a mock function with given fields: ctx, transactionID, req, userID
+func (_m *MockTransactionService) UpdateTransaction(ctx context.Context, transactionID int64, req models.UpdateTransactionRequest, userID uuid.UUID) (*models.Transaction, error) {
+	ret := _m.Called(ctx, transactionID, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTransaction")
+	}
+
+	var r0 *models.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, models.UpdateTransactionRequest, uuid.UUID) (*models.Transaction, error)); ok {
+		return rf(ctx, transactionID, req, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, models.UpdateTransactionRequest, uuid.UUID) *models.Transaction); ok {
+		r0 = rf(ctx, transactionID, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, models.UpdateTransactionRequest, uuid.UUID) error); ok {
+		r1 = rf(ctx, transactionID, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionService_UpdateTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTransaction'
+type MockTransactionService_UpdateTransaction_Call struct {
+	*mock.Call
+}
+
+// UpdateTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionID int64
+//   - req models.UpdateTransactionRequest
+//   - userID uuid.UUID
+func (_e *MockTransactionService_Expecter) UpdateTransaction(ctx interface{}, transactionID interface{}, req interface{}, userID interface{}) *MockTransactionService_UpdateTransaction_Call {
+	return &MockTransactionService_UpdateTransaction_Call{Call: _e.mock.On("UpdateTransaction", ctx, transactionID, req, userID)}
 }
 
-func (_c *MockTransactionService_GetUserTransactions_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockTransactionService_GetUserTransactions_Call {
+func (_c *MockTransactionService_UpdateTransaction_Call) Run(run func(ctx context.Context, transactionID int64, req models.UpdateTransactionRequest, userID uuid.UUID)) *MockTransactionService_UpdateTransaction_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(int64), args[2].(models.UpdateTransactionRequest), args[3].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockTransactionService_GetUserTransactions_Call) Return(_a0 []models.Transaction, _a1 error) *MockTransactionService_GetUserTransactions_Call {
+func (_c *MockTransactionService_UpdateTransaction_Call) Return(_a0 *models.Transaction, _a1 error) *MockTransactionService_UpdateTransaction_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockTransactionService_GetUserTransactions_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.Transaction, error)) *MockTransactionService_GetUserTransactions_Call {
+func (_c *MockTransactionService_UpdateTransaction_Call) RunAndReturn(run func(context.Context, int64, models.UpdateTransactionRequest, uuid.UUID) (*models.Transaction, error)) *MockTransactionService_UpdateTransaction_Call {
 	_c.Call.Return(run)
 	return _c
 }
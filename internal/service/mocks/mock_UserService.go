@@ -24,6 +24,65 @@ func (_m *MockUserService) EXPECT() *MockUserService_Expecter {
 	return &MockUserService_Expecter{mock: &_m.Mock}
 }
 
+// GetPreferences provides a mock function with given fields: ctx, userID
+func (_m *MockUserService) GetPreferences(ctx context.Context, userID uuid.UUID) (*models.UserPreferences, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPreferences")
+	}
+
+	var r0 *models.UserPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.UserPreferences, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.UserPreferences); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.UserPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserService_GetPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreferences'
+type MockUserService_GetPreferences_Call struct {
+	*mock.Call
+}
+
+// GetPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockUserService_Expecter) GetPreferences(ctx interface{}, userID interface{}) *MockUserService_GetPreferences_Call {
+	return &MockUserService_GetPreferences_Call{Call: _e.mock.On("GetPreferences", ctx, userID)}
+}
+
+func (_c *MockUserService_GetPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockUserService_GetPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockUserService_GetPreferences_Call) Return(_a0 *models.UserPreferences, _a1 error) *MockUserService_GetPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserService_GetPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.UserPreferences, error)) *MockUserService_GetPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserProfile provides a mock function with given fields: ctx, userID
 func (_m *MockUserService) GetUserProfile(ctx context.Context, userID uuid.UUID) (*models.User, error) {
 	ret := _m.Called(ctx, userID)
@@ -83,6 +142,126 @@ func (_c *MockUserService_GetUserProfile_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// UpdatePreferences provides a mock function with given fields: ctx, userID, req
+func (_m *MockUserService) UpdatePreferences(ctx context.Context, userID uuid.UUID, req models.UpdatePreferencesRequest) (*models.UserPreferences, error) {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePreferences")
+	}
+
+	var r0 *models.UserPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdatePreferencesRequest) (*models.UserPreferences, error)); ok {
+		return rf(ctx, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdatePreferencesRequest) *models.UserPreferences); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.UserPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdatePreferencesRequest) error); ok {
+		r1 = rf(ctx, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserService_UpdatePreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePreferences'
+type MockUserService_UpdatePreferences_Call struct {
+	*mock.Call
+}
+
+// UpdatePreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - req models.UpdatePreferencesRequest
+func (_e *MockUserService_Expecter) UpdatePreferences(ctx interface{}, userID interface{}, req interface{}) *MockUserService_UpdatePreferences_Call {
+	return &MockUserService_UpdatePreferences_Call{Call: _e.mock.On("UpdatePreferences", ctx, userID, req)}
+}
+
+func (_c *MockUserService_UpdatePreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID, req models.UpdatePreferencesRequest)) *MockUserService_UpdatePreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdatePreferencesRequest))
+	})
+	return _c
+}
+
+func (_c *MockUserService_UpdatePreferences_Call) Return(_a0 *models.UserPreferences, _a1 error) *MockUserService_UpdatePreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserService_UpdatePreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdatePreferencesRequest) (*models.UserPreferences, error)) *MockUserService_UpdatePreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProfile provides a mock function with given fields: ctx, userID, req
+func (_m *MockUserService) UpdateProfile(ctx context.Context, userID uuid.UUID, req models.UpdateProfileRequest) (*models.User, error) {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProfile")
+	}
+
+	var r0 *models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateProfileRequest) (*models.User, error)); ok {
+		return rf(ctx, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateProfileRequest) *models.User); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdateProfileRequest) error); ok {
+		r1 = rf(ctx, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserService_UpdateProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProfile'
+type MockUserService_UpdateProfile_Call struct {
+	*mock.Call
+}
+
+// UpdateProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - req models.UpdateProfileRequest
+func (_e *MockUserService_Expecter) UpdateProfile(ctx interface{}, userID interface{}, req interface{}) *MockUserService_UpdateProfile_Call {
+	return &MockUserService_UpdateProfile_Call{Call: _e.mock.On("UpdateProfile", ctx, userID, req)}
+}
+
+func (_c *MockUserService_UpdateProfile_Call) Run(run func(ctx context.Context, userID uuid.UUID, req models.UpdateProfileRequest)) *MockUserService_UpdateProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateProfileRequest))
+	})
+	return _c
+}
+
+func (_c *MockUserService_UpdateProfile_Call) Return(_a0 *models.User, _a1 error) *MockUserService_UpdateProfile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserService_UpdateProfile_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateProfileRequest) (*models.User, error)) *MockUserService_UpdateProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockUserService creates a new instance of MockUserService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockUserService(t interface {
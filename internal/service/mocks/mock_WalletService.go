@@ -24,6 +24,54 @@ func (_m *MockWalletService) EXPECT() *MockWalletService_Expecter {
 	return &MockWalletService_Expecter{mock: &_m.Mock}
 }
 
+// ArchiveWallet provides a mock function with given fields: ctx, walletID, userID
+func (_m *MockWalletService) ArchiveWallet(ctx context.Context, walletID int64, userID uuid.UUID) error {
+	ret := _m.Called(ctx, walletID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveWallet")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uuid.UUID) error); ok {
+		r0 = rf(ctx, walletID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWalletService_ArchiveWallet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArchiveWallet'
+type MockWalletService_ArchiveWallet_Call struct {
+	*mock.Call
+}
+
+// ArchiveWallet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID int64
+//   - userID uuid.UUID
+func (_e *MockWalletService_Expecter) ArchiveWallet(ctx interface{}, walletID interface{}, userID interface{}) *MockWalletService_ArchiveWallet_Call {
+	return &MockWalletService_ArchiveWallet_Call{Call: _e.mock.On("ArchiveWallet", ctx, walletID, userID)}
+}
+
+func (_c *MockWalletService_ArchiveWallet_Call) Run(run func(ctx context.Context, walletID int64, userID uuid.UUID)) *MockWalletService_ArchiveWallet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockWalletService_ArchiveWallet_Call) Return(_a0 error) *MockWalletService_ArchiveWallet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWalletService_ArchiveWallet_Call) RunAndReturn(run func(context.Context, int64, uuid.UUID) error) *MockWalletService_ArchiveWallet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateWallet provides a mock function with given fields: ctx, req, userID
 func (_m *MockWalletService) CreateWallet(ctx context.Context, req models.CreateWalletRequest, userID uuid.UUID) (*models.Wallet, error) {
 	ret := _m.Called(ctx, req, userID)
@@ -191,6 +239,55 @@ func (_c *MockWalletService_GetUserWallets_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// ReassignAndDeleteWallet provides a mock function with given fields: ctx, walletID, targetWalletID, userID
+func (_m *MockWalletService) ReassignAndDeleteWallet(ctx context.Context, walletID int64, targetWalletID int64, userID uuid.UUID) error {
+	ret := _m.Called(ctx, walletID, targetWalletID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReassignAndDeleteWallet")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, uuid.UUID) error); ok {
+		r0 = rf(ctx, walletID, targetWalletID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWalletService_ReassignAndDeleteWallet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReassignAndDeleteWallet'
+type MockWalletService_ReassignAndDeleteWallet_Call struct {
+	*mock.Call
+}
+
+// ReassignAndDeleteWallet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID int64
+//   - targetWalletID int64
+//   - userID uuid.UUID
+func (_e *MockWalletService_Expecter) ReassignAndDeleteWallet(ctx interface{}, walletID interface{}, targetWalletID interface{}, userID interface{}) *MockWalletService_ReassignAndDeleteWallet_Call {
+	return &MockWalletService_ReassignAndDeleteWallet_Call{Call: _e.mock.On("ReassignAndDeleteWallet", ctx, walletID, targetWalletID, userID)}
+}
+
+func (_c *MockWalletService_ReassignAndDeleteWallet_Call) Run(run func(ctx context.Context, walletID int64, targetWalletID int64, userID uuid.UUID)) *MockWalletService_ReassignAndDeleteWallet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockWalletService_ReassignAndDeleteWallet_Call) Return(_a0 error) *MockWalletService_ReassignAndDeleteWallet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWalletService_ReassignAndDeleteWallet_Call) RunAndReturn(run func(context.Context, int64, int64, uuid.UUID) error) *MockWalletService_ReassignAndDeleteWallet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateWallet provides a mock function with given fields: ctx, walletID, req, userID
 func (_m *MockWalletService) UpdateWallet(ctx context.Context, walletID int64, req models.UpdateWalletRequest, userID uuid.UUID) error {
 	ret := _m.Called(ctx, walletID, req, userID)
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/repository"
+	"github.com/google/uuid"
+)
+
+type NotificationService interface {
+	GetNotifications(ctx context.Context, userID uuid.UUID, query models.NotificationQuery) ([]models.Notification, error)
+	MarkAsRead(ctx context.Context, notificationID int64, userID uuid.UUID) error
+	MarkAllAsRead(ctx context.Context, userID uuid.UUID) (int64, error)
+}
+
+type notificationService struct {
+	notificationRepo repository.NotificationRepository
+}
+
+func NewNotificationService(notificationRepo repository.NotificationRepository) NotificationService {
+	return &notificationService{notificationRepo: notificationRepo}
+}
+
+func (s *notificationService) GetNotifications(ctx context.Context, userID uuid.UUID, query models.NotificationQuery) ([]models.Notification, error) {
+	notifications, err := s.notificationRepo.GetAllByUserID(ctx, userID, query.Unread)
+	if err != nil {
+		return nil, err
+	}
+	if notifications == nil {
+		notifications = []models.Notification{}
+	}
+	return notifications, nil
+}
+
+func (s *notificationService) MarkAsRead(ctx context.Context, notificationID int64, userID uuid.UUID) error {
+	if _, err := s.notificationRepo.CheckOwnership(ctx, notificationID, userID); err != nil {
+		return ErrForbidden
+	}
+
+	return s.notificationRepo.MarkRead(ctx, notificationID)
+}
+
+func (s *notificationService) MarkAllAsRead(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return s.notificationRepo.MarkAllRead(ctx, userID)
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	repoMocks "github.com/Udean777/uang-bijak-go/internal/repository/mocks"
+)
+
+func TestNotificationService_GetNotifications(t *testing.T) {
+	mockNotificationRepo := repoMocks.NewMockNotificationRepository(t)
+	service := NewNotificationService(mockNotificationRepo)
+	ctx := context.Background()
+	testUserID := uuid.New()
+
+	t.Run("Success - Empty List Is Not Nil", func(t *testing.T) {
+		// 1. Setup
+		mockNotificationRepo.EXPECT().GetAllByUserID(ctx, testUserID, true).Return(nil, nil).Once()
+
+		// 2. Act
+		notifications, err := service.GetNotifications(ctx, testUserID, models.NotificationQuery{Unread: true})
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, notifications)
+		assert.Empty(t, notifications)
+	})
+}
+
+func TestNotificationService_MarkAsRead(t *testing.T) {
+	mockNotificationRepo := repoMocks.NewMockNotificationRepository(t)
+	service := NewNotificationService(mockNotificationRepo)
+	ctx := context.Background()
+	testUserID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// 1. Setup
+		mockNotificationRepo.EXPECT().CheckOwnership(ctx, int64(1), testUserID).Return(&models.Notification{ID: 1}, nil).Once()
+		mockNotificationRepo.EXPECT().MarkRead(ctx, int64(1)).Return(nil).Once()
+
+		// 2. Act
+		err := service.MarkAsRead(ctx, 1, testUserID)
+
+		// 3. Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fail - Forbidden", func(t *testing.T) {
+		// 1. Setup
+		mockNotificationRepo.EXPECT().CheckOwnership(ctx, int64(2), testUserID).Return(nil, errors.New("not found")).Once()
+
+		// 2. Act
+		err := service.MarkAsRead(ctx, 2, testUserID)
+
+		// 3. Assert
+		assert.Equal(t, ErrForbidden, err)
+	})
+}
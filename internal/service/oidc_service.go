@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/oidc"
+	"github.com/Udean777/uang-bijak-go/internal/repository"
+)
+
+var (
+	ErrUnknownOIDCProvider     = errors.New("unknown identity provider")
+	ErrOIDCProviderUnavailable = errors.New("identity provider unavailable")
+	ErrInvalidOIDCState        = errors.New("invalid or expired login state")
+	ErrOIDCLoginFailed         = errors.New("identity provider login failed")
+	ErrOIDCEmailNotVerified    = errors.New("identity provider did not return a verified email")
+	// ErrOIDCAccountNotLinkable: email sudah terdaftar tetapi belum diverifikasi, sehingga
+	// belum terbukti milik orang yang sama dengan pemilik akun di provider
+	ErrOIDCAccountNotLinkable = errors.New("an account with this email exists but its email is not verified; sign in with your password and verify it first")
+)
+
+type OIDCService interface {
+	StartLogin(ctx context.Context, provider string) (*models.OIDCAuthorization, error)
+	CompleteLogin(ctx context.Context, provider, code, state string, meta models.SessionMetadata) (*models.LoginResult, error)
+	PruneLoginStates(ctx context.Context, now time.Time) (int, error)
+}
+
+type oidcService struct {
+	providers        map[string]*oidc.Provider
+	oidcRepo         repository.OIDCRepository
+	userRepo         repository.UserRepository
+	authService      AuthService
+	categoryTemplate models.CategoryTemplate
+	stateTTL         time.Duration
+	now              func() time.Time
+}
+
+func NewOIDCService(providers []*oidc.Provider, oidcRepo repository.OIDCRepository, userRepo repository.UserRepository, authService AuthService, categoryTemplate models.CategoryTemplate, stateTTL time.Duration) OIDCService {
+	byName := make(map[string]*oidc.Provider, len(providers))
+	for _, p := range providers {
+		byName[p.Name()] = p
+	}
+	return &oidcService{
+		providers:        byName,
+		oidcRepo:         oidcRepo,
+		userRepo:         userRepo,
+		authService:      authService,
+		categoryTemplate: categoryTemplate,
+		stateTTL:         stateTTL,
+		now:              time.Now,
+	}
+}
+
+// StartLogin menyiapkan authorization request: state, nonce dan code_verifier PKCE disimpan
+// di server (state hanya sebagai hash), sedangkan code_challenge-nya dikirim ke provider.
+func (s *oidcService) StartLogin(ctx context.Context, providerName string) (*models.OIDCAuthorization, error) {
+	provider, ok := s.providers[providerName]
+	if !ok {
+		return nil, ErrUnknownOIDCProvider
+	}
+
+	state, err := generateOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := generateOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+	verifier, err := oidc.NewCodeVerifier()
+	if err != nil {
+		return nil, err
+	}
+
+	authURL, err := provider.AuthCodeURL(ctx, state, nonce, oidc.CodeChallengeS256(verifier))
+	if err != nil {
+		log.Printf("Gagal menghubungi identity provider %s: %v", providerName, err)
+		return nil, ErrOIDCProviderUnavailable
+	}
+
+	err = s.oidcRepo.CreateLoginState(ctx, &models.OIDCLoginState{
+		StateHash:    hashToken(state),
+		Provider:     providerName,
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		ExpiresAt:    s.now().Add(s.stateTTL),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.OIDCAuthorization{AuthorizationURL: authURL, State: state}, nil
+}
+
+// CompleteLogin menukar code dari callback provider lalu login sebagai user yang tertaut ke
+// identitas tersebut. Identitas baru ditautkan ke user dengan email yang sama hanya jika
+// provider dan akun lokal sama-sama menyatakan email itu terverifikasi; jika belum ada user
+// dengan email tersebut, user baru dibuat tanpa password.
+func (s *oidcService) CompleteLogin(ctx context.Context, providerName, code, state string, meta models.SessionMetadata) (*models.LoginResult, error) {
+	provider, ok := s.providers[providerName]
+	if !ok {
+		return nil, ErrUnknownOIDCProvider
+	}
+
+	loginState, err := s.oidcRepo.ConsumeLoginState(ctx, hashToken(state), providerName)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrInvalidOIDCState
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	idToken, err := provider.Exchange(ctx, code, loginState.CodeVerifier, loginState.Nonce)
+	if err != nil {
+		var tokenErr *oidc.TokenError
+		if errors.As(err, &tokenErr) || errors.Is(err, oidc.ErrInvalidIDToken) {
+			log.Printf("Login OIDC %s ditolak: %v", providerName, err)
+			return nil, ErrOIDCLoginFailed
+		}
+		log.Printf("Gagal menghubungi identity provider %s: %v", providerName, err)
+		return nil, ErrOIDCProviderUnavailable
+	}
+
+	user, err := s.resolveUser(ctx, providerName, idToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.authService.LoginExternal(ctx, user, meta)
+}
+
+// resolveUser mencari user pemilik identitas, menautkan identitas ke user dengan email
+// terverifikasi yang sama, atau membuat user baru.
+func (s *oidcService) resolveUser(ctx context.Context, providerName string, idToken *oidc.IDToken) (*models.User, error) {
+	identity, err := s.oidcRepo.GetIdentity(ctx, providerName, idToken.Subject)
+	if err == nil {
+		if err := s.oidcRepo.TouchIdentity(ctx, identity.ID); err != nil {
+			return nil, err
+		}
+		return s.userRepo.GetUserByID(ctx, identity.UserID)
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	if idToken.Email == "" || !idToken.EmailVerified {
+		return nil, ErrOIDCEmailNotVerified
+	}
+
+	user, err := s.userRepo.GetUserByEmail(ctx, idToken.Email)
+	switch {
+	case err == nil:
+		// Email lokal yang belum diverifikasi bisa saja didaftarkan orang lain; menautkannya
+		// akan membuat pendaftar itu tetap bisa login dengan password ke akun pemilik email
+		if !user.EmailVerified() {
+			return nil, ErrOIDCAccountNotLinkable
+		}
+	case errors.Is(err, pgx.ErrNoRows):
+		user, err = s.createUser(ctx, idToken)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+
+	// Jika langkah ini gagal setelah user baru dibuat, login berikutnya akan menautkan
+	// identitas lewat email yang sudah terverifikasi
+	err = s.oidcRepo.CreateIdentity(ctx, &models.UserIdentity{
+		UserID:   user.ID,
+		Provider: providerName,
+		Subject:  idToken.Subject,
+		Email:    idToken.Email,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// createUser membuat user dari identitas provider. User tidak punya password (hash kosong
+// tidak pernah cocok), tetapi bisa membuatnya lewat lupa password.
+func (s *oidcService) createUser(ctx context.Context, idToken *oidc.IDToken) (*models.User, error) {
+	name := strings.TrimSpace(idToken.Name)
+	if name == "" {
+		name, _, _ = strings.Cut(idToken.Email, "@")
+	}
+	locale, _, _ := strings.Cut(strings.ToLower(idToken.Locale), "-")
+
+	user := &models.User{Name: name, Email: idToken.Email}
+	id, err := s.userRepo.CreateUser(ctx, user, s.categoryTemplate.Build(locale))
+	if err != nil {
+		return nil, err
+	}
+	user.ID = id
+
+	if _, err := s.userRepo.MarkEmailVerified(ctx, user.ID, user.Email); err != nil {
+		return nil, fmt.Errorf("mark email verified: %w", err)
+	}
+	verifiedAt := s.now()
+	user.EmailVerifiedAt = &verifiedAt
+
+	return user, nil
+}
+
+// PruneLoginStates menghapus state login yang kedaluwarsa; dipanggil berkala oleh worker.
+func (s *oidcService) PruneLoginStates(ctx context.Context, now time.Time) (int, error) {
+	return s.oidcRepo.DeleteExpiredLoginStates(ctx, now)
+}
@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/oidc"
+	"github.com/Udean777/uang-bijak-go/internal/oidc/oidctest"
+	mocks "github.com/Udean777/uang-bijak-go/internal/repository/mocks"
+	serviceMocks "github.com/Udean777/uang-bijak-go/internal/service/mocks"
+)
+
+type oidcServiceMocks struct {
+	provider *oidctest.Provider
+	oidcRepo *mocks.MockOIDCRepository
+	userRepo *mocks.MockUserRepository
+	auth     *serviceMocks.MockAuthService
+}
+
+func setupOIDCService(t *testing.T) (OIDCService, oidcServiceMocks) {
+	m := oidcServiceMocks{
+		provider: oidctest.NewProvider(t),
+		oidcRepo: mocks.NewMockOIDCRepository(t),
+		userRepo: mocks.NewMockUserRepository(t),
+		auth:     serviceMocks.NewMockAuthService(t),
+	}
+
+	provider := oidc.NewProvider(oidc.Config{
+		Name:         "mock",
+		IssuerURL:    m.provider.Issuer(),
+		ClientID:     oidctest.ClientID,
+		ClientSecret: oidctest.ClientSecret,
+		RedirectURL:  "http://localhost:3000/auth/oidc/mock/callback",
+	}, nil)
+
+	service := NewOIDCService([]*oidc.Provider{provider}, m.oidcRepo, m.userRepo, m.auth, models.DefaultCategoryTemplate, 10*time.Minute)
+	return service, m
+}
+
+// authorizeAtProvider menjalankan StartLogin lalu "login" di provider tiruan sebagai user,
+// dan menyiapkan ConsumeLoginState untuk mengembalikan state yang tersimpan.
+func authorizeAtProvider(t *testing.T, service OIDCService, m oidcServiceMocks, user oidctest.User) (code string, state string) {
+	ctx := context.Background()
+
+	var saved *models.OIDCLoginState
+	m.oidcRepo.EXPECT().
+		CreateLoginState(ctx, mock.AnythingOfType("*models.OIDCLoginState")).
+		Run(func(ctx context.Context, s *models.OIDCLoginState) { saved = s }).
+		Return(nil).
+		Once()
+
+	authorization, err := service.StartLogin(ctx, "mock")
+	assert.NoError(t, err)
+
+	code, state, err = m.provider.Authorize(authorization.AuthorizationURL, user)
+	assert.NoError(t, err)
+	assert.Equal(t, authorization.State, state)
+
+	m.oidcRepo.EXPECT().ConsumeLoginState(ctx, hashToken(state), "mock").Return(saved, nil).Once()
+	return code, state
+}
+
+func TestOIDCService_StartLogin(t *testing.T) {
+	service, m := setupOIDCService(t)
+	ctx := context.Background()
+
+	t.Run("Success - Stores Hashed State With PKCE Verifier", func(t *testing.T) {
+		// 1. Setup
+		var saved *models.OIDCLoginState
+		m.oidcRepo.EXPECT().
+			CreateLoginState(ctx, mock.AnythingOfType("*models.OIDCLoginState")).
+			Run(func(ctx context.Context, s *models.OIDCLoginState) { saved = s }).
+			Return(nil).
+			Once()
+
+		// 2. Act
+		authorization, err := service.StartLogin(ctx, "mock")
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.NotEmpty(t, authorization.State)
+		assert.Equal(t, hashToken(authorization.State), saved.StateHash)
+		assert.Equal(t, "mock", saved.Provider)
+		assert.Contains(t, authorization.AuthorizationURL, "code_challenge="+oidc.CodeChallengeS256(saved.CodeVerifier))
+		assert.Contains(t, authorization.AuthorizationURL, "nonce="+saved.Nonce)
+		assert.NotContains(t, authorization.AuthorizationURL, saved.CodeVerifier)
+	})
+
+	t.Run("Unknown Provider", func(t *testing.T) {
+		// 2. Act
+		_, err := service.StartLogin(ctx, "tidak-ada")
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrUnknownOIDCProvider)
+	})
+}
+
+func TestOIDCService_CompleteLogin(t *testing.T) {
+	ctx := context.Background()
+	testMeta := models.SessionMetadata{Device: "Chrome"}
+	verifiedAt := time.Now()
+	providerUser := oidctest.User{Subject: "sub-123", Email: "budi@example.com", EmailVerified: true, Name: "Budi"}
+	loginResult := &models.LoginResult{AccessToken: "access", RefreshToken: "refresh"}
+
+	t.Run("Existing Identity", func(t *testing.T) {
+		// 1. Setup
+		service, m := setupOIDCService(t)
+		code, state := authorizeAtProvider(t, service, m, providerUser)
+
+		user := &models.User{ID: uuid.New(), Email: "email-lama@example.com", EmailVerifiedAt: &verifiedAt}
+		m.oidcRepo.EXPECT().GetIdentity(ctx, "mock", "sub-123").Return(&models.UserIdentity{ID: 7, UserID: user.ID}, nil).Once()
+		m.oidcRepo.EXPECT().TouchIdentity(ctx, int64(7)).Return(nil).Once()
+		m.userRepo.EXPECT().GetUserByID(ctx, user.ID).Return(user, nil).Once()
+		m.auth.EXPECT().LoginExternal(ctx, user, testMeta).Return(loginResult, nil).Once()
+
+		// 2. Act
+		result, err := service.CompleteLogin(ctx, "mock", code, state, testMeta)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Equal(t, loginResult, result)
+	})
+
+	t.Run("Links To Existing User With Verified Email", func(t *testing.T) {
+		// 1. Setup
+		service, m := setupOIDCService(t)
+		code, state := authorizeAtProvider(t, service, m, providerUser)
+
+		user := &models.User{ID: uuid.New(), Email: "budi@example.com", EmailVerifiedAt: &verifiedAt}
+		m.oidcRepo.EXPECT().GetIdentity(ctx, "mock", "sub-123").Return(nil, pgx.ErrNoRows).Once()
+		m.userRepo.EXPECT().GetUserByEmail(ctx, "budi@example.com").Return(user, nil).Once()
+
+		var linked *models.UserIdentity
+		m.oidcRepo.EXPECT().
+			CreateIdentity(ctx, mock.AnythingOfType("*models.UserIdentity")).
+			Run(func(ctx context.Context, i *models.UserIdentity) { linked = i }).
+			Return(nil).
+			Once()
+		m.auth.EXPECT().LoginExternal(ctx, user, testMeta).Return(loginResult, nil).Once()
+
+		// 2. Act
+		result, err := service.CompleteLogin(ctx, "mock", code, state, testMeta)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Equal(t, loginResult, result)
+		assert.Equal(t, user.ID, linked.UserID)
+		assert.Equal(t, "mock", linked.Provider)
+		assert.Equal(t, "sub-123", linked.Subject)
+	})
+
+	t.Run("Refuses To Link Unverified Local Account", func(t *testing.T) {
+		// 1. Setup
+		service, m := setupOIDCService(t)
+		code, state := authorizeAtProvider(t, service, m, providerUser)
+
+		user := &models.User{ID: uuid.New(), Email: "budi@example.com"}
+		m.oidcRepo.EXPECT().GetIdentity(ctx, "mock", "sub-123").Return(nil, pgx.ErrNoRows).Once()
+		m.userRepo.EXPECT().GetUserByEmail(ctx, "budi@example.com").Return(user, nil).Once()
+
+		// 2. Act
+		result, err := service.CompleteLogin(ctx, "mock", code, state, testMeta)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrOIDCAccountNotLinkable)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Creates New Verified User", func(t *testing.T) {
+		// 1. Setup
+		service, m := setupOIDCService(t)
+		code, state := authorizeAtProvider(t, service, m, providerUser)
+
+		newID := uuid.New()
+		m.oidcRepo.EXPECT().GetIdentity(ctx, "mock", "sub-123").Return(nil, pgx.ErrNoRows).Once()
+		m.userRepo.EXPECT().GetUserByEmail(ctx, "budi@example.com").Return(nil, pgx.ErrNoRows).Once()
+
+		var created *models.User
+		m.userRepo.EXPECT().
+			CreateUser(ctx, mock.AnythingOfType("*models.User"), mock.AnythingOfType("[]models.Category")).
+			Run(func(ctx context.Context, u *models.User, categories []models.Category) { created = u }).
+			Return(newID, nil).
+			Once()
+		m.userRepo.EXPECT().MarkEmailVerified(ctx, newID, "budi@example.com").Return(true, nil).Once()
+		m.oidcRepo.EXPECT().CreateIdentity(ctx, mock.AnythingOfType("*models.UserIdentity")).Return(nil).Once()
+		m.auth.EXPECT().
+			LoginExternal(ctx, mock.MatchedBy(func(u *models.User) bool { return u.ID == newID && u.EmailVerified() }), testMeta).
+			Return(loginResult, nil).
+			Once()
+
+		// 2. Act
+		result, err := service.CompleteLogin(ctx, "mock", code, state, testMeta)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Equal(t, loginResult, result)
+		assert.Equal(t, "Budi", created.Name)
+		assert.Empty(t, created.PasswordHash)
+	})
+
+	t.Run("Provider Email Not Verified", func(t *testing.T) {
+		// 1. Setup
+		service, m := setupOIDCService(t)
+		unverified := providerUser
+		unverified.EmailVerified = false
+		code, state := authorizeAtProvider(t, service, m, unverified)
+
+		m.oidcRepo.EXPECT().GetIdentity(ctx, "mock", "sub-123").Return(nil, pgx.ErrNoRows).Once()
+
+		// 2. Act
+		_, err := service.CompleteLogin(ctx, "mock", code, state, testMeta)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrOIDCEmailNotVerified)
+	})
+
+	t.Run("Invalid Or Reused State", func(t *testing.T) {
+		// 1. Setup
+		service, m := setupOIDCService(t)
+		m.oidcRepo.EXPECT().ConsumeLoginState(ctx, hashToken("state-palsu"), "mock").Return(nil, pgx.ErrNoRows).Once()
+
+		// 2. Act
+		_, err := service.CompleteLogin(ctx, "mock", "code", "state-palsu", testMeta)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrInvalidOIDCState)
+	})
+
+	t.Run("Code Rejected By Provider", func(t *testing.T) {
+		// 1. Setup
+		service, m := setupOIDCService(t)
+		_, state := authorizeAtProvider(t, service, m, providerUser)
+
+		// 2. Act
+		_, err := service.CompleteLogin(ctx, "mock", "code-palsu", state, testMeta)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrOIDCLoginFailed)
+	})
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/Udean777/uang-bijak-go/internal/mailer"
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/repository"
+)
+
+var (
+	ErrWrongPassword     = errors.New("current password is incorrect")
+	ErrInvalidResetToken = errors.New("invalid or expired reset token")
+)
+
+type PasswordService interface {
+	ChangePassword(ctx context.Context, userID uuid.UUID, currentSessionID uuid.UUID, req models.ChangePasswordRequest) error
+	ForgotPassword(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, req models.ResetPasswordRequest) error
+}
+
+type passwordService struct {
+	userRepo      repository.UserRepository
+	sessionRepo   repository.SessionRepository
+	patRepo       repository.PersonalAccessTokenRepository
+	resetRepo     repository.PasswordResetRepository
+	mailer        mailer.Mailer
+	appBaseURL    string
+	resetTokenTTL time.Duration
+}
+
+func NewPasswordService(userRepo repository.UserRepository, sessionRepo repository.SessionRepository, patRepo repository.PersonalAccessTokenRepository, resetRepo repository.PasswordResetRepository, m mailer.Mailer, appBaseURL string, resetTokenTTL time.Duration) PasswordService {
+	return &passwordService{
+		userRepo:      userRepo,
+		sessionRepo:   sessionRepo,
+		patRepo:       patRepo,
+		resetRepo:     resetRepo,
+		mailer:        m,
+		appBaseURL:    appBaseURL,
+		resetTokenTTL: resetTokenTTL,
+	}
+}
+
+// ChangePassword mengganti password setelah memverifikasi password lama, lalu mencabut semua
+// sesi lain dan personal access token sehingga perangkat atau skrip yang mungkin dikuasai
+// orang lain ikut kehilangan akses.
+func (s *passwordService) ChangePassword(ctx context.Context, userID uuid.UUID, currentSessionID uuid.UUID, req models.ChangePasswordRequest) error {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.CurrentPassword)); err != nil {
+		return ErrWrongPassword
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	if err := s.userRepo.UpdatePassword(ctx, userID, string(hashedPassword)); err != nil {
+		return err
+	}
+
+	if err := s.sessionRepo.RevokeOthers(ctx, userID, currentSessionID); err != nil {
+		return err
+	}
+
+	return s.patRepo.RevokeAllByUserID(ctx, userID)
+}
+
+// ForgotPassword mengirim link reset ke email jika terdaftar. Hasilnya selalu nil untuk email
+// yang tidak dikenal agar endpoint tidak bisa dipakai menebak email terdaftar.
+func (s *passwordService) ForgotPassword(ctx context.Context, email string) error {
+	user, err := s.userRepo.GetUserByEmail(ctx, email)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	token, err := generateOpaqueToken()
+	if err != nil {
+		return err
+	}
+
+	err = s.resetRepo.Create(ctx, &models.PasswordResetToken{
+		UserID:    user.ID,
+		TokenHash: hashToken(token),
+		ExpiresAt: time.Now().Add(s.resetTokenTTL),
+	})
+	if err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf("%s/reset-password?token=%s", s.appBaseURL, url.QueryEscape(token))
+	msg := mailer.Message{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Hi %s,\n\nOpen the link below to choose a new password. The link expires in %d minutes and can only be used once.\n\n%s\n\nIf you did not request this, you can ignore this email.\n",
+			user.Name, int(s.resetTokenTTL.Minutes()), link),
+	}
+
+	// Kegagalan kirim hanya dicatat; membalas error di sini akan membocorkan bahwa email terdaftar
+	if err := s.mailer.Send(ctx, msg); err != nil {
+		log.Printf("Gagal mengirim email reset password ke user %s: %v", user.ID, err)
+	}
+	return nil
+}
+
+// ResetPassword memakai token reset (sekali pakai) untuk menyetel password baru, lalu mencabut
+// semua sesi dan personal access token user.
+func (s *passwordService) ResetPassword(ctx context.Context, req models.ResetPasswordRequest) error {
+	token, err := s.resetRepo.Consume(ctx, hashToken(req.Token))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrInvalidResetToken
+	}
+	if err != nil {
+		return err
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	if err := s.userRepo.UpdatePassword(ctx, token.UserID, string(hashedPassword)); err != nil {
+		return err
+	}
+
+	if err := s.sessionRepo.RevokeAllByUserID(ctx, token.UserID); err != nil {
+		return err
+	}
+
+	return s.patRepo.RevokeAllByUserID(ctx, token.UserID)
+}
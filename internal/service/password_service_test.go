@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/Udean777/uang-bijak-go/internal/mailer"
+	mailerMocks "github.com/Udean777/uang-bijak-go/internal/mailer/mocks"
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	repoMocks "github.com/Udean777/uang-bijak-go/internal/repository/mocks"
+)
+
+type passwordServiceMocks struct {
+	userRepo    *repoMocks.MockUserRepository
+	sessionRepo *repoMocks.MockSessionRepository
+	patRepo     *repoMocks.MockPersonalAccessTokenRepository
+	resetRepo   *repoMocks.MockPasswordResetRepository
+	mailer      *mailerMocks.MockMailer
+}
+
+func setupPasswordService(t *testing.T) (PasswordService, passwordServiceMocks) {
+	m := passwordServiceMocks{
+		userRepo:    repoMocks.NewMockUserRepository(t),
+		sessionRepo: repoMocks.NewMockSessionRepository(t),
+		patRepo:     repoMocks.NewMockPersonalAccessTokenRepository(t),
+		resetRepo:   repoMocks.NewMockPasswordResetRepository(t),
+		mailer:      mailerMocks.NewMockMailer(t),
+	}
+	service := NewPasswordService(m.userRepo, m.sessionRepo, m.patRepo, m.resetRepo, m.mailer, "https://app.uangbijak.test", time.Hour)
+	return service, m
+}
+
+func TestPasswordService_ChangePassword(t *testing.T) {
+	service, m := setupPasswordService(t)
+	ctx := context.Background()
+
+	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte("old-password"), bcrypt.DefaultCost)
+	testUser := &models.User{ID: uuid.New(), PasswordHash: string(hashedPassword)}
+	currentSessionID := uuid.New()
+
+	t.Run("Success - Revokes Other Sessions And Access Tokens", func(t *testing.T) {
+		// 1. Setup
+		m.userRepo.EXPECT().GetUserByID(ctx, testUser.ID).Return(testUser, nil).Once()
+		m.userRepo.EXPECT().
+			UpdatePassword(ctx, testUser.ID, mock.MatchedBy(func(hash string) bool {
+				return bcrypt.CompareHashAndPassword([]byte(hash), []byte("new-password")) == nil
+			})).
+			Return(nil).
+			Once()
+		m.sessionRepo.EXPECT().RevokeOthers(ctx, testUser.ID, currentSessionID).Return(nil).Once()
+		m.patRepo.EXPECT().RevokeAllByUserID(ctx, testUser.ID).Return(nil).Once()
+
+		// 2. Act
+		err := service.ChangePassword(ctx, testUser.ID, currentSessionID, models.ChangePasswordRequest{
+			CurrentPassword: "old-password",
+			NewPassword:     "new-password",
+		})
+
+		// 3. Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fail - Wrong Current Password", func(t *testing.T) {
+		// 1. Setup
+		m.userRepo.EXPECT().GetUserByID(ctx, testUser.ID).Return(testUser, nil).Once()
+
+		// 2. Act
+		err := service.ChangePassword(ctx, testUser.ID, currentSessionID, models.ChangePasswordRequest{
+			CurrentPassword: "wrong",
+			NewPassword:     "new-password",
+		})
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrWrongPassword)
+	})
+}
+
+func TestPasswordService_ForgotPassword(t *testing.T) {
+	service, m := setupPasswordService(t)
+	ctx := context.Background()
+	testUser := &models.User{ID: uuid.New(), Name: "Budi", Email: "budi@example.com"}
+
+	t.Run("Success - Sends Reset Link", func(t *testing.T) {
+		// 1. Setup
+		var stored *models.PasswordResetToken
+		m.userRepo.EXPECT().GetUserByEmail(ctx, "budi@example.com").Return(testUser, nil).Once()
+		m.resetRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*models.PasswordResetToken")).
+			Run(func(ctx context.Context, token *models.PasswordResetToken) { stored = token }).
+			Return(nil).
+			Once()
+
+		var sent mailer.Message
+		m.mailer.EXPECT().
+			Send(ctx, mock.AnythingOfType("mailer.Message")).
+			Run(func(ctx context.Context, msg mailer.Message) { sent = msg }).
+			Return(nil).
+			Once()
+
+		// 2. Act
+		err := service.ForgotPassword(ctx, "budi@example.com")
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "budi@example.com", sent.To)
+		assert.Equal(t, testUser.ID, stored.UserID)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), stored.ExpiresAt, time.Minute)
+
+		// Link berisi token asli, database hanya menyimpan hash-nya
+		idx := strings.Index(sent.Body, "https://app.uangbijak.test/reset-password?token=")
+		assert.GreaterOrEqual(t, idx, 0)
+		token := strings.Fields(sent.Body[idx+len("https://app.uangbijak.test/reset-password?token="):])[0]
+		assert.Equal(t, hashToken(token), stored.TokenHash)
+	})
+
+	t.Run("Unknown Email Is Silent", func(t *testing.T) {
+		// 1. Setup
+		m.userRepo.EXPECT().GetUserByEmail(ctx, "ghost@example.com").Return(nil, pgx.ErrNoRows).Once()
+
+		// 2. Act
+		err := service.ForgotPassword(ctx, "ghost@example.com")
+
+		// 3. Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Mailer Failure Is Not Returned", func(t *testing.T) {
+		// 1. Setup
+		m.userRepo.EXPECT().GetUserByEmail(ctx, "budi@example.com").Return(testUser, nil).Once()
+		m.resetRepo.EXPECT().Create(ctx, mock.AnythingOfType("*models.PasswordResetToken")).Return(nil).Once()
+		m.mailer.EXPECT().Send(ctx, mock.AnythingOfType("mailer.Message")).Return(errors.New("smtp down")).Once()
+
+		// 2. Act
+		err := service.ForgotPassword(ctx, "budi@example.com")
+
+		// 3. Assert
+		assert.NoError(t, err)
+	})
+}
+
+func TestPasswordService_ResetPassword(t *testing.T) {
+	service, m := setupPasswordService(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+
+	t.Run("Success - Revokes All Sessions And Access Tokens", func(t *testing.T) {
+		// 1. Setup
+		m.resetRepo.EXPECT().
+			Consume(ctx, hashToken("reset-token")).
+			Return(&models.PasswordResetToken{UserID: testUserID}, nil).
+			Once()
+		m.userRepo.EXPECT().UpdatePassword(ctx, testUserID, mock.AnythingOfType("string")).Return(nil).Once()
+		m.sessionRepo.EXPECT().RevokeAllByUserID(ctx, testUserID).Return(nil).Once()
+		m.patRepo.EXPECT().RevokeAllByUserID(ctx, testUserID).Return(nil).Once()
+
+		// 2. Act
+		err := service.ResetPassword(ctx, models.ResetPasswordRequest{Token: "reset-token", NewPassword: "new-password"})
+
+		// 3. Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fail - Used Or Expired Token", func(t *testing.T) {
+		// 1. Setup
+		m.resetRepo.EXPECT().Consume(ctx, hashToken("used-token")).Return(nil, pgx.ErrNoRows).Once()
+
+		// 2. Act
+		err := service.ResetPassword(ctx, models.ResetPasswordRequest{Token: "used-token", NewPassword: "new-password"})
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrInvalidResetToken)
+	})
+}
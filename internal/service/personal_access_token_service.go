@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/repository"
+)
+
+var ErrInvalidScope = errors.New("invalid token scope")
+
+// tokenTouchInterval membatasi seberapa sering last_used_at diperbarui, agar token yang
+// dipakai script dengan intensif tidak menulis ke database di setiap request.
+const tokenTouchInterval = time.Minute
+
+type PersonalAccessTokenService interface {
+	CreateToken(ctx context.Context, userID uuid.UUID, req models.CreatePersonalAccessTokenRequest) (*models.PersonalAccessToken, string, error)
+	GetTokens(ctx context.Context, userID uuid.UUID) ([]models.PersonalAccessToken, error)
+	RevokeToken(ctx context.Context, tokenID uuid.UUID, userID uuid.UUID) error
+	AuthenticateToken(ctx context.Context, token string) (*models.TokenPrincipal, error)
+}
+
+type personalAccessTokenService struct {
+	tokenRepo repository.PersonalAccessTokenRepository
+	userRepo  repository.UserRepository
+	now       func() time.Time
+}
+
+func NewPersonalAccessTokenService(tokenRepo repository.PersonalAccessTokenRepository, userRepo repository.UserRepository) PersonalAccessTokenService {
+	return &personalAccessTokenService{tokenRepo: tokenRepo, userRepo: userRepo, now: time.Now}
+}
+
+// CreateToken membuat token baru dan mengembalikan nilai aslinya. Nilai ini hanya tersedia
+// sekali; yang disimpan hanya hash-nya.
+func (s *personalAccessTokenService) CreateToken(ctx context.Context, userID uuid.UUID, req models.CreatePersonalAccessTokenRequest) (*models.PersonalAccessToken, string, error) {
+	scopes := make([]string, 0, len(req.Scopes))
+	for _, scope := range req.Scopes {
+		if !models.IsValidScope(scope) {
+			return nil, "", fmt.Errorf("%w: %q", ErrInvalidScope, scope)
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	secret, err := generateOpaqueToken()
+	if err != nil {
+		return nil, "", err
+	}
+	plain := models.PersonalAccessTokenPrefix + secret
+
+	token := &models.PersonalAccessToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      strings.TrimSpace(req.Name),
+		TokenHash: hashToken(plain),
+		Scopes:    scopes,
+	}
+	if req.ExpiresInDays != nil {
+		expiresAt := s.now().AddDate(0, 0, *req.ExpiresInDays)
+		token.ExpiresAt = &expiresAt
+	}
+
+	if err := s.tokenRepo.Create(ctx, token); err != nil {
+		return nil, "", err
+	}
+	return token, plain, nil
+}
+
+func (s *personalAccessTokenService) GetTokens(ctx context.Context, userID uuid.UUID) ([]models.PersonalAccessToken, error) {
+	tokens, err := s.tokenRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if tokens == nil {
+		tokens = []models.PersonalAccessToken{}
+	}
+	return tokens, nil
+}
+
+func (s *personalAccessTokenService) RevokeToken(ctx context.Context, tokenID uuid.UUID, userID uuid.UUID) error {
+	if _, err := s.tokenRepo.CheckOwnership(ctx, tokenID, userID); err != nil {
+		return ErrForbidden
+	}
+
+	return s.tokenRepo.Revoke(ctx, tokenID)
+}
+
+// AuthenticateToken dipakai AuthMiddleware. Mengembalikan nil (tanpa error) jika token tidak
+// dikenal, sudah dicabut, atau kedaluwarsa.
+func (s *personalAccessTokenService) AuthenticateToken(ctx context.Context, token string) (*models.TokenPrincipal, error) {
+	stored, err := s.tokenRepo.GetActiveByHash(ctx, hashToken(token))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.GetUserByID(ctx, stored.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if stored.LastUsedAt == nil || s.now().Sub(*stored.LastUsedAt) >= tokenTouchInterval {
+		if err := s.tokenRepo.Touch(ctx, stored.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return &models.TokenPrincipal{
+		UserID:        stored.UserID,
+		TokenID:       stored.ID,
+		Scopes:        stored.Scopes,
+		EmailVerified: user.EmailVerified(),
+	}, nil
+}
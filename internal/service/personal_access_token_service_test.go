@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	repoMocks "github.com/Udean777/uang-bijak-go/internal/repository/mocks"
+)
+
+type personalAccessTokenMocks struct {
+	tokenRepo *repoMocks.MockPersonalAccessTokenRepository
+	userRepo  *repoMocks.MockUserRepository
+}
+
+var patTestNow = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func setupPersonalAccessTokenService(t *testing.T) (PersonalAccessTokenService, personalAccessTokenMocks) {
+	m := personalAccessTokenMocks{
+		tokenRepo: repoMocks.NewMockPersonalAccessTokenRepository(t),
+		userRepo:  repoMocks.NewMockUserRepository(t),
+	}
+	service := NewPersonalAccessTokenService(m.tokenRepo, m.userRepo).(*personalAccessTokenService)
+	service.now = func() time.Time { return patTestNow }
+	return service, m
+}
+
+func TestPersonalAccessTokenService_CreateToken(t *testing.T) {
+	service, m := setupPersonalAccessTokenService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+
+	t.Run("Success - Stores Only The Hash", func(t *testing.T) {
+		// 1. Setup
+		var stored *models.PersonalAccessToken
+		m.tokenRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*models.PersonalAccessToken")).
+			Run(func(ctx context.Context, token *models.PersonalAccessToken) { stored = token }).
+			Return(nil).
+			Once()
+
+		days := 30
+		req := models.CreatePersonalAccessTokenRequest{
+			Name:          " backup script ",
+			Scopes:        []string{"read", "transactions:write", "read"},
+			ExpiresInDays: &days,
+		}
+
+		// 2. Act
+		token, plain, err := service.CreateToken(ctx, userID, req)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(plain, models.PersonalAccessTokenPrefix))
+		assert.Equal(t, hashToken(plain), stored.TokenHash)
+		assert.NotContains(t, stored.TokenHash, plain)
+		assert.Equal(t, userID, stored.UserID)
+		assert.Equal(t, "backup script", token.Name)
+		assert.Equal(t, []string{"read", "transactions:write"}, token.Scopes)
+		assert.Equal(t, patTestNow.AddDate(0, 0, 30), *token.ExpiresAt)
+	})
+
+	t.Run("Fail - Unknown Scope", func(t *testing.T) {
+		// 2. Act
+		_, _, err := service.CreateToken(ctx, userID, models.CreatePersonalAccessTokenRequest{
+			Name:   "admin",
+			Scopes: []string{"read", "admin"},
+		})
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrInvalidScope)
+	})
+}
+
+func TestPersonalAccessTokenService_RevokeToken(t *testing.T) {
+	service, m := setupPersonalAccessTokenService(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	tokenID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// 1. Setup
+		m.tokenRepo.EXPECT().CheckOwnership(ctx, tokenID, userID).Return(&models.PersonalAccessToken{ID: tokenID}, nil).Once()
+		m.tokenRepo.EXPECT().Revoke(ctx, tokenID).Return(nil).Once()
+
+		// 2. Act
+		err := service.RevokeToken(ctx, tokenID, userID)
+
+		// 3. Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fail - Not Owner", func(t *testing.T) {
+		// 1. Setup
+		m.tokenRepo.EXPECT().CheckOwnership(ctx, tokenID, userID).Return(nil, pgx.ErrNoRows).Once()
+
+		// 2. Act
+		err := service.RevokeToken(ctx, tokenID, userID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
+
+func TestPersonalAccessTokenService_AuthenticateToken(t *testing.T) {
+	service, m := setupPersonalAccessTokenService(t)
+	ctx := context.Background()
+
+	verifiedAt := patTestNow.Add(-48 * time.Hour)
+	testUser := &models.User{ID: uuid.New(), EmailVerifiedAt: &verifiedAt}
+	plain := models.PersonalAccessTokenPrefix + "secret"
+
+	t.Run("Success - Touches Stale Token", func(t *testing.T) {
+		// 1. Setup
+		stored := &models.PersonalAccessToken{ID: uuid.New(), UserID: testUser.ID, Scopes: []string{"read"}}
+		m.tokenRepo.EXPECT().GetActiveByHash(ctx, hashToken(plain)).Return(stored, nil).Once()
+		m.userRepo.EXPECT().GetUserByID(ctx, testUser.ID).Return(testUser, nil).Once()
+		m.tokenRepo.EXPECT().Touch(ctx, stored.ID).Return(nil).Once()
+
+		// 2. Act
+		principal, err := service.AuthenticateToken(ctx, plain)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Equal(t, testUser.ID, principal.UserID)
+		assert.Equal(t, stored.ID, principal.TokenID)
+		assert.Equal(t, []string{"read"}, principal.Scopes)
+		assert.True(t, principal.EmailVerified)
+	})
+
+	t.Run("Recently Used Token Is Not Touched Again", func(t *testing.T) {
+		// 1. Setup: tidak ada EXPECT untuk Touch
+		lastUsed := patTestNow.Add(-10 * time.Second)
+		stored := &models.PersonalAccessToken{ID: uuid.New(), UserID: testUser.ID, LastUsedAt: &lastUsed}
+		m.tokenRepo.EXPECT().GetActiveByHash(ctx, hashToken(plain)).Return(stored, nil).Once()
+		m.userRepo.EXPECT().GetUserByID(ctx, testUser.ID).Return(testUser, nil).Once()
+
+		// 2. Act
+		principal, err := service.AuthenticateToken(ctx, plain)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, principal)
+	})
+
+	t.Run("Unknown, Revoked Or Expired Token", func(t *testing.T) {
+		// 1. Setup
+		m.tokenRepo.EXPECT().GetActiveByHash(ctx, hashToken(plain)).Return(nil, pgx.ErrNoRows).Once()
+
+		// 2. Act
+		principal, err := service.AuthenticateToken(ctx, plain)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Nil(t, principal)
+	})
+}
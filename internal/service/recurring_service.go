@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var ErrInvalidSchedule = errors.New("invalid schedule")
+
+const (
+	// recurringDueBatchSize adalah jumlah template yang diproses per putaran worker
+	recurringDueBatchSize = 100
+	// maxCatchUpPerRun membatasi kemunculan yang dibuat per template per putaran;
+	// sisanya dilanjutkan pada putaran berikutnya
+	maxCatchUpPerRun = 400
+)
+
+type RecurringService interface {
+	CreateRecurring(ctx context.Context, req models.CreateRecurringTransactionRequest, userID uuid.UUID) (*models.RecurringTransaction, error)
+	GetUserRecurring(ctx context.Context, userID uuid.UUID) ([]models.RecurringTransaction, error)
+	UpdateRecurring(ctx context.Context, recurringID int64, req models.UpdateRecurringTransactionRequest, userID uuid.UUID) (*models.RecurringTransaction, error)
+	DeleteRecurring(ctx context.Context, recurringID int64, userID uuid.UUID) error
+	ProcessDue(ctx context.Context, now time.Time) (int, error)
+}
+
+type recurringService struct {
+	db            txBeginner
+	recurringRepo repository.RecurringRepository
+	categoryRepo  repository.CategoryRepository
+	*transactionWriter
+}
+
+func NewRecurringService(db txBeginner, recurringRepo repository.RecurringRepository, trxRepo repository.TransactionRepository, walletRepo repository.WalletRepository, categoryRepo repository.CategoryRepository, envelopeRepo repository.EnvelopeRepository, budgetRepo repository.BudgetRepository, notificationRepo repository.NotificationRepository, prefsRepo repository.PreferencesRepository) RecurringService {
+	return &recurringService{
+		db:                db,
+		recurringRepo:     recurringRepo,
+		categoryRepo:      categoryRepo,
+		transactionWriter: newTransactionWriter(trxRepo, walletRepo, envelopeRepo, budgetRepo, notificationRepo, prefsRepo),
+	}
+}
+
+// CreateRecurring menyimpan template baru. Jika StartDate sudah lewat, kemunculan yang
+// tertinggal akan dibuat oleh worker pada putaran berikutnya.
+func (s *recurringService) CreateRecurring(ctx context.Context, req models.CreateRecurringTransactionRequest, userID uuid.UUID) (*models.RecurringTransaction, error) {
+	wallet, category, err := s.validateTarget(ctx, req.WalletID, req.CategoryID, req.Type, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rt := &models.RecurringTransaction{
+		UserID:      userID,
+		WalletID:    req.WalletID,
+		CategoryID:  req.CategoryID,
+		Amount:      req.Amount,
+		Type:        models.TransactionType(req.Type),
+		Description: req.Description,
+		Schedule: models.Schedule{
+			Frequency: models.RecurrenceFrequency(req.Frequency),
+			Interval:  max(req.Interval, 1),
+			StartDate: req.StartDate,
+			EndDate:   req.EndDate,
+			Count:     req.Count,
+		},
+		WalletName:   wallet.Name,
+		CategoryName: category.Name,
+	}
+
+	if err := rt.Schedule.Validate(); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, ErrInvalidSchedule)
+	}
+	loc, err := loadLocation(ctx, s.prefsRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+	rt.NextRunAt = rt.NextOccurrence(loc)
+	if rt.NextRunAt == nil {
+		return nil, fmt.Errorf("schedule has no occurrences: %w", ErrInvalidSchedule)
+	}
+
+	if err := s.recurringRepo.Create(ctx, rt); err != nil {
+		return nil, err
+	}
+	return rt, nil
+}
+
+func (s *recurringService) GetUserRecurring(ctx context.Context, userID uuid.UUID) ([]models.RecurringTransaction, error) {
+	templates, err := s.recurringRepo.GetAllByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if templates == nil {
+		templates = []models.RecurringTransaction{}
+	}
+	return templates, nil
+}
+
+// UpdateRecurring mengganti isi transaksi dan batas akhir jadwal. Kemunculan yang sudah
+// dibuat tidak diubah; NextRunAt dihitung ulang dari batas yang baru.
+func (s *recurringService) UpdateRecurring(ctx context.Context, recurringID int64, req models.UpdateRecurringTransactionRequest, userID uuid.UUID) (*models.RecurringTransaction, error) {
+	rt, err := s.recurringRepo.CheckOwnership(ctx, recurringID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("recurring transaction ownership validation failed: %w", ErrForbidden)
+	}
+
+	wallet, category, err := s.validateTarget(ctx, req.WalletID, req.CategoryID, req.Type, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rt.WalletID = req.WalletID
+	rt.CategoryID = req.CategoryID
+	rt.Amount = req.Amount
+	rt.Type = models.TransactionType(req.Type)
+	rt.Description = req.Description
+	rt.EndDate = req.EndDate
+	rt.Count = req.Count
+	rt.WalletName = wallet.Name
+	rt.CategoryName = category.Name
+
+	if err := rt.Schedule.Validate(); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, ErrInvalidSchedule)
+	}
+	loc, err := loadLocation(ctx, s.prefsRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+	rt.NextRunAt = rt.NextOccurrence(loc)
+
+	if err := s.recurringRepo.Update(ctx, rt); err != nil {
+		return nil, err
+	}
+	return rt, nil
+}
+
+// DeleteRecurring menghentikan template. Transaksi yang sudah dibuat tetap ada.
+func (s *recurringService) DeleteRecurring(ctx context.Context, recurringID int64, userID uuid.UUID) error {
+	if _, err := s.recurringRepo.CheckOwnership(ctx, recurringID, userID); err != nil {
+		return ErrForbidden
+	}
+
+	return s.recurringRepo.Delete(ctx, recurringID)
+}
+
+// ProcessDue membuat semua kemunculan yang jatuh tempo sampai `now`, termasuk yang tertinggal
+// saat server mati. Error satu template tidak menghentikan template lain; semua error
+// digabung dan dikembalikan bersama jumlah transaksi yang berhasil dibuat.
+func (s *recurringService) ProcessDue(ctx context.Context, now time.Time) (int, error) {
+	ids, err := s.recurringRepo.GetDueIDs(ctx, now, recurringDueBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	created := 0
+	var errs []error
+	for _, id := range ids {
+		for range maxCatchUpPerRun {
+			ok, err := s.materializeNext(ctx, id, now)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("recurring transaction %d: %w", id, err))
+				break
+			}
+			if !ok {
+				break
+			}
+			created++
+		}
+	}
+
+	return created, errors.Join(errs...)
+}
+
+// materializeNext membuat satu kemunculan berikutnya jika sudah jatuh tempo. Template dikunci
+// selama tx dan penghitung kemunculan dinaikkan di tx yang sama dengan pembuatan transaksi,
+// sehingga kemunculan yang sama tidak mungkin tercatat dua kali.
+func (s *recurringService) materializeNext(ctx context.Context, id int64, now time.Time) (bool, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	defer tx.Rollback(ctx)
+
+	rt, err := s.recurringRepo.GetForUpdateTx(ctx, tx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Sudah dihapus, atau sedang diproses instance lain
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	loc, err := loadLocation(ctx, s.prefsRepo, rt.UserID)
+	if err != nil {
+		return false, err
+	}
+	next := rt.NextOccurrence(loc)
+	if next == nil || next.After(now) {
+		// Tidak ada yang jatuh tempo; selaraskan next_run_at (mis. setelah batas diubah)
+		if err := s.recurringRepo.AdvanceTx(ctx, tx, rt.ID, rt.OccurrenceCount, next); err != nil {
+			return false, err
+		}
+		return false, tx.Commit(ctx)
+	}
+
+	// Kind kategori bisa berubah setelah template dibuat; jangan catat transaksi yang bertentangan.
+	// Jadwal dijeda (next_run_at NULL) sampai user memperbaiki template lewat UpdateRecurring.
+	category, err := s.categoryRepo.CheckOwnership(ctx, rt.CategoryID, rt.UserID)
+	if err != nil {
+		return false, err
+	}
+	if category.Kind != rt.Type {
+		log.Printf("Transaksi berulang %d dijeda: transaksi %s tidak bisa memakai kategori %s", rt.ID, rt.Type, category.Kind)
+		if err := s.recurringRepo.AdvanceTx(ctx, tx, rt.ID, rt.OccurrenceCount, nil); err != nil {
+			return false, err
+		}
+		return false, tx.Commit(ctx)
+	}
+
+	index := rt.OccurrenceCount
+	t := &models.Transaction{
+		UserID:          rt.UserID,
+		WalletID:        rt.WalletID,
+		CategoryID:      rt.CategoryID,
+		Amount:          rt.Amount,
+		Type:            rt.Type,
+		Description:     rt.Description,
+		TransactionDate: *next,
+		RecurringID:     &rt.ID,
+		RecurrenceIndex: &index,
+	}
+	if err := s.createTx(ctx, tx, t); err != nil {
+		return false, err
+	}
+
+	rt.OccurrenceCount++
+	if err := s.recurringRepo.AdvanceTx(ctx, tx, rt.ID, rt.OccurrenceCount, rt.NextOccurrence(loc)); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit(ctx)
+}
+
+// validateTarget memastikan dompet & kategori milik user dan jenis kategori cocok dengan transaksi.
+func (s *recurringService) validateTarget(ctx context.Context, walletID int64, categoryID int64, trxType string, userID uuid.UUID) (*models.Wallet, *models.Category, error) {
+	wallet, err := s.walletRepo.CheckOwnership(ctx, walletID, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("wallet ownership validation failed: %w", ErrForbidden)
+	}
+	if wallet.IsArchived() {
+		return nil, nil, ErrWalletArchived
+	}
+	category, err := s.categoryRepo.CheckOwnership(ctx, categoryID, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("category ownership validation failed: %w", ErrForbidden)
+	}
+	if category.Kind != models.TransactionType(trxType) {
+		return nil, nil, fmt.Errorf("%s transaction cannot use %s category: %w", trxType, category.Kind, ErrCategoryKindMismatch)
+	}
+	return wallet, category, nil
+}
@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	repoMocks "github.com/Udean777/uang-bijak-go/internal/repository/mocks"
+)
+
+// Helper setup
+func setupRecurringService(t *testing.T) (RecurringService, *repoMocks.MockRecurringRepository, *repoMocks.MockWalletRepository, *repoMocks.MockCategoryRepository, *repoMocks.MockPreferencesRepository) {
+	mockRecurringRepo := repoMocks.NewMockRecurringRepository(t)
+	mockWalletRepo := repoMocks.NewMockWalletRepository(t)
+	mockCategoryRepo := repoMocks.NewMockCategoryRepository(t)
+	mockPrefsRepo := repoMocks.NewMockPreferencesRepository(t)
+
+	service := NewRecurringService(nil, mockRecurringRepo,
+		repoMocks.NewMockTransactionRepository(t), mockWalletRepo, mockCategoryRepo,
+		repoMocks.NewMockEnvelopeRepository(t), repoMocks.NewMockBudgetRepository(t), repoMocks.NewMockNotificationRepository(t),
+		mockPrefsRepo)
+	return service, mockRecurringRepo, mockWalletRepo, mockCategoryRepo, mockPrefsRepo
+}
+
+func TestRecurringService_CreateRecurring(t *testing.T) {
+	service, mockRecurringRepo, mockWalletRepo, mockCategoryRepo, mockPrefsRepo := setupRecurringService(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+	startDate := time.Date(2026, time.January, 25, 9, 0, 0, 0, time.UTC)
+
+	req := models.CreateRecurringTransactionRequest{
+		WalletID:   1,
+		CategoryID: 2,
+		Amount:     8000000,
+		Type:       "income",
+		Frequency:  "monthly",
+		StartDate:  startDate,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		// 1. Setup
+		mockWalletRepo.EXPECT().CheckOwnership(ctx, req.WalletID, testUserID).Return(&models.Wallet{ID: 1, Name: "BCA"}, nil).Once()
+		mockCategoryRepo.EXPECT().
+			CheckOwnership(ctx, req.CategoryID, testUserID).
+			Return(&models.Category{ID: 2, Name: "Gaji", Kind: models.TransactionIncome}, nil).
+			Once()
+		mockPrefsRepo.EXPECT().GetByUserID(ctx, testUserID).Return(nil, pgx.ErrNoRows).Once()
+		mockRecurringRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*models.RecurringTransaction")).
+			Run(func(ctx context.Context, rt *models.RecurringTransaction) {
+				assert.Equal(t, 1, rt.Interval)
+				assert.True(t, rt.NextRunAt.Equal(startDate))
+				rt.ID = 10
+			}).
+			Return(nil).
+			Once()
+
+		// 2. Act
+		recurring, err := service.CreateRecurring(ctx, req, testUserID)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(10), recurring.ID)
+		assert.Equal(t, "Gaji", recurring.CategoryName)
+	})
+
+	t.Run("Fail - End Date Before Start Date", func(t *testing.T) {
+		// 1. Setup
+		endDate := startDate.AddDate(0, 0, -1)
+		badReq := req
+		badReq.EndDate = &endDate
+
+		mockWalletRepo.EXPECT().CheckOwnership(ctx, req.WalletID, testUserID).Return(&models.Wallet{ID: 1}, nil).Once()
+		mockCategoryRepo.EXPECT().
+			CheckOwnership(ctx, req.CategoryID, testUserID).
+			Return(&models.Category{ID: 2, Kind: models.TransactionIncome}, nil).
+			Once()
+
+		// 2. Act
+		_, err := service.CreateRecurring(ctx, badReq, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrInvalidSchedule)
+	})
+
+	t.Run("Fail - Category Kind Mismatch", func(t *testing.T) {
+		// 1. Setup
+		mockWalletRepo.EXPECT().CheckOwnership(ctx, req.WalletID, testUserID).Return(&models.Wallet{ID: 1}, nil).Once()
+		mockCategoryRepo.EXPECT().
+			CheckOwnership(ctx, req.CategoryID, testUserID).
+			Return(&models.Category{ID: 2, Name: "Sewa", Kind: models.TransactionExpense}, nil).
+			Once()
+
+		// 2. Act
+		_, err := service.CreateRecurring(ctx, req, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrCategoryKindMismatch)
+	})
+
+	t.Run("Fail - Wallet Not Owned", func(t *testing.T) {
+		// 1. Setup
+		mockWalletRepo.EXPECT().CheckOwnership(ctx, req.WalletID, testUserID).Return(nil, errors.New("not found")).Once()
+
+		// 2. Act
+		_, err := service.CreateRecurring(ctx, req, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
+
+func TestRecurringService_UpdateRecurring(t *testing.T) {
+	service, mockRecurringRepo, mockWalletRepo, mockCategoryRepo, mockPrefsRepo := setupRecurringService(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+	startDate := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Success - Lowering Count Finishes Template", func(t *testing.T) {
+		// 1. Setup: sudah 3 kemunculan dibuat, count diturunkan menjadi 3
+		existing := &models.RecurringTransaction{
+			ID: 4, UserID: testUserID, OccurrenceCount: 3,
+			Schedule: models.Schedule{Frequency: models.FrequencyMonthly, Interval: 1, StartDate: startDate},
+		}
+		count := 3
+		req := models.UpdateRecurringTransactionRequest{WalletID: 1, CategoryID: 2, Amount: 50000, Type: "expense", Count: &count}
+
+		mockRecurringRepo.EXPECT().CheckOwnership(ctx, int64(4), testUserID).Return(existing, nil).Once()
+		mockWalletRepo.EXPECT().CheckOwnership(ctx, req.WalletID, testUserID).Return(&models.Wallet{ID: 1}, nil).Once()
+		mockCategoryRepo.EXPECT().
+			CheckOwnership(ctx, req.CategoryID, testUserID).
+			Return(&models.Category{ID: 2, Kind: models.TransactionExpense}, nil).
+			Once()
+		mockPrefsRepo.EXPECT().GetByUserID(ctx, testUserID).Return(nil, pgx.ErrNoRows).Once()
+		mockRecurringRepo.EXPECT().
+			Update(ctx, mock.MatchedBy(func(rt *models.RecurringTransaction) bool {
+				return rt.NextRunAt == nil && rt.Amount == 50000
+			})).
+			Return(nil).
+			Once()
+
+		// 2. Act
+		recurring, err := service.UpdateRecurring(ctx, 4, req, testUserID)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Nil(t, recurring.NextRunAt)
+	})
+
+	t.Run("Fail - Not Owner", func(t *testing.T) {
+		// 1. Setup
+		mockRecurringRepo.EXPECT().CheckOwnership(ctx, int64(5), testUserID).Return(nil, errors.New("not found")).Once()
+
+		// 2. Act
+		_, err := service.UpdateRecurring(ctx, 5, models.UpdateRecurringTransactionRequest{}, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
+
+// Pembuatan kemunculan membutuhkan tx database (Integration Test); di sini hanya jalur tanpa tx
+func TestRecurringService_ProcessDue(t *testing.T) {
+	service, mockRecurringRepo, _, _, _ := setupRecurringService(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("Nothing Due", func(t *testing.T) {
+		// 1. Setup
+		mockRecurringRepo.EXPECT().GetDueIDs(ctx, now, recurringDueBatchSize).Return(nil, nil).Once()
+
+		// 2. Act
+		created, err := service.ProcessDue(ctx, now)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 0, created)
+	})
+
+	t.Run("Fail - Query Error", func(t *testing.T) {
+		// 1. Setup
+		mockRecurringRepo.EXPECT().GetDueIDs(ctx, now, recurringDueBatchSize).Return(nil, errors.New("db down")).Once()
+
+		// 2. Act
+		_, err := service.ProcessDue(ctx, now)
+
+		// 3. Assert
+		assert.Error(t, err)
+	})
+}
+
+func TestRecurringService_MaterializeNext_PausesOnKindMismatch(t *testing.T) {
+	ctx := context.Background()
+	tx := &fakeTx{}
+	mockRecurringRepo := repoMocks.NewMockRecurringRepository(t)
+	mockTrxRepo := repoMocks.NewMockTransactionRepository(t)
+	mockCategoryRepo := repoMocks.NewMockCategoryRepository(t)
+	mockPrefsRepo := repoMocks.NewMockPreferencesRepository(t)
+	service := NewRecurringService(&fakeDB{tx: tx}, mockRecurringRepo,
+		mockTrxRepo, repoMocks.NewMockWalletRepository(t), mockCategoryRepo,
+		repoMocks.NewMockEnvelopeRepository(t), repoMocks.NewMockBudgetRepository(t), repoMocks.NewMockNotificationRepository(t),
+		mockPrefsRepo).(*recurringService)
+
+	testUserID := uuid.New()
+	startDate := time.Date(2026, time.January, 25, 9, 0, 0, 0, time.UTC)
+	rt := &models.RecurringTransaction{
+		ID:         7,
+		UserID:     testUserID,
+		WalletID:   1,
+		CategoryID: 2,
+		Amount:     50000,
+		Type:       models.TransactionExpense,
+		Schedule:   models.Schedule{Frequency: models.FrequencyMonthly, Interval: 1, StartDate: startDate},
+	}
+
+	// 1. Setup: kategori sudah berubah menjadi pemasukan setelah template dibuat
+	mockRecurringRepo.EXPECT().GetForUpdateTx(ctx, tx, int64(7)).Return(rt, nil).Once()
+	mockPrefsRepo.EXPECT().GetByUserID(ctx, testUserID).Return(nil, pgx.ErrNoRows).Once()
+	mockCategoryRepo.EXPECT().
+		CheckOwnership(ctx, int64(2), testUserID).
+		Return(&models.Category{ID: 2, Kind: models.TransactionIncome}, nil).
+		Once()
+	mockRecurringRepo.EXPECT().AdvanceTx(ctx, tx, int64(7), 0, (*time.Time)(nil)).Return(nil).Once()
+
+	// 2. Act
+	created, err := service.materializeNext(ctx, 7, startDate.AddDate(0, 0, 1))
+
+	// 3. Assert: tidak ada transaksi yang dibuat dan jadwal dijeda
+	assert.NoError(t, err)
+	assert.False(t, created)
+	assert.True(t, tx.committed)
+	mockTrxRepo.AssertNotCalled(t, "CreateTx", ctx, tx, mock.Anything)
+}
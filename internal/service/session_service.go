@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type SessionService interface {
+	GetSessions(ctx context.Context, userID uuid.UUID, currentSessionID uuid.UUID) ([]models.Session, error)
+	RevokeSession(ctx context.Context, sessionID uuid.UUID, userID uuid.UUID) error
+	IsSessionActive(ctx context.Context, sessionID uuid.UUID) (bool, error)
+}
+
+type sessionService struct {
+	sessionRepo repository.SessionRepository
+}
+
+func NewSessionService(sessionRepo repository.SessionRepository) SessionService {
+	return &sessionService{sessionRepo: sessionRepo}
+}
+
+// GetSessions mengembalikan sesi aktif user; sesi yang sedang dipakai request ini ditandai Current.
+func (s *sessionService) GetSessions(ctx context.Context, userID uuid.UUID, currentSessionID uuid.UUID) ([]models.Session, error) {
+	sessions, err := s.sessionRepo.GetActiveByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if sessions == nil {
+		sessions = []models.Session{}
+	}
+
+	for i := range sessions {
+		sessions[i].Current = sessions[i].ID == currentSessionID
+	}
+	return sessions, nil
+}
+
+func (s *sessionService) RevokeSession(ctx context.Context, sessionID uuid.UUID, userID uuid.UUID) error {
+	if _, err := s.sessionRepo.CheckOwnership(ctx, sessionID, userID); err != nil {
+		return ErrForbidden
+	}
+
+	return s.sessionRepo.Revoke(ctx, sessionID)
+}
+
+// IsSessionActive dipakai AuthMiddleware untuk menolak access token dari sesi yang sudah dicabut.
+func (s *sessionService) IsSessionActive(ctx context.Context, sessionID uuid.UUID) (bool, error) {
+	session, err := s.sessionRepo.GetByID(ctx, sessionID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return session.RevokedAt == nil, nil
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	repoMocks "github.com/Udean777/uang-bijak-go/internal/repository/mocks"
+)
+
+func TestSessionService_GetSessions(t *testing.T) {
+	mockSessionRepo := repoMocks.NewMockSessionRepository(t)
+	service := NewSessionService(mockSessionRepo)
+	ctx := context.Background()
+	testUserID := uuid.New()
+
+	t.Run("Success - Marks Current Session", func(t *testing.T) {
+		// 1. Setup
+		currentID := uuid.New()
+		otherID := uuid.New()
+		mockSessionRepo.EXPECT().
+			GetActiveByUserID(ctx, testUserID).
+			Return([]models.Session{{ID: otherID}, {ID: currentID}}, nil).
+			Once()
+
+		// 2. Act
+		sessions, err := service.GetSessions(ctx, testUserID, currentID)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.False(t, sessions[0].Current)
+		assert.True(t, sessions[1].Current)
+	})
+}
+
+func TestSessionService_RevokeSession(t *testing.T) {
+	mockSessionRepo := repoMocks.NewMockSessionRepository(t)
+	service := NewSessionService(mockSessionRepo)
+	ctx := context.Background()
+	testUserID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		// 1. Setup
+		sessionID := uuid.New()
+		mockSessionRepo.EXPECT().CheckOwnership(ctx, sessionID, testUserID).Return(&models.Session{ID: sessionID}, nil).Once()
+		mockSessionRepo.EXPECT().Revoke(ctx, sessionID).Return(nil).Once()
+
+		// 2. Act
+		err := service.RevokeSession(ctx, sessionID, testUserID)
+
+		// 3. Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fail - Forbidden", func(t *testing.T) {
+		// 1. Setup
+		sessionID := uuid.New()
+		mockSessionRepo.EXPECT().CheckOwnership(ctx, sessionID, testUserID).Return(nil, errors.New("not found")).Once()
+
+		// 2. Act
+		err := service.RevokeSession(ctx, sessionID, testUserID)
+
+		// 3. Assert
+		assert.Equal(t, ErrForbidden, err)
+	})
+}
+
+func TestSessionService_IsSessionActive(t *testing.T) {
+	mockSessionRepo := repoMocks.NewMockSessionRepository(t)
+	service := NewSessionService(mockSessionRepo)
+	ctx := context.Background()
+
+	t.Run("Active", func(t *testing.T) {
+		sessionID := uuid.New()
+		mockSessionRepo.EXPECT().GetByID(ctx, sessionID).Return(&models.Session{ID: sessionID}, nil).Once()
+
+		active, err := service.IsSessionActive(ctx, sessionID)
+
+		assert.NoError(t, err)
+		assert.True(t, active)
+	})
+
+	t.Run("Revoked", func(t *testing.T) {
+		sessionID := uuid.New()
+		revokedAt := time.Now()
+		mockSessionRepo.EXPECT().GetByID(ctx, sessionID).Return(&models.Session{ID: sessionID, RevokedAt: &revokedAt}, nil).Once()
+
+		active, err := service.IsSessionActive(ctx, sessionID)
+
+		assert.NoError(t, err)
+		assert.False(t, active)
+	})
+
+	t.Run("Unknown Session", func(t *testing.T) {
+		sessionID := uuid.New()
+		mockSessionRepo.EXPECT().GetByID(ctx, sessionID).Return(nil, pgx.ErrNoRows).Once()
+
+		active, err := service.IsSessionActive(ctx, sessionID)
+
+		assert.NoError(t, err)
+		assert.False(t, active)
+	})
+}
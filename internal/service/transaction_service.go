@@ -2,57 +2,211 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/Udean777/uang-bijak-go/internal/models"
 	"github.com/Udean777/uang-bijak-go/internal/repository"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+var (
+	ErrInvalidFilter = errors.New("invalid filter")
+	// ErrManagedByTransfer: transaksi biaya admin hanya bisa diubah lewat transfernya
+	ErrManagedByTransfer = errors.New("transaction is managed by a transfer")
+)
+
+const defaultTransactionPageSize = 20
+
 type TransactionService interface {
 	CreateTransaction(ctx context.Context, req models.CreateTransactionRequest, userID uuid.UUID) (*models.Transaction, error)
-	GetUserTransactions(ctx context.Context, userID uuid.UUID) ([]models.Transaction, error)
+	GetUserTransactions(ctx context.Context, userID uuid.UUID, filter models.TransactionFilter) (*models.TransactionPage, error)
+	UpdateTransaction(ctx context.Context, transactionID int64, req models.UpdateTransactionRequest, userID uuid.UUID) (*models.Transaction, error)
+	DeleteTransaction(ctx context.Context, transactionID int64, userID uuid.UUID) error
 }
 
 type transactionService struct {
 	db           *pgxpool.Pool
-	trxRepo      repository.TransactionRepository
-	walletRepo   repository.WalletRepository
 	categoryRepo repository.CategoryRepository
+	*transactionWriter
 }
 
-func NewTransactionService(db *pgxpool.Pool, trxRepo repository.TransactionRepository, walletRepo repository.WalletRepository, categoryRepo repository.CategoryRepository) TransactionService {
+func NewTransactionService(db *pgxpool.Pool, trxRepo repository.TransactionRepository, walletRepo repository.WalletRepository, categoryRepo repository.CategoryRepository, envelopeRepo repository.EnvelopeRepository, budgetRepo repository.BudgetRepository, notificationRepo repository.NotificationRepository, prefsRepo repository.PreferencesRepository) TransactionService {
 	return &transactionService{
-		db:           db,
-		trxRepo:      trxRepo,
-		walletRepo:   walletRepo,
-		categoryRepo: categoryRepo,
+		db:                db,
+		categoryRepo:      categoryRepo,
+		transactionWriter: newTransactionWriter(trxRepo, walletRepo, envelopeRepo, budgetRepo, notificationRepo, prefsRepo),
+	}
+}
+
+// transactionWriter berisi langkah-langkah mencatat transaksi di dalam tx database: saldo
+// dompet, baris transaksi, amplop kategori, dan notifikasi anggaran. Dipakai bersama oleh
+// transactionService dan recurringService agar efek sebuah transaksi selalu sama.
+type transactionWriter struct {
+	trxRepo          repository.TransactionRepository
+	walletRepo       repository.WalletRepository
+	envelopeRepo     repository.EnvelopeRepository
+	budgetRepo       repository.BudgetRepository
+	notificationRepo repository.NotificationRepository
+	prefsRepo        repository.PreferencesRepository
+}
+
+func newTransactionWriter(trxRepo repository.TransactionRepository, walletRepo repository.WalletRepository, envelopeRepo repository.EnvelopeRepository, budgetRepo repository.BudgetRepository, notificationRepo repository.NotificationRepository, prefsRepo repository.PreferencesRepository) *transactionWriter {
+	return &transactionWriter{
+		trxRepo:          trxRepo,
+		walletRepo:       walletRepo,
+		envelopeRepo:     envelopeRepo,
+		budgetRepo:       budgetRepo,
+		notificationRepo: notificationRepo,
+		prefsRepo:        prefsRepo,
+	}
+}
+
+// checkWalletActive memastikan dompet milik user dan belum diarsipkan, karena saldo dompet
+// yang diarsipkan dibekukan.
+func (w *transactionWriter) checkWalletActive(ctx context.Context, walletID int64, userID uuid.UUID) error {
+	wallet, err := w.walletRepo.CheckOwnership(ctx, walletID, userID)
+	if err != nil {
+		return fmt.Errorf("wallet ownership validation failed: %w", ErrForbidden)
+	}
+	if wallet.IsArchived() {
+		return ErrWalletArchived
+	}
+	return nil
+}
+
+// createTx mencatat transaksi baru beserta seluruh efeknya. Commit menjadi tanggung jawab caller.
+func (w *transactionWriter) createTx(ctx context.Context, tx pgx.Tx, t *models.Transaction) error {
+	if err := w.walletRepo.UpdateBalanceTx(ctx, tx, t.WalletID, t.BalanceEffect()); err != nil {
+		return err
+	}
+
+	if err := w.drawEnvelopeTx(ctx, tx, t); err != nil {
+		return err
+	}
+
+	if err := w.trxRepo.CreateTx(ctx, tx, t); err != nil {
+		return err
+	}
+
+	return w.emitBudgetAlertsTx(ctx, tx, t)
+}
+
+// deleteTx membalikkan seluruh efek transaksi (saldo dompet dan amplop) lalu menghapusnya.
+// Commit menjadi tanggung jawab caller.
+func (w *transactionWriter) deleteTx(ctx context.Context, tx pgx.Tx, t *models.Transaction) error {
+	if err := w.walletRepo.UpdateBalanceTx(ctx, tx, t.WalletID, -t.BalanceEffect()); err != nil {
+		return err
+	}
+
+	if err := w.refundEnvelopeTx(ctx, tx, t); err != nil {
+		return err
+	}
+
+	return w.trxRepo.DeleteTx(ctx, tx, t.ID)
+}
+
+// drawEnvelopeTx mengurangi amplop kategori untuk pengeluaran dan mencatat amplop yang dipotong
+// di t.EnvelopeID. Pemasukan tidak memengaruhi amplop.
+func (w *transactionWriter) drawEnvelopeTx(ctx context.Context, tx pgx.Tx, t *models.Transaction) error {
+	t.EnvelopeID = nil
+	if t.Type != models.TransactionExpense {
+		return nil
+	}
+	envelopeID, err := w.envelopeRepo.DrawDownTx(ctx, tx, t.CategoryID, t.Amount)
+	if err != nil {
+		return err
+	}
+	t.EnvelopeID = envelopeID
+	return nil
+}
+
+// refundEnvelopeTx mengembalikan dana ke amplop yang dicatat di t.EnvelopeID saat transaksi
+// diubah/dihapus. Transaksi yang tidak pernah memotong amplop (mis. dibuat sebelum amplopnya ada,
+// atau dipindah lewat merge/reparent) tidak mengembalikan apa pun.
+func (w *transactionWriter) refundEnvelopeTx(ctx context.Context, tx pgx.Tx, t *models.Transaction) error {
+	if t.EnvelopeID == nil {
+		return nil
+	}
+	if err := w.envelopeRepo.RefundTx(ctx, tx, *t.EnvelopeID, t.Amount); err != nil {
+		return err
+	}
+	t.EnvelopeID = nil
+	return nil
+}
+
+// emitBudgetAlertsTx membuat notifikasi untuk ambang tertinggi yang tercapai pada setiap
+// anggaran (kategori transaksi dan leluhurnya) di bulan keuangan transaksi. Ambang dihitung terhadap
+// dana yang tersedia termasuk rollover, sama seperti GetBudgetStatus. Notifikasi yang sama
+// tidak dibuat ulang, jadi pengeluaran kecil berikutnya tidak memicu notifikasi baru.
+func (w *transactionWriter) emitBudgetAlertsTx(ctx context.Context, tx pgx.Tx, t *models.Transaction) error {
+	if t.Type != models.TransactionExpense {
+		return nil
+	}
+
+	prefs, err := loadPreferences(ctx, w.prefsRepo, t.UserID)
+	if err != nil {
+		return err
+	}
+	year, month := prefs.PeriodOf(t.TransactionDate)
+	startTime, endTime := prefs.MonthRange(year, month)
+
+	usages, err := w.budgetRepo.GetUsageForCategoryTx(ctx, tx, t.UserID, t.CategoryID, year, month, startTime, endTime)
+	if err != nil {
+		return err
+	}
+
+	budgets := make([]models.Budget, len(usages))
+	for i, usage := range usages {
+		budgets[i] = usage.Budget
+	}
+	carryOver, err := rolloverCarryOver(ctx, w.budgetRepo, t.UserID, year, month, budgets, newSpendingCache(w.trxRepo, t.UserID, prefs))
+	if err != nil {
+		return err
+	}
+
+	for _, usage := range usages {
+		usage.CarryOver = carryOver[usage.ID]
+		threshold := usage.CrossedThreshold()
+		if threshold == 0 {
+			continue
+		}
+		if _, err := w.notificationRepo.CreateTx(ctx, tx, models.NewBudgetThresholdNotification(usage, threshold)); err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
 func (s *transactionService) CreateTransaction(ctx context.Context, req models.CreateTransactionRequest, userID uuid.UUID) (*models.Transaction, error) {
 
-	if _, err := s.walletRepo.CheckOwnership(ctx, req.WalletID, userID); err != nil {
+	wallet, err := s.walletRepo.CheckOwnership(ctx, req.WalletID, userID)
+	if err != nil {
 		return nil, fmt.Errorf("wallet ownership validation failed: %w", ErrForbidden)
 	}
-	if _, err := s.categoryRepo.CheckOwnership(ctx, req.CategoryID, userID); err != nil {
+	if wallet.IsArchived() {
+		return nil, ErrWalletArchived
+	}
+	category, err := s.categoryRepo.CheckOwnership(ctx, req.CategoryID, userID)
+	if err != nil {
 		return nil, fmt.Errorf("category ownership validation failed: %w", ErrForbidden)
 	}
-
-	balanceChange := req.Amount
-	if models.TransactionType(req.Type) == models.TransactionExpense {
-		balanceChange = -req.Amount
+	if category.Kind != models.TransactionType(req.Type) {
+		return nil, fmt.Errorf("%s transaction cannot use %s category: %w", req.Type, category.Kind, ErrCategoryKindMismatch)
 	}
 
 	t := &models.Transaction{
-		UserID:      userID,
-		WalletID:    req.WalletID,
-		CategoryID:  req.CategoryID,
-		Amount:      req.Amount,
-		Type:        models.TransactionType(req.Type),
-		Description: req.Description,
+		UserID:       userID,
+		WalletID:     req.WalletID,
+		CategoryID:   req.CategoryID,
+		Amount:       req.Amount,
+		Type:         models.TransactionType(req.Type),
+		Description:  req.Description,
+		WalletName:   wallet.Name,
+		CategoryName: category.Name,
 	}
 	if req.TransactionDate != nil {
 		t.TransactionDate = *req.TransactionDate
@@ -67,11 +221,134 @@ func (s *transactionService) CreateTransaction(ctx context.Context, req models.C
 
 	defer tx.Rollback(ctx)
 
-	if err := s.walletRepo.UpdateBalanceTx(ctx, tx, req.WalletID, balanceChange); err != nil {
+	if err := s.createTx(ctx, tx, t); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (s *transactionService) GetUserTransactions(ctx context.Context, userID uuid.UUID, filter models.TransactionFilter) (*models.TransactionPage, error) {
+
+	if filter.MinAmount != nil && filter.MaxAmount != nil && *filter.MinAmount > *filter.MaxAmount {
+		return nil, fmt.Errorf("min_amount is greater than max_amount: %w", ErrInvalidFilter)
+	}
+	if filter.StartDate != nil && filter.EndDate != nil && filter.StartDate.After(*filter.EndDate) {
+		return nil, fmt.Errorf("start_date is after end_date: %w", ErrInvalidFilter)
+	}
+
+	if filter.Cursor != "" {
+		cursor, err := models.DecodeTransactionCursor(filter.Cursor)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", err, ErrInvalidFilter)
+		}
+		filter.After = cursor
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = defaultTransactionPageSize
+	}
+	// Ambil satu baris ekstra untuk mengetahui apakah masih ada halaman berikutnya
+	filter.Limit = limit + 1
+
+	transactions, err := s.trxRepo.List(ctx, userID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &models.TransactionPage{Data: transactions}
+	if len(transactions) > limit {
+		page.Data = transactions[:limit]
+		last := page.Data[limit-1]
+		next := models.TransactionCursor{TransactionDate: last.TransactionDate, ID: last.ID}.Encode()
+		page.NextCursor = &next
+	}
+
+	return page, nil
+}
+
+func (s *transactionService) UpdateTransaction(ctx context.Context, transactionID int64, req models.UpdateTransactionRequest, userID uuid.UUID) (*models.Transaction, error) {
+
+	existing, err := s.trxRepo.CheckOwnership(ctx, transactionID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("transaction ownership validation failed: %w", ErrForbidden)
+	}
+	if existing.TransferID != nil {
+		return nil, ErrManagedByTransfer
+	}
+	// Pembalikan efek lama mengubah saldo dompet asal, jadi dompet itu juga tidak boleh diarsipkan
+	if existing.WalletID != req.WalletID {
+		if err := s.checkWalletActive(ctx, existing.WalletID, userID); err != nil {
+			return nil, err
+		}
+	}
+	wallet, err := s.walletRepo.CheckOwnership(ctx, req.WalletID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("wallet ownership validation failed: %w", ErrForbidden)
+	}
+	if wallet.IsArchived() {
+		return nil, ErrWalletArchived
+	}
+	category, err := s.categoryRepo.CheckOwnership(ctx, req.CategoryID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("category ownership validation failed: %w", ErrForbidden)
+	}
+	if category.Kind != models.TransactionType(req.Type) {
+		return nil, fmt.Errorf("%s transaction cannot use %s category: %w", req.Type, category.Kind, ErrCategoryKindMismatch)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback(ctx)
+
+	// Ambil ulang di dalam tx (dengan lock) agar pembalikan saldo memakai data terbaru
+	t, err := s.trxRepo.GetByIDTx(ctx, tx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Batalkan efek lama pada dompet asal dan amplop yang dulu dipotong
+	if err := s.walletRepo.UpdateBalanceTx(ctx, tx, t.WalletID, -t.BalanceEffect()); err != nil {
+		return nil, err
+	}
+	if err := s.refundEnvelopeTx(ctx, tx, t); err != nil {
+		return nil, err
+	}
+
+	// 2. Terapkan data baru
+	t.WalletID = req.WalletID
+	t.CategoryID = req.CategoryID
+	t.Amount = req.Amount
+	t.Type = models.TransactionType(req.Type)
+	t.Description = req.Description
+	t.WalletName = wallet.Name
+	t.CategoryName = category.Name
+	if req.TransactionDate != nil {
+		t.TransactionDate = *req.TransactionDate
+	}
+
+	// 3. Terapkan efek baru pada dompet tujuan (bisa dompet yang sama) dan amplop kategori baru
+	if err := s.walletRepo.UpdateBalanceTx(ctx, tx, t.WalletID, t.BalanceEffect()); err != nil {
+		return nil, err
+	}
+	if err := s.drawEnvelopeTx(ctx, tx, t); err != nil {
+		return nil, err
+	}
+
+	if err := s.trxRepo.UpdateTx(ctx, tx, t); err != nil {
 		return nil, err
 	}
 
-	if err := s.trxRepo.CreateTx(ctx, tx, t); err != nil {
+	// Nominal, kategori, atau tanggal baru bisa melewati ambang anggaran yang belum tercapai
+	if err := s.emitBudgetAlertsTx(ctx, tx, t); err != nil {
 		return nil, err
 	}
 
@@ -82,7 +359,34 @@ func (s *transactionService) CreateTransaction(ctx context.Context, req models.C
 	return t, nil
 }
 
-func (s *transactionService) GetUserTransactions(ctx context.Context, userID uuid.UUID) ([]models.Transaction, error) {
+func (s *transactionService) DeleteTransaction(ctx context.Context, transactionID int64, userID uuid.UUID) error {
+
+	existing, err := s.trxRepo.CheckOwnership(ctx, transactionID, userID)
+	if err != nil {
+		return fmt.Errorf("transaction ownership validation failed: %w", ErrForbidden)
+	}
+	if existing.TransferID != nil {
+		return ErrManagedByTransfer
+	}
+	if err := s.checkWalletActive(ctx, existing.WalletID, userID); err != nil {
+		return err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback(ctx)
+
+	t, err := s.trxRepo.GetByIDTx(ctx, tx, transactionID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.deleteTx(ctx, tx, t); err != nil {
+		return err
+	}
 
-	return s.trxRepo.GetAllByUserID(ctx, userID)
+	return tx.Commit(ctx)
 }
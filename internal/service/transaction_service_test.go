@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/Udean777/uang-bijak-go/internal/models"
 
@@ -19,7 +22,7 @@ func setupTransactionService(t *testing.T) (TransactionService, *repoMocks.MockT
 	mockWalletRepo := repoMocks.NewMockWalletRepository(t)
 	mockCategoryRepo := repoMocks.NewMockCategoryRepository(t)
 
-	service := NewTransactionService(nil, mockTrxRepo, mockWalletRepo, mockCategoryRepo)
+	service := NewTransactionService(nil, mockTrxRepo, mockWalletRepo, mockCategoryRepo, repoMocks.NewMockEnvelopeRepository(t), repoMocks.NewMockBudgetRepository(t), repoMocks.NewMockNotificationRepository(t), repoMocks.NewMockPreferencesRepository(t))
 	return service, mockTrxRepo, mockWalletRepo, mockCategoryRepo
 }
 
@@ -28,24 +31,93 @@ func TestTransactionService_GetUserTransactions(t *testing.T) {
 	ctx := context.Background()
 	testUserID := uuid.New()
 
-	t.Run("Success", func(t *testing.T) {
+	t.Run("Success - Last Page", func(t *testing.T) {
 		// 1. Setup
 		mockResponse := []models.Transaction{
 			{ID: 1, Amount: 10000, Type: "expense"},
 		}
 
+		// Service meminta 1 baris ekstra (default 20 + 1)
 		mockTrxRepo.EXPECT().
-			GetAllByUserID(ctx, testUserID).
+			List(ctx, testUserID, models.TransactionFilter{Limit: 21}).
 			Return(mockResponse, nil).
 			Once()
 
 		// 2. Act
-		trxs, err := service.GetUserTransactions(ctx, testUserID)
+		page, err := service.GetUserTransactions(ctx, testUserID, models.TransactionFilter{})
 
 		// 3. Assert
 		assert.NoError(t, err)
-		assert.NotNil(t, trxs)
-		assert.Equal(t, 1, len(trxs))
+		assert.NotNil(t, page)
+		assert.Equal(t, 1, len(page.Data))
+		assert.Nil(t, page.NextCursor)
+	})
+
+	t.Run("Success - Has Next Page", func(t *testing.T) {
+		// 1. Setup
+		date := time.Date(2025, time.October, 5, 10, 0, 0, 0, time.UTC)
+		mockResponse := []models.Transaction{
+			{ID: 3, TransactionDate: date},
+			{ID: 2, TransactionDate: date},
+			{ID: 1, TransactionDate: date}, // baris ekstra
+		}
+
+		mockTrxRepo.EXPECT().
+			List(ctx, testUserID, models.TransactionFilter{Limit: 3}).
+			Return(mockResponse, nil).
+			Once()
+
+		// 2. Act
+		page, err := service.GetUserTransactions(ctx, testUserID, models.TransactionFilter{Limit: 2})
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(page.Data))
+		assert.NotNil(t, page.NextCursor)
+
+		cursor, err := models.DecodeTransactionCursor(*page.NextCursor)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), cursor.ID)
+		assert.True(t, date.Equal(cursor.TransactionDate))
+	})
+
+	t.Run("Success - Cursor Is Decoded", func(t *testing.T) {
+		// 1. Setup
+		after := models.TransactionCursor{TransactionDate: time.Date(2025, time.October, 1, 0, 0, 0, 0, time.UTC), ID: 42}
+		filter := models.TransactionFilter{Cursor: after.Encode(), Limit: 10}
+
+		mockTrxRepo.EXPECT().
+			List(ctx, testUserID, mock.MatchedBy(func(f models.TransactionFilter) bool {
+				return f.After != nil && f.After.ID == 42 && f.Limit == 11
+			})).
+			Return([]models.Transaction{}, nil).
+			Once()
+
+		// 2. Act
+		page, err := service.GetUserTransactions(ctx, testUserID, filter)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Empty(t, page.Data)
+	})
+
+	t.Run("Fail - Invalid Cursor", func(t *testing.T) {
+		// 2. Act
+		_, err := service.GetUserTransactions(ctx, testUserID, models.TransactionFilter{Cursor: "bukan-cursor"})
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrInvalidFilter)
+	})
+
+	t.Run("Fail - Invalid Amount Range", func(t *testing.T) {
+		// 1. Setup
+		minAmount, maxAmount := int64(5000), int64(1000)
+
+		// 2. Act
+		_, err := service.GetUserTransactions(ctx, testUserID, models.TransactionFilter{MinAmount: &minAmount, MaxAmount: &maxAmount})
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrInvalidFilter)
 	})
 }
 
@@ -79,6 +151,21 @@ func TestTransactionService_CreateTransaction_Failure_Forbidden(t *testing.T) {
 		assert.ErrorIs(t, err, ErrForbidden)
 	})
 
+	t.Run("Fail - Wallet Archived", func(t *testing.T) {
+		// 1. Setup
+		archivedAt := time.Now()
+		mockWalletRepo.EXPECT().
+			CheckOwnership(ctx, req.WalletID, testUserID).
+			Return(&models.Wallet{ID: 1, ArchivedAt: &archivedAt}, nil).
+			Once()
+
+		// 2. Act
+		_, err := service.CreateTransaction(ctx, req, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrWalletArchived)
+	})
+
 	t.Run("Fail - Category Ownership", func(t *testing.T) {
 		// 1. Setup
 		// Simulasikan walletRepo.CheckOwnership SUKSES
@@ -100,4 +187,338 @@ func TestTransactionService_CreateTransaction_Failure_Forbidden(t *testing.T) {
 		assert.Error(t, err)
 		assert.ErrorIs(t, err, ErrForbidden)
 	})
+
+	t.Run("Fail - Category Kind Mismatch", func(t *testing.T) {
+		// 1. Setup
+		mockWalletRepo.EXPECT().
+			CheckOwnership(ctx, req.WalletID, testUserID).
+			Return(&models.Wallet{}, nil).
+			Once()
+
+		// Kategori "Gaji" hanya untuk pemasukan
+		mockCategoryRepo.EXPECT().
+			CheckOwnership(ctx, req.CategoryID, testUserID).
+			Return(&models.Category{ID: req.CategoryID, Name: "Gaji", Kind: models.TransactionIncome}, nil).
+			Once()
+
+		// 2. Act
+		_, err := service.CreateTransaction(ctx, req, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrCategoryKindMismatch)
+	})
+}
+
+// Sama seperti CreateTransaction, skenario sukses Update/Delete membutuhkan database (Integration Test)
+func TestTransactionService_UpdateTransaction_Failure_Forbidden(t *testing.T) {
+	service, mockTrxRepo, mockWalletRepo, mockCategoryRepo := setupTransactionService(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+	transactionID := int64(10)
+	req := models.UpdateTransactionRequest{
+		WalletID:   2,
+		CategoryID: 3,
+		Amount:     5000,
+		Type:       "income",
+	}
+
+	t.Run("Fail - Transaction Ownership", func(t *testing.T) {
+		// 1. Setup
+		mockTrxRepo.EXPECT().
+			CheckOwnership(ctx, transactionID, testUserID).
+			Return(nil, errors.New("not found")).
+			Once()
+
+		// 2. Act
+		_, err := service.UpdateTransaction(ctx, transactionID, req, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrForbidden)
+		mockWalletRepo.AssertNotCalled(t, "CheckOwnership")
+	})
+
+	t.Run("Fail - New Wallet Ownership", func(t *testing.T) {
+		// 1. Setup
+		mockTrxRepo.EXPECT().
+			CheckOwnership(ctx, transactionID, testUserID).
+			Return(&models.Transaction{ID: transactionID, WalletID: req.WalletID}, nil).
+			Once()
+
+		// Dompet tujuan milik user lain
+		mockWalletRepo.EXPECT().
+			CheckOwnership(ctx, req.WalletID, testUserID).
+			Return(nil, errors.New("not found")).
+			Once()
+
+		// 2. Act
+		_, err := service.UpdateTransaction(ctx, transactionID, req, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("Fail - New Category Ownership", func(t *testing.T) {
+		// 1. Setup
+		mockTrxRepo.EXPECT().
+			CheckOwnership(ctx, transactionID, testUserID).
+			Return(&models.Transaction{ID: transactionID, WalletID: req.WalletID}, nil).
+			Once()
+
+		mockWalletRepo.EXPECT().
+			CheckOwnership(ctx, req.WalletID, testUserID).
+			Return(&models.Wallet{}, nil).
+			Once()
+
+		mockCategoryRepo.EXPECT().
+			CheckOwnership(ctx, req.CategoryID, testUserID).
+			Return(nil, errors.New("not found")).
+			Once()
+
+		// 2. Act
+		_, err := service.UpdateTransaction(ctx, transactionID, req, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("Fail - Old Wallet Archived", func(t *testing.T) {
+		// 1. Setup: transaksi dipindah keluar dari dompet yang sudah diarsipkan
+		archivedAt := time.Now()
+		mockTrxRepo.EXPECT().
+			CheckOwnership(ctx, transactionID, testUserID).
+			Return(&models.Transaction{ID: transactionID, WalletID: 9}, nil).
+			Once()
+
+		mockWalletRepo.EXPECT().
+			CheckOwnership(ctx, int64(9), testUserID).
+			Return(&models.Wallet{ID: 9, ArchivedAt: &archivedAt}, nil).
+			Once()
+
+		// 2. Act
+		_, err := service.UpdateTransaction(ctx, transactionID, req, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrWalletArchived)
+	})
+}
+
+func TestTransactionService_DeleteTransaction_Failure_Forbidden(t *testing.T) {
+	service, mockTrxRepo, mockWalletRepo, _ := setupTransactionService(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+
+	// 1. Setup
+	mockTrxRepo.EXPECT().
+		CheckOwnership(ctx, int64(10), testUserID).
+		Return(nil, errors.New("not found")).
+		Once()
+
+	// 2. Act
+	err := service.DeleteTransaction(ctx, 10, testUserID)
+
+	// 3. Assert
+	assert.ErrorIs(t, err, ErrForbidden)
+	mockWalletRepo.AssertNotCalled(t, "UpdateBalanceTx")
+}
+
+func TestTransactionService_DeleteTransaction_Failure_WalletArchived(t *testing.T) {
+	service, mockTrxRepo, mockWalletRepo, _ := setupTransactionService(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+	archivedAt := time.Now()
+
+	// 1. Setup
+	mockTrxRepo.EXPECT().
+		CheckOwnership(ctx, int64(10), testUserID).
+		Return(&models.Transaction{ID: 10, WalletID: 4}, nil).
+		Once()
+
+	mockWalletRepo.EXPECT().
+		CheckOwnership(ctx, int64(4), testUserID).
+		Return(&models.Wallet{ID: 4, ArchivedAt: &archivedAt}, nil).
+		Once()
+
+	// 2. Act
+	err := service.DeleteTransaction(ctx, 10, testUserID)
+
+	// 3. Assert
+	assert.ErrorIs(t, err, ErrWalletArchived)
+	mockWalletRepo.AssertNotCalled(t, "UpdateBalanceTx")
+}
+
+func TestTransactionService_TransferFee_ManagedByTransfer(t *testing.T) {
+	service, mockTrxRepo, mockWalletRepo, _ := setupTransactionService(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+	transferID := int64(12)
+	fee := &models.Transaction{ID: 77, UserID: testUserID, Amount: 2500, Type: models.TransactionExpense, TransferID: &transferID}
+
+	t.Run("Update - Rejected", func(t *testing.T) {
+		// 1. Setup
+		mockTrxRepo.EXPECT().CheckOwnership(ctx, int64(77), testUserID).Return(fee, nil).Once()
+
+		// 2. Act
+		_, err := service.UpdateTransaction(ctx, 77, models.UpdateTransactionRequest{WalletID: 1, CategoryID: 30, Amount: 1000, Type: "expense"}, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrManagedByTransfer)
+		mockWalletRepo.AssertNotCalled(t, "CheckOwnership")
+	})
+
+	t.Run("Delete - Rejected", func(t *testing.T) {
+		// 1. Setup
+		mockTrxRepo.EXPECT().CheckOwnership(ctx, int64(77), testUserID).Return(fee, nil).Once()
+
+		// 2. Act
+		err := service.DeleteTransaction(ctx, 77, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrManagedByTransfer)
+		mockWalletRepo.AssertNotCalled(t, "UpdateBalanceTx")
+	})
+}
+
+func TestTransactionWriter_EmitBudgetAlerts_Rollover(t *testing.T) {
+	ctx := context.Background()
+	testUserID := uuid.New()
+	tx := &fakeTx{}
+	trx := &models.Transaction{
+		UserID:          testUserID,
+		CategoryID:      5,
+		Amount:          50000,
+		Type:            models.TransactionExpense,
+		TransactionDate: time.Date(2026, time.January, 15, 12, 0, 0, 0, time.UTC),
+	}
+	usage := models.BudgetUsage{
+		Budget: models.Budget{ID: 4, UserID: testUserID, CategoryID: 5, CategoryName: "Servis Motor", Year: 2026, Month: 1, Amount: 300000, Rollover: true, AlertThresholds: []int{80, 100}},
+		Spent:  250000,
+	}
+
+	setup := func(t *testing.T, spentLastMonth int64) (*transactionWriter, *repoMocks.MockNotificationRepository) {
+		mockTrxRepo := repoMocks.NewMockTransactionRepository(t)
+		mockBudgetRepo := repoMocks.NewMockBudgetRepository(t)
+		mockNotificationRepo := repoMocks.NewMockNotificationRepository(t)
+		mockPrefsRepo := repoMocks.NewMockPreferencesRepository(t)
+		writer := newTransactionWriter(mockTrxRepo, repoMocks.NewMockWalletRepository(t), repoMocks.NewMockEnvelopeRepository(t), mockBudgetRepo, mockNotificationRepo, mockPrefsRepo)
+
+		// Tanpa preferensi tersimpan: bulan kalender Asia/Jakarta
+		prefs := models.DefaultUserPreferences(testUserID)
+		mockPrefsRepo.EXPECT().GetByUserID(ctx, testUserID).Return(nil, pgx.ErrNoRows).Once()
+
+		januaryStart, januaryEnd := prefs.MonthRange(2026, 1)
+		mockBudgetRepo.EXPECT().
+			GetUsageForCategoryTx(ctx, tx, testUserID, int64(5), 2026, 1, januaryStart, januaryEnd).
+			Return([]models.BudgetUsage{usage}, nil).
+			Once()
+		mockBudgetRepo.EXPECT().
+			GetRolloverHistory(ctx, testUserID, 2026, 1, models.MaxRolloverMonths).
+			Return([]models.Budget{{ID: 3, CategoryID: 5, Year: 2025, Month: 12, Amount: 300000, Rollover: true}}, nil).
+			Once()
+
+		startTime, endTime := prefs.MonthRange(2025, 12)
+		mockTrxRepo.EXPECT().
+			GetTotalsByCategory(ctx, testUserID, startTime, endTime).
+			Return([]models.CategorySummary{{CategoryID: 5, Name: "Servis Motor", TotalExpense: spentLastMonth}}, nil).
+			Once()
+
+		return writer, mockNotificationRepo
+	}
+
+	t.Run("Unspent Rollover Raises Available Amount", func(t *testing.T) {
+		// 1. Setup: sisa 200.000 bulan lalu, 250.000 baru 50% dari 500.000
+		writer, _ := setup(t, 100000)
+
+		// 2. Act
+		err := writer.emitBudgetAlertsTx(ctx, tx, trx)
+
+		// 3. Assert: tanpa rollover 250.000 sudah 83% dan akan memicu notifikasi 80%
+		assert.NoError(t, err)
+	})
+
+	t.Run("Overspent Rollover Lowers Available Amount", func(t *testing.T) {
+		// 1. Setup: kelebihan 200.000 bulan lalu, 250.000 sudah 250% dari 100.000
+		writer, mockNotificationRepo := setup(t, 500000)
+		mockNotificationRepo.EXPECT().
+			CreateTx(ctx, tx, mock.MatchedBy(func(n *models.Notification) bool {
+				return *n.Threshold == 100 && n.DedupKey == "budget:4:100"
+			})).
+			Return(true, nil).
+			Once()
+
+		// 2. Act
+		err := writer.emitBudgetAlertsTx(ctx, tx, trx)
+
+		// 3. Assert
+		assert.NoError(t, err)
+	})
+}
+
+func TestTransactionWriter_EmitBudgetAlerts_FinancialMonth(t *testing.T) {
+	ctx := context.Background()
+	testUserID := uuid.New()
+	tx := &fakeTx{}
+	mockBudgetRepo := repoMocks.NewMockBudgetRepository(t)
+	mockPrefsRepo := repoMocks.NewMockPreferencesRepository(t)
+	writer := newTransactionWriter(repoMocks.NewMockTransactionRepository(t), repoMocks.NewMockWalletRepository(t), repoMocks.NewMockEnvelopeRepository(t), mockBudgetRepo, repoMocks.NewMockNotificationRepository(t), mockPrefsRepo)
+
+	// 1. Setup: bulan keuangan dimulai tanggal 25, jadi 10 Januari masih periode Desember
+	prefs := models.DefaultUserPreferences(testUserID)
+	prefs.MonthStartDay = 25
+	mockPrefsRepo.EXPECT().GetByUserID(ctx, testUserID).Return(prefs, nil).Once()
+
+	startTime, endTime := prefs.MonthRange(2025, 12)
+	mockBudgetRepo.EXPECT().
+		GetUsageForCategoryTx(ctx, tx, testUserID, int64(5), 2025, 12, startTime, endTime).
+		Return([]models.BudgetUsage{}, nil).
+		Once()
+
+	// 2. Act
+	err := writer.emitBudgetAlertsTx(ctx, tx, &models.Transaction{
+		UserID:          testUserID,
+		CategoryID:      5,
+		Amount:          50000,
+		Type:            models.TransactionExpense,
+		TransactionDate: time.Date(2026, time.January, 10, 12, 0, 0, 0, time.UTC),
+	})
+
+	// 3. Assert
+	assert.NoError(t, err)
+}
+
+func TestTransactionWriter_DeleteTx_RefundsDrawnEnvelopeOnly(t *testing.T) {
+	ctx := context.Background()
+	tx := &fakeTx{}
+	mockTrxRepo := repoMocks.NewMockTransactionRepository(t)
+	mockWalletRepo := repoMocks.NewMockWalletRepository(t)
+	mockEnvelopeRepo := repoMocks.NewMockEnvelopeRepository(t)
+	writer := newTransactionWriter(mockTrxRepo, mockWalletRepo, mockEnvelopeRepo, repoMocks.NewMockBudgetRepository(t), repoMocks.NewMockNotificationRepository(t), repoMocks.NewMockPreferencesRepository(t))
+
+	t.Run("Success - Refunds Envelope Recorded On Transaction", func(t *testing.T) {
+		// 1. Setup: kategori sekarang (5) bisa saja sudah pindah induk; dana kembali ke amplop 9
+		envelopeID := int64(9)
+		trx := &models.Transaction{ID: 1, WalletID: 2, CategoryID: 5, Amount: 40000, Type: models.TransactionExpense, EnvelopeID: &envelopeID}
+		mockWalletRepo.EXPECT().UpdateBalanceTx(ctx, tx, int64(2), int64(40000)).Return(nil).Once()
+		mockEnvelopeRepo.EXPECT().RefundTx(ctx, tx, envelopeID, int64(40000)).Return(nil).Once()
+		mockTrxRepo.EXPECT().DeleteTx(ctx, tx, int64(1)).Return(nil).Once()
+
+		// 2. Act
+		err := writer.deleteTx(ctx, tx, trx)
+
+		// 3. Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("Success - No Refund When Nothing Was Drawn", func(t *testing.T) {
+		// 1. Setup: transaksi dibuat sebelum amplopnya ada
+		trx := &models.Transaction{ID: 3, WalletID: 2, CategoryID: 5, Amount: 15000, Type: models.TransactionExpense}
+		mockWalletRepo.EXPECT().UpdateBalanceTx(ctx, tx, int64(2), int64(15000)).Return(nil).Once()
+		mockTrxRepo.EXPECT().DeleteTx(ctx, tx, int64(3)).Return(nil).Once()
+
+		// 2. Act
+		err := writer.deleteTx(ctx, tx, trx)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		mockEnvelopeRepo.AssertNotCalled(t, "RefundTx", ctx, tx, mock.Anything, int64(15000))
+	})
 }
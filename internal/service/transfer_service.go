@@ -0,0 +1,316 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var ErrFeeCategoryRequired = errors.New("fee_category_id is required when fee is set")
+
+const defaultTransferPageSize = 20
+
+type TransferService interface {
+	CreateTransfer(ctx context.Context, req models.UpsertTransferRequest, userID uuid.UUID) (*models.Transfer, error)
+	GetUserTransfers(ctx context.Context, userID uuid.UUID, filter models.TransferFilter) (*models.TransferPage, error)
+	UpdateTransfer(ctx context.Context, transferID int64, req models.UpsertTransferRequest, userID uuid.UUID) (*models.Transfer, error)
+	DeleteTransfer(ctx context.Context, transferID int64, userID uuid.UUID) error
+}
+
+type transferService struct {
+	db           txBeginner
+	transferRepo repository.TransferRepository
+	categoryRepo repository.CategoryRepository
+	*transactionWriter
+}
+
+func NewTransferService(db txBeginner, transferRepo repository.TransferRepository, trxRepo repository.TransactionRepository, walletRepo repository.WalletRepository, categoryRepo repository.CategoryRepository, envelopeRepo repository.EnvelopeRepository, budgetRepo repository.BudgetRepository, notificationRepo repository.NotificationRepository, prefsRepo repository.PreferencesRepository) TransferService {
+	return &transferService{
+		db:                db,
+		transferRepo:      transferRepo,
+		categoryRepo:      categoryRepo,
+		transactionWriter: newTransactionWriter(trxRepo, walletRepo, envelopeRepo, budgetRepo, notificationRepo, prefsRepo),
+	}
+}
+
+func (s *transferService) checkWallets(ctx context.Context, req models.UpsertTransferRequest, userID uuid.UUID) error {
+	from, err := s.walletRepo.CheckOwnership(ctx, req.FromWalletID, userID)
+	if err != nil {
+		return fmt.Errorf("source wallet ownership validation failed: %w", ErrForbidden)
+	}
+	to, err := s.walletRepo.CheckOwnership(ctx, req.ToWalletID, userID)
+	if err != nil {
+		return fmt.Errorf("destination wallet ownership validation failed: %w", ErrForbidden)
+	}
+	if from.IsArchived() || to.IsArchived() {
+		return ErrWalletArchived
+	}
+	return nil
+}
+
+// checkOldWallets menolak perubahan transfer yang dompet lamanya sudah diarsipkan.
+func (s *transferService) checkOldWallets(ctx context.Context, t *models.Transfer, userID uuid.UUID) error {
+	if err := s.checkWalletActive(ctx, t.FromWalletID, userID); err != nil {
+		return err
+	}
+	return s.checkWalletActive(ctx, t.ToWalletID, userID)
+}
+
+// checkFeeCategory memvalidasi kategori pengeluaran untuk biaya admin. Mengembalikan nil jika
+// transfer tidak memiliki biaya.
+func (s *transferService) checkFeeCategory(ctx context.Context, req models.UpsertTransferRequest, userID uuid.UUID) (*models.Category, error) {
+	if req.Fee == 0 {
+		return nil, nil
+	}
+	if req.FeeCategoryID == nil {
+		return nil, ErrFeeCategoryRequired
+	}
+
+	category, err := s.categoryRepo.CheckOwnership(ctx, *req.FeeCategoryID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("fee category ownership validation failed: %w", ErrForbidden)
+	}
+	if category.Kind != models.TransactionExpense {
+		return nil, fmt.Errorf("transfer fee cannot use %s category: %w", category.Kind, ErrCategoryKindMismatch)
+	}
+	return category, nil
+}
+
+// applyBalancesTx menerapkan (sign = 1) atau membalikkan (sign = -1) kedua sisi transfer.
+// Biaya admin tidak termasuk; biaya dicatat sebagai transaksi lewat createFeeTx.
+func (s *transferService) applyBalancesTx(ctx context.Context, tx pgx.Tx, t *models.Transfer, sign int64) error {
+	if err := s.walletRepo.UpdateBalanceTx(ctx, tx, t.FromWalletID, -sign*t.Amount); err != nil {
+		return err
+	}
+	return s.walletRepo.UpdateBalanceTx(ctx, tx, t.ToWalletID, sign*t.Amount)
+}
+
+// createFeeTx mencatat biaya admin sebagai pengeluaran dari dompet asal, sehingga ikut
+// dihitung di dashboard, laporan, anggaran dan amplop.
+func (s *transferService) createFeeTx(ctx context.Context, tx pgx.Tx, t *models.Transfer, category *models.Category) error {
+	t.FeeTransactionID = nil
+	t.FeeCategoryID = nil
+	if t.Fee == 0 {
+		return nil
+	}
+
+	fee := &models.Transaction{
+		UserID:          t.UserID,
+		WalletID:        t.FromWalletID,
+		CategoryID:      category.ID,
+		Amount:          t.Fee,
+		Type:            models.TransactionExpense,
+		Description:     t.Description,
+		TransactionDate: t.TransferDate,
+		TransferID:      &t.ID,
+		CategoryName:    category.Name,
+	}
+	if err := s.createTx(ctx, tx, fee); err != nil {
+		return err
+	}
+
+	t.FeeTransactionID = &fee.ID
+	t.FeeCategoryID = &category.ID
+	return nil
+}
+
+// deleteFeeTx membalikkan dan menghapus transaksi biaya admin transfer, jika ada.
+func (s *transferService) deleteFeeTx(ctx context.Context, tx pgx.Tx, transferID int64) error {
+	fee, err := s.trxRepo.GetByTransferIDTx(ctx, tx, transferID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return s.deleteTx(ctx, tx, fee)
+}
+
+func (s *transferService) CreateTransfer(ctx context.Context, req models.UpsertTransferRequest, userID uuid.UUID) (*models.Transfer, error) {
+	if err := s.checkWallets(ctx, req, userID); err != nil {
+		return nil, err
+	}
+	feeCategory, err := s.checkFeeCategory(ctx, req, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &models.Transfer{
+		UserID:       userID,
+		FromWalletID: req.FromWalletID,
+		ToWalletID:   req.ToWalletID,
+		Amount:       req.Amount,
+		Fee:          req.Fee,
+		Description:  req.Description,
+	}
+	if req.TransferDate != nil {
+		t.TransferDate = *req.TransferDate
+	} else {
+		t.TransferDate = time.Now()
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback(ctx)
+
+	if err := s.applyBalancesTx(ctx, tx, t, 1); err != nil {
+		return nil, err
+	}
+
+	if err := s.transferRepo.CreateTx(ctx, tx, t); err != nil {
+		return nil, err
+	}
+
+	if err := s.createFeeTx(ctx, tx, t, feeCategory); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (s *transferService) GetUserTransfers(ctx context.Context, userID uuid.UUID, filter models.TransferFilter) (*models.TransferPage, error) {
+	if filter.Cursor != "" {
+		cursor, err := models.DecodeTransferCursor(filter.Cursor)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", err, ErrInvalidFilter)
+		}
+		filter.After = cursor
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = defaultTransferPageSize
+	}
+	// Ambil satu baris ekstra untuk mengetahui apakah masih ada halaman berikutnya
+	filter.Limit = limit + 1
+
+	transfers, err := s.transferRepo.List(ctx, userID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &models.TransferPage{Data: transfers}
+	if len(transfers) > limit {
+		page.Data = transfers[:limit]
+		last := page.Data[limit-1]
+		next := models.TransferCursor{TransferDate: last.TransferDate, ID: last.ID}.Encode()
+		page.NextCursor = &next
+	}
+
+	return page, nil
+}
+
+func (s *transferService) UpdateTransfer(ctx context.Context, transferID int64, req models.UpsertTransferRequest, userID uuid.UUID) (*models.Transfer, error) {
+	existing, err := s.transferRepo.CheckOwnership(ctx, transferID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("transfer ownership validation failed: %w", ErrForbidden)
+	}
+	// Pembalikan transfer lama mengubah saldo kedua dompet lamanya
+	if err := s.checkOldWallets(ctx, existing, userID); err != nil {
+		return nil, err
+	}
+	if err := s.checkWallets(ctx, req, userID); err != nil {
+		return nil, err
+	}
+	feeCategory, err := s.checkFeeCategory(ctx, req, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback(ctx)
+
+	t, err := s.transferRepo.GetByIDTx(ctx, tx, transferID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Balikkan kedua sisi transfer lama beserta transaksi biayanya
+	if err := s.applyBalancesTx(ctx, tx, t, -1); err != nil {
+		return nil, err
+	}
+	if err := s.deleteFeeTx(ctx, tx, t.ID); err != nil {
+		return nil, err
+	}
+
+	// 2. Terapkan data baru
+	t.FromWalletID = req.FromWalletID
+	t.ToWalletID = req.ToWalletID
+	t.Amount = req.Amount
+	t.Fee = req.Fee
+	t.Description = req.Description
+	if req.TransferDate != nil {
+		t.TransferDate = *req.TransferDate
+	}
+
+	if err := s.applyBalancesTx(ctx, tx, t, 1); err != nil {
+		return nil, err
+	}
+
+	if err := s.transferRepo.UpdateTx(ctx, tx, t); err != nil {
+		return nil, err
+	}
+
+	if err := s.createFeeTx(ctx, tx, t, feeCategory); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (s *transferService) DeleteTransfer(ctx context.Context, transferID int64, userID uuid.UUID) error {
+	existing, err := s.transferRepo.CheckOwnership(ctx, transferID, userID)
+	if err != nil {
+		return fmt.Errorf("transfer ownership validation failed: %w", ErrForbidden)
+	}
+	if err := s.checkOldWallets(ctx, existing, userID); err != nil {
+		return err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback(ctx)
+
+	t, err := s.transferRepo.GetByIDTx(ctx, tx, transferID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.applyBalancesTx(ctx, tx, t, -1); err != nil {
+		return err
+	}
+
+	if err := s.deleteFeeTx(ctx, tx, t.ID); err != nil {
+		return err
+	}
+
+	if err := s.transferRepo.DeleteTx(ctx, tx, transferID); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,329 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+
+	repoMocks "github.com/Udean777/uang-bijak-go/internal/repository/mocks"
+)
+
+type transferMocks struct {
+	transferRepo     *repoMocks.MockTransferRepository
+	trxRepo          *repoMocks.MockTransactionRepository
+	walletRepo       *repoMocks.MockWalletRepository
+	categoryRepo     *repoMocks.MockCategoryRepository
+	envelopeRepo     *repoMocks.MockEnvelopeRepository
+	budgetRepo       *repoMocks.MockBudgetRepository
+	notificationRepo *repoMocks.MockNotificationRepository
+	prefsRepo        *repoMocks.MockPreferencesRepository
+	tx               *fakeTx
+}
+
+func setupTransferService(t *testing.T) (TransferService, *repoMocks.MockTransferRepository, *repoMocks.MockWalletRepository) {
+	service, m := setupTransferServiceWithMocks(t)
+	return service, m.transferRepo, m.walletRepo
+}
+
+func setupTransferServiceWithMocks(t *testing.T) (TransferService, transferMocks) {
+	m := transferMocks{
+		transferRepo:     repoMocks.NewMockTransferRepository(t),
+		trxRepo:          repoMocks.NewMockTransactionRepository(t),
+		walletRepo:       repoMocks.NewMockWalletRepository(t),
+		categoryRepo:     repoMocks.NewMockCategoryRepository(t),
+		envelopeRepo:     repoMocks.NewMockEnvelopeRepository(t),
+		budgetRepo:       repoMocks.NewMockBudgetRepository(t),
+		notificationRepo: repoMocks.NewMockNotificationRepository(t),
+		prefsRepo:        repoMocks.NewMockPreferencesRepository(t),
+		tx:               &fakeTx{},
+	}
+	service := NewTransferService(&fakeDB{tx: m.tx}, m.transferRepo, m.trxRepo, m.walletRepo, m.categoryRepo, m.envelopeRepo, m.budgetRepo, m.notificationRepo, m.prefsRepo)
+	return service, m
+}
+
+func TestTransferService_GetUserTransfers(t *testing.T) {
+	service, mockTransferRepo, _ := setupTransferService(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+
+	t.Run("Success - Returns Next Cursor", func(t *testing.T) {
+		// 1. Setup: limit 1, repo mengembalikan 2 baris (1 ekstra)
+		rows := []models.Transfer{
+			{ID: 9, FromWalletID: 1, ToWalletID: 2, Amount: 50000},
+			{ID: 8, FromWalletID: 2, ToWalletID: 1, Amount: 10000},
+		}
+		mockTransferRepo.EXPECT().
+			List(ctx, testUserID, models.TransferFilter{Limit: 2}).
+			Return(rows, nil).
+			Once()
+
+		// 2. Act
+		page, err := service.GetUserTransfers(ctx, testUserID, models.TransferFilter{Limit: 1})
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Len(t, page.Data, 1)
+		if assert.NotNil(t, page.NextCursor) {
+			cursor, err := models.DecodeTransferCursor(*page.NextCursor)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(9), cursor.ID)
+		}
+	})
+
+	t.Run("Fail - Invalid Cursor", func(t *testing.T) {
+		// 2. Act
+		_, err := service.GetUserTransfers(ctx, testUserID, models.TransferFilter{Cursor: "%%%"})
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrInvalidFilter)
+	})
+}
+
+// Skenario sukses membutuhkan pgx.Tx sungguhan (Integration Test)
+func TestTransferService_CreateTransfer_Failure_Forbidden(t *testing.T) {
+	service, _, mockWalletRepo := setupTransferService(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+	req := models.UpsertTransferRequest{
+		FromWalletID: 1,
+		ToWalletID:   2,
+		Amount:       100000,
+		Fee:          2500,
+	}
+
+	t.Run("Fail - Source Wallet Ownership", func(t *testing.T) {
+		// 1. Setup
+		mockWalletRepo.EXPECT().
+			CheckOwnership(ctx, req.FromWalletID, testUserID).
+			Return(nil, errors.New("not found")).
+			Once()
+
+		// 2. Act
+		_, err := service.CreateTransfer(ctx, req, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("Fail - Destination Wallet Ownership", func(t *testing.T) {
+		// 1. Setup
+		mockWalletRepo.EXPECT().
+			CheckOwnership(ctx, req.FromWalletID, testUserID).
+			Return(&models.Wallet{}, nil).
+			Once()
+
+		mockWalletRepo.EXPECT().
+			CheckOwnership(ctx, req.ToWalletID, testUserID).
+			Return(nil, errors.New("not found")).
+			Once()
+
+		// 2. Act
+		_, err := service.CreateTransfer(ctx, req, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("Fail - Fee Without Category", func(t *testing.T) {
+		// 1. Setup
+		mockWalletRepo.EXPECT().CheckOwnership(ctx, req.FromWalletID, testUserID).Return(&models.Wallet{}, nil).Once()
+		mockWalletRepo.EXPECT().CheckOwnership(ctx, req.ToWalletID, testUserID).Return(&models.Wallet{}, nil).Once()
+
+		// 2. Act
+		_, err := service.CreateTransfer(ctx, req, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrFeeCategoryRequired)
+	})
+}
+
+func TestTransferService_CreateTransfer_RecordsFeeAsExpense(t *testing.T) {
+	service, m := setupTransferServiceWithMocks(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+	feeCategoryID := int64(30)
+	envelopeID := int64(5)
+	req := models.UpsertTransferRequest{
+		FromWalletID:  1,
+		ToWalletID:    2,
+		Amount:        100000,
+		Fee:           2500,
+		FeeCategoryID: &feeCategoryID,
+	}
+
+	t.Run("Fail - Fee Category Is Income", func(t *testing.T) {
+		// 1. Setup
+		m.walletRepo.EXPECT().CheckOwnership(ctx, int64(1), testUserID).Return(&models.Wallet{}, nil).Once()
+		m.walletRepo.EXPECT().CheckOwnership(ctx, int64(2), testUserID).Return(&models.Wallet{}, nil).Once()
+		m.categoryRepo.EXPECT().
+			CheckOwnership(ctx, feeCategoryID, testUserID).
+			Return(&models.Category{ID: feeCategoryID, Kind: models.TransactionIncome}, nil).
+			Once()
+
+		// 2. Act
+		_, err := service.CreateTransfer(ctx, req, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrCategoryKindMismatch)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		// 1. Setup
+		m.walletRepo.EXPECT().CheckOwnership(ctx, int64(1), testUserID).Return(&models.Wallet{}, nil).Once()
+		m.walletRepo.EXPECT().CheckOwnership(ctx, int64(2), testUserID).Return(&models.Wallet{}, nil).Once()
+		m.categoryRepo.EXPECT().
+			CheckOwnership(ctx, feeCategoryID, testUserID).
+			Return(&models.Category{ID: feeCategoryID, Name: "Biaya Admin", Kind: models.TransactionExpense}, nil).
+			Once()
+
+		// Transfer hanya memindahkan nominal; biaya dipotong lewat transaksi pengeluaran
+		m.walletRepo.EXPECT().UpdateBalanceTx(ctx, m.tx, int64(1), int64(-100000)).Return(nil).Once()
+		m.walletRepo.EXPECT().UpdateBalanceTx(ctx, m.tx, int64(2), int64(100000)).Return(nil).Once()
+		m.transferRepo.EXPECT().
+			CreateTx(ctx, m.tx, mock.AnythingOfType("*models.Transfer")).
+			Run(func(ctx context.Context, tx pgx.Tx, transfer *models.Transfer) { transfer.ID = 12 }).
+			Return(nil).
+			Once()
+
+		m.walletRepo.EXPECT().UpdateBalanceTx(ctx, m.tx, int64(1), int64(-2500)).Return(nil).Once()
+		m.trxRepo.EXPECT().
+			CreateTx(ctx, m.tx, mock.MatchedBy(func(fee *models.Transaction) bool {
+				return fee.TransferID != nil && *fee.TransferID == 12 &&
+					fee.WalletID == 1 && fee.CategoryID == feeCategoryID &&
+					fee.Amount == 2500 && fee.Type == models.TransactionExpense &&
+					fee.EnvelopeID != nil && *fee.EnvelopeID == envelopeID
+			})).
+			Run(func(ctx context.Context, tx pgx.Tx, fee *models.Transaction) { fee.ID = 77 }).
+			Return(nil).
+			Once()
+		m.envelopeRepo.EXPECT().DrawDownTx(ctx, m.tx, feeCategoryID, int64(2500)).Return(&envelopeID, nil).Once()
+		m.prefsRepo.EXPECT().GetByUserID(ctx, testUserID).Return(nil, pgx.ErrNoRows).Once()
+		m.budgetRepo.EXPECT().
+			GetUsageForCategoryTx(ctx, m.tx, testUserID, feeCategoryID, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, nil).
+			Once()
+
+		// 2. Act
+		transfer, err := service.CreateTransfer(ctx, req, testUserID)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.True(t, m.tx.committed)
+		if assert.NotNil(t, transfer.FeeTransactionID) {
+			assert.Equal(t, int64(77), *transfer.FeeTransactionID)
+		}
+		assert.Equal(t, &feeCategoryID, transfer.FeeCategoryID)
+	})
+}
+
+func TestTransferService_DeleteTransfer_ReversesFee(t *testing.T) {
+	service, m := setupTransferServiceWithMocks(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+	transferID := int64(12)
+	stored := &models.Transfer{ID: transferID, UserID: testUserID, FromWalletID: 1, ToWalletID: 2, Amount: 100000, Fee: 2500}
+	envelopeID := int64(5)
+	fee := &models.Transaction{ID: 77, WalletID: 1, CategoryID: 30, Amount: 2500, Type: models.TransactionExpense, TransferID: &transferID, EnvelopeID: &envelopeID}
+
+	// 1. Setup
+	m.transferRepo.EXPECT().CheckOwnership(ctx, transferID, testUserID).Return(stored, nil).Once()
+	m.walletRepo.EXPECT().CheckOwnership(ctx, int64(1), testUserID).Return(&models.Wallet{ID: 1}, nil).Once()
+	m.walletRepo.EXPECT().CheckOwnership(ctx, int64(2), testUserID).Return(&models.Wallet{ID: 2}, nil).Once()
+	m.transferRepo.EXPECT().GetByIDTx(ctx, m.tx, transferID).Return(stored, nil).Once()
+	m.walletRepo.EXPECT().UpdateBalanceTx(ctx, m.tx, int64(1), int64(100000)).Return(nil).Once()
+	m.walletRepo.EXPECT().UpdateBalanceTx(ctx, m.tx, int64(2), int64(-100000)).Return(nil).Once()
+
+	m.trxRepo.EXPECT().GetByTransferIDTx(ctx, m.tx, transferID).Return(fee, nil).Once()
+	m.walletRepo.EXPECT().UpdateBalanceTx(ctx, m.tx, int64(1), int64(2500)).Return(nil).Once()
+	m.envelopeRepo.EXPECT().RefundTx(ctx, m.tx, envelopeID, int64(2500)).Return(nil).Once()
+	m.trxRepo.EXPECT().DeleteTx(ctx, m.tx, int64(77)).Return(nil).Once()
+
+	m.transferRepo.EXPECT().DeleteTx(ctx, m.tx, transferID).Return(nil).Once()
+
+	// 2. Act
+	err := service.DeleteTransfer(ctx, transferID, testUserID)
+
+	// 3. Assert
+	assert.NoError(t, err)
+	assert.True(t, m.tx.committed)
+}
+
+func TestTransferService_UpdateAndDelete_Failure_WalletArchived(t *testing.T) {
+	service, m := setupTransferServiceWithMocks(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+	transferID := int64(12)
+	archivedAt := time.Now()
+	stored := &models.Transfer{ID: transferID, UserID: testUserID, FromWalletID: 1, ToWalletID: 2, Amount: 100000}
+
+	t.Run("Update - Old Destination Archived", func(t *testing.T) {
+		// 1. Setup: transfer dipindah ke dompet lain, tapi dompet tujuan lama sudah diarsipkan
+		m.transferRepo.EXPECT().CheckOwnership(ctx, transferID, testUserID).Return(stored, nil).Once()
+		m.walletRepo.EXPECT().CheckOwnership(ctx, int64(1), testUserID).Return(&models.Wallet{ID: 1}, nil).Once()
+		m.walletRepo.EXPECT().CheckOwnership(ctx, int64(2), testUserID).Return(&models.Wallet{ID: 2, ArchivedAt: &archivedAt}, nil).Once()
+
+		// 2. Act
+		_, err := service.UpdateTransfer(ctx, transferID, models.UpsertTransferRequest{FromWalletID: 1, ToWalletID: 3, Amount: 100000}, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrWalletArchived)
+		m.walletRepo.AssertNotCalled(t, "UpdateBalanceTx")
+	})
+
+	t.Run("Delete - Source Archived", func(t *testing.T) {
+		// 1. Setup
+		m.transferRepo.EXPECT().CheckOwnership(ctx, transferID, testUserID).Return(stored, nil).Once()
+		m.walletRepo.EXPECT().CheckOwnership(ctx, int64(1), testUserID).Return(&models.Wallet{ID: 1, ArchivedAt: &archivedAt}, nil).Once()
+
+		// 2. Act
+		err := service.DeleteTransfer(ctx, transferID, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrWalletArchived)
+		m.walletRepo.AssertNotCalled(t, "UpdateBalanceTx")
+	})
+}
+
+func TestTransferService_UpdateAndDelete_Failure_Forbidden(t *testing.T) {
+	service, mockTransferRepo, mockWalletRepo := setupTransferService(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+	transferID := int64(7)
+
+	t.Run("Update - Not Owner", func(t *testing.T) {
+		// 1. Setup
+		mockTransferRepo.EXPECT().
+			CheckOwnership(ctx, transferID, testUserID).
+			Return(nil, errors.New("not found")).
+			Once()
+
+		// 2. Act
+		_, err := service.UpdateTransfer(ctx, transferID, models.UpsertTransferRequest{FromWalletID: 1, ToWalletID: 2, Amount: 1}, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrForbidden)
+		mockWalletRepo.AssertNotCalled(t, "CheckOwnership")
+	})
+
+	t.Run("Delete - Not Owner", func(t *testing.T) {
+		// 1. Setup
+		mockTransferRepo.EXPECT().
+			CheckOwnership(ctx, transferID, testUserID).
+			Return(nil, errors.New("not found")).
+			Once()
+
+		// 2. Act
+		err := service.DeleteTransfer(ctx, transferID, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
@@ -2,25 +2,116 @@ package service
 
 import (
 	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 
 	"github.com/Udean777/uang-bijak-go/internal/models"
 	"github.com/Udean777/uang-bijak-go/internal/repository"
 )
 
+var (
+	ErrEmailTaken = errors.New("email is already in use")
+	ErrEmptyName  = errors.New("name must not be empty")
+)
+
 type UserService interface {
 	GetUserProfile(ctx context.Context, userID uuid.UUID) (*models.User, error)
+	UpdateProfile(ctx context.Context, userID uuid.UUID, req models.UpdateProfileRequest) (*models.User, error)
+	GetPreferences(ctx context.Context, userID uuid.UUID) (*models.UserPreferences, error)
+	UpdatePreferences(ctx context.Context, userID uuid.UUID, req models.UpdatePreferencesRequest) (*models.UserPreferences, error)
 }
 
 type userService struct {
-	userRepo repository.UserRepository
+	userRepo      repository.UserRepository
+	prefsRepo     repository.PreferencesRepository
+	emailVerifier EmailVerificationService
 }
 
-func NewUserService(repo repository.UserRepository) UserService {
-	return &userService{userRepo: repo}
+func NewUserService(repo repository.UserRepository, prefsRepo repository.PreferencesRepository, emailVerifier EmailVerificationService) UserService {
+	return &userService{userRepo: repo, prefsRepo: prefsRepo, emailVerifier: emailVerifier}
 }
 
 func (s *userService) GetUserProfile(ctx context.Context, userID uuid.UUID) (*models.User, error) {
 	return s.userRepo.GetUserByID(ctx, userID)
 }
+
+// UpdateProfile mengubah nama dan/atau email. Jika email berganti, status verifikasi direset
+// dan email verifikasi dikirim ke alamat baru; token verifikasi lama otomatis tidak berlaku
+// karena terikat ke alamat lama.
+func (s *userService) UpdateProfile(ctx context.Context, userID uuid.UUID, req models.UpdateProfileRequest) (*models.User, error) {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	name, email := user.Name, user.Email
+	if req.Name != nil {
+		name = strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, ErrEmptyName
+		}
+	}
+	if req.Email != nil {
+		email = strings.TrimSpace(*req.Email)
+	}
+
+	updated, err := s.userRepo.UpdateProfile(ctx, userID, name, email)
+	if errors.Is(err, repository.ErrDuplicate) {
+		return nil, ErrEmailTaken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if updated.Email != user.Email {
+		if err := s.emailVerifier.SendVerification(ctx, updated); err != nil {
+			log.Printf("Gagal mengirim email verifikasi ke user %s: %v", updated.ID, err)
+		}
+	}
+
+	return updated, nil
+}
+
+func (s *userService) GetPreferences(ctx context.Context, userID uuid.UUID) (*models.UserPreferences, error) {
+	return loadPreferences(ctx, s.prefsRepo, userID)
+}
+
+// UpdatePreferences menerapkan perubahan sebagian di atas preferensi saat ini (atau default).
+func (s *userService) UpdatePreferences(ctx context.Context, userID uuid.UUID, req models.UpdatePreferencesRequest) (*models.UserPreferences, error) {
+	prefs, err := loadPreferences(ctx, s.prefsRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Apply(prefs)
+	if err := s.prefsRepo.Upsert(ctx, prefs); err != nil {
+		return nil, err
+	}
+	return prefs, nil
+}
+
+// loadLocation mengembalikan zona waktu preferensi user, dipakai untuk menghitung jadwal.
+func loadLocation(ctx context.Context, repo repository.PreferencesRepository, userID uuid.UUID) (*time.Location, error) {
+	prefs, err := loadPreferences(ctx, repo, userID)
+	if err != nil {
+		return nil, err
+	}
+	return prefs.Location(), nil
+}
+
+// loadPreferences mengembalikan preferensi user, atau default jika belum pernah disimpan.
+func loadPreferences(ctx context.Context, repo repository.PreferencesRepository, userID uuid.UUID) (*models.UserPreferences, error) {
+	prefs, err := repo.GetByUserID(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return models.DefaultUserPreferences(userID), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return prefs, nil
+}
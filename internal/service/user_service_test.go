@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Udean777/uang-bijak-go/internal/models"
+	"github.com/Udean777/uang-bijak-go/internal/repository"
+	repoMocks "github.com/Udean777/uang-bijak-go/internal/repository/mocks"
+	serviceMocks "github.com/Udean777/uang-bijak-go/internal/service/mocks"
+)
+
+type userServiceMocks struct {
+	userRepo  *repoMocks.MockUserRepository
+	prefsRepo *repoMocks.MockPreferencesRepository
+	verifier  *serviceMocks.MockEmailVerificationService
+}
+
+func setupUserService(t *testing.T) (UserService, userServiceMocks) {
+	m := userServiceMocks{
+		userRepo:  repoMocks.NewMockUserRepository(t),
+		prefsRepo: repoMocks.NewMockPreferencesRepository(t),
+		verifier:  serviceMocks.NewMockEmailVerificationService(t),
+	}
+	return NewUserService(m.userRepo, m.prefsRepo, m.verifier), m
+}
+
+func strPtr(s string) *string { return &s }
+
+func TestUserService_UpdateProfile(t *testing.T) {
+	service, m := setupUserService(t)
+	ctx := context.Background()
+
+	verifiedAt := time.Now()
+	testUser := &models.User{ID: uuid.New(), Name: "Budi", Email: "budi@example.com", EmailVerifiedAt: &verifiedAt}
+
+	t.Run("Success - Name Only Keeps Verification", func(t *testing.T) {
+		// 1. Setup
+		updated := *testUser
+		updated.Name = "Budi Santoso"
+		m.userRepo.EXPECT().GetUserByID(ctx, testUser.ID).Return(testUser, nil).Once()
+		m.userRepo.EXPECT().UpdateProfile(ctx, testUser.ID, "Budi Santoso", "budi@example.com").Return(&updated, nil).Once()
+
+		// 2. Act
+		user, err := service.UpdateProfile(ctx, testUser.ID, models.UpdateProfileRequest{Name: strPtr("  Budi Santoso ")})
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "Budi Santoso", user.Name)
+		assert.True(t, user.EmailVerified())
+		m.verifier.AssertNotCalled(t, "SendVerification", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success - Email Change Sends Verification To New Address", func(t *testing.T) {
+		// 1. Setup
+		updated := &models.User{ID: testUser.ID, Name: "Budi", Email: "budi.baru@example.com"}
+		m.userRepo.EXPECT().GetUserByID(ctx, testUser.ID).Return(testUser, nil).Once()
+		m.userRepo.EXPECT().UpdateProfile(ctx, testUser.ID, "Budi", "budi.baru@example.com").Return(updated, nil).Once()
+		m.verifier.EXPECT().SendVerification(ctx, updated).Return(nil).Once()
+
+		// 2. Act
+		user, err := service.UpdateProfile(ctx, testUser.ID, models.UpdateProfileRequest{Email: strPtr("budi.baru@example.com")})
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.False(t, user.EmailVerified())
+	})
+
+	t.Run("Success - Email Change Succeeds Even If Mail Fails", func(t *testing.T) {
+		// 1. Setup
+		updated := &models.User{ID: testUser.ID, Name: "Budi", Email: "budi.baru@example.com"}
+		m.userRepo.EXPECT().GetUserByID(ctx, testUser.ID).Return(testUser, nil).Once()
+		m.userRepo.EXPECT().UpdateProfile(ctx, testUser.ID, "Budi", "budi.baru@example.com").Return(updated, nil).Once()
+		m.verifier.EXPECT().SendVerification(ctx, updated).Return(errors.New("smtp down")).Once()
+
+		// 2. Act
+		user, err := service.UpdateProfile(ctx, testUser.ID, models.UpdateProfileRequest{Email: strPtr("budi.baru@example.com")})
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "budi.baru@example.com", user.Email)
+	})
+
+	t.Run("Fail - Email Taken", func(t *testing.T) {
+		// 1. Setup
+		m.userRepo.EXPECT().GetUserByID(ctx, testUser.ID).Return(testUser, nil).Once()
+		m.userRepo.EXPECT().UpdateProfile(ctx, testUser.ID, "Budi", "ani@example.com").Return(nil, repository.ErrDuplicate).Once()
+
+		// 2. Act
+		_, err := service.UpdateProfile(ctx, testUser.ID, models.UpdateProfileRequest{Email: strPtr("ani@example.com")})
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrEmailTaken)
+	})
+
+	t.Run("Fail - Blank Name", func(t *testing.T) {
+		// 1. Setup
+		m.userRepo.EXPECT().GetUserByID(ctx, testUser.ID).Return(testUser, nil).Once()
+
+		// 2. Act
+		_, err := service.UpdateProfile(ctx, testUser.ID, models.UpdateProfileRequest{Name: strPtr("   ")})
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrEmptyName)
+	})
+}
+
+func TestUserService_Preferences(t *testing.T) {
+	service, m := setupUserService(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+
+	t.Run("Get - Defaults When Never Saved", func(t *testing.T) {
+		// 1. Setup
+		m.prefsRepo.EXPECT().GetByUserID(ctx, testUserID).Return(nil, pgx.ErrNoRows).Once()
+
+		// 2. Act
+		prefs, err := service.GetPreferences(ctx, testUserID)
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Equal(t, models.DefaultUserPreferences(testUserID), prefs)
+	})
+
+	t.Run("Update - Partial Change On Top Of Stored Values", func(t *testing.T) {
+		// 1. Setup
+		stored := models.DefaultUserPreferences(testUserID)
+		stored.Currency = "USD"
+		m.prefsRepo.EXPECT().GetByUserID(ctx, testUserID).Return(stored, nil).Once()
+
+		var saved *models.UserPreferences
+		m.prefsRepo.EXPECT().
+			Upsert(ctx, mock.AnythingOfType("*models.UserPreferences")).
+			Run(func(ctx context.Context, p *models.UserPreferences) { saved = p }).
+			Return(nil).
+			Once()
+
+		startDay := 25
+		firstDay := 0
+
+		// 2. Act
+		prefs, err := service.UpdatePreferences(ctx, testUserID, models.UpdatePreferencesRequest{MonthStartDay: &startDay, FirstDayOfWeek: &firstDay})
+
+		// 3. Assert
+		assert.NoError(t, err)
+		assert.Equal(t, prefs, saved)
+		assert.Equal(t, "USD", saved.Currency)
+		assert.Equal(t, "Asia/Jakarta", saved.Timezone)
+		assert.Equal(t, 25, saved.MonthStartDay)
+		assert.Equal(t, 0, saved.FirstDayOfWeek)
+	})
+
+	t.Run("Update - Repo Error", func(t *testing.T) {
+		// 1. Setup
+		m.prefsRepo.EXPECT().GetByUserID(ctx, testUserID).Return(nil, errors.New("db error")).Once()
+
+		// 2. Act
+		prefs, err := service.UpdatePreferences(ctx, testUserID, models.UpdatePreferencesRequest{Currency: strPtr("EUR")})
+
+		// 3. Assert
+		assert.Error(t, err)
+		assert.Nil(t, prefs)
+	})
+}
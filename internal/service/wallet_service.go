@@ -2,26 +2,67 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/Udean777/uang-bijak-go/internal/models"
 	"github.com/Udean777/uang-bijak-go/internal/repository"
 	"github.com/google/uuid"
 )
 
+var (
+	ErrConflict   = errors.New("conflict")
+	ErrSameWallet = errors.New("target wallet must be different from the deleted wallet")
+	// ErrWalletArchived: dompet yang diarsipkan dibekukan, saldonya tidak boleh berubah lagi
+	ErrWalletArchived = errors.New("wallet is archived")
+	// ErrWalletHasBalance: saldo dompet harus dipindahkan (reassign) sebelum dompet dihapus
+	ErrWalletHasBalance = errors.New("wallet still has a balance")
+)
+
+// WalletInUseError dikembalikan saat dompet yang akan dihapus masih memiliki riwayat.
+type WalletInUseError struct {
+	TransactionCount int64
+	TransferCount    int64
+	RecurringCount   int64
+	BillCount        int64
+}
+
+func (e *WalletInUseError) Error() string {
+	return fmt.Sprintf("wallet still has %d transactions, %d transfers, %d recurring transactions and %d bills",
+		e.TransactionCount, e.TransferCount, e.RecurringCount, e.BillCount)
+}
+
+func (e *WalletInUseError) Is(target error) bool {
+	return target == ErrConflict
+}
+
 type WalletService interface {
 	CreateWallet(ctx context.Context, req models.CreateWalletRequest, userID uuid.UUID) (*models.Wallet, error)
 	GetUserWallets(ctx context.Context, userID uuid.UUID) ([]models.Wallet, error)
 	UpdateWallet(ctx context.Context, walletID int64, req models.UpdateWalletRequest, userID uuid.UUID) error
 	DeleteWallet(ctx context.Context, walletID int64, userID uuid.UUID) error
+	ReassignAndDeleteWallet(ctx context.Context, walletID int64, targetWalletID int64, userID uuid.UUID) error
+	ArchiveWallet(ctx context.Context, walletID int64, userID uuid.UUID) error
 }
 
 type walletService struct {
-	walletRepo repository.WalletRepository
-	// TODO: Tambahkan transactionRepo di sini
+	db            txBeginner
+	walletRepo    repository.WalletRepository
+	trxRepo       repository.TransactionRepository
+	transferRepo  repository.TransferRepository
+	recurringRepo repository.RecurringRepository
+	billRepo      repository.BillRepository
 }
 
-func NewWalletService(repo repository.WalletRepository) WalletService {
-	return &walletService{walletRepo: repo}
+func NewWalletService(db txBeginner, repo repository.WalletRepository, trxRepo repository.TransactionRepository, transferRepo repository.TransferRepository, recurringRepo repository.RecurringRepository, billRepo repository.BillRepository) WalletService {
+	return &walletService{
+		db:            db,
+		walletRepo:    repo,
+		trxRepo:       trxRepo,
+		transferRepo:  transferRepo,
+		recurringRepo: recurringRepo,
+		billRepo:      billRepo,
+	}
 }
 
 func (s *walletService) CreateWallet(ctx context.Context, req models.CreateWalletRequest, userID uuid.UUID) (*models.Wallet, error) {
@@ -52,15 +93,119 @@ func (s *walletService) UpdateWallet(ctx context.Context, walletID int64, req mo
 }
 
 func (s *walletService) DeleteWallet(ctx context.Context, walletID int64, userID uuid.UUID) error {
-	_, err := s.walletRepo.CheckOwnership(ctx, walletID, userID)
+	wallet, err := s.walletRepo.CheckOwnership(ctx, walletID, userID)
 	if err != nil {
 		return ErrForbidden
 	}
 
-	// TODO: Tambahkan pengecekan di sini
-	// "Apakah dompet ini masih memiliki transaksi?"
-	// "Apakah saldonya nol?"
-	// Untuk saat ini, kita izinkan delete.
+	trxCount, err := s.trxRepo.CountByWalletID(ctx, walletID)
+	if err != nil {
+		return err
+	}
+	transferCount, err := s.transferRepo.CountByWalletID(ctx, walletID)
+	if err != nil {
+		return err
+	}
+	recurringCount, err := s.recurringRepo.CountByWalletID(ctx, walletID)
+	if err != nil {
+		return err
+	}
+	billCount, err := s.billRepo.CountByWalletID(ctx, walletID)
+	if err != nil {
+		return err
+	}
+
+	// Riwayat & jadwal tidak boleh hilang diam-diam: minta user memindahkan atau mengarsipkan
+	if trxCount > 0 || transferCount > 0 || recurringCount > 0 || billCount > 0 {
+		return &WalletInUseError{
+			TransactionCount: trxCount,
+			TransferCount:    transferCount,
+			RecurringCount:   recurringCount,
+			BillCount:        billCount,
+		}
+	}
+
+	// Saldo awal tanpa riwayat pun bagian dari kekayaan bersih, jangan dibuang diam-diam
+	if wallet.Balance != 0 {
+		return fmt.Errorf("wallet balance is %d: %w", wallet.Balance, ErrWalletHasBalance)
+	}
 
 	return s.walletRepo.Delete(ctx, walletID)
 }
+
+// ReassignAndDeleteWallet memindahkan seluruh transaksi, transfer, transaksi berulang & tagihan ke dompet target, lalu
+// menghapus dompet asal dalam satu pgx.Tx. Saldo dompet asal (termasuk saldo awal) dipindahkan
+// utuh ke dompet target agar total saldo user tidak berubah.
+func (s *walletService) ReassignAndDeleteWallet(ctx context.Context, walletID int64, targetWalletID int64, userID uuid.UUID) error {
+	if walletID == targetWalletID {
+		return ErrSameWallet
+	}
+
+	if _, err := s.walletRepo.CheckOwnership(ctx, walletID, userID); err != nil {
+		return ErrForbidden
+	}
+	target, err := s.walletRepo.CheckOwnership(ctx, targetWalletID, userID)
+	if err != nil {
+		return fmt.Errorf("target wallet ownership validation failed: %w", ErrForbidden)
+	}
+	if target.IsArchived() {
+		return fmt.Errorf("target wallet: %w", ErrWalletArchived)
+	}
+
+	// Transfer antara kedua dompet akan menjadi transfer ke dompet yang sama
+	between, err := s.transferRepo.CountBetweenWallets(ctx, walletID, targetWalletID)
+	if err != nil {
+		return err
+	}
+	if between > 0 {
+		return fmt.Errorf("%d transfers exist between both wallets: %w", between, ErrConflict)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback(ctx)
+
+	// Kunci dompet asal agar saldonya tidak berubah sebelum dipindahkan
+	source, err := s.walletRepo.GetForUpdateTx(ctx, tx, walletID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.trxRepo.ReassignWalletTx(ctx, tx, walletID, targetWalletID); err != nil {
+		return err
+	}
+
+	if _, err := s.transferRepo.ReassignWalletTx(ctx, tx, walletID, targetWalletID); err != nil {
+		return err
+	}
+
+	if err := s.recurringRepo.ReassignWalletTx(ctx, tx, walletID, targetWalletID); err != nil {
+		return err
+	}
+
+	if err := s.billRepo.ReassignWalletTx(ctx, tx, walletID, targetWalletID); err != nil {
+		return err
+	}
+
+	if err := s.walletRepo.UpdateBalanceTx(ctx, tx, targetWalletID, source.Balance); err != nil {
+		return err
+	}
+
+	if err := s.walletRepo.DeleteTx(ctx, tx, walletID); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (s *walletService) ArchiveWallet(ctx context.Context, walletID int64, userID uuid.UUID) error {
+	_, err := s.walletRepo.CheckOwnership(ctx, walletID, userID)
+	if err != nil {
+		return ErrForbidden
+	}
+
+	return s.walletRepo.Archive(ctx, walletID)
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
@@ -14,15 +16,60 @@ import (
 	mocks "github.com/Udean777/uang-bijak-go/internal/repository/mocks"
 )
 
+// fakeTx menggantikan pgx.Tx di alur service yang semua query-nya lewat repo tiruan;
+// hanya Commit & Rollback yang dipanggil langsung oleh service.
+type fakeTx struct {
+	pgx.Tx
+	committed bool
+}
+
+func (tx *fakeTx) Commit(ctx context.Context) error {
+	tx.committed = true
+	return nil
+}
+
+func (tx *fakeTx) Rollback(ctx context.Context) error {
+	return nil
+}
+
+type fakeDB struct {
+	tx *fakeTx
+}
+
+func (db *fakeDB) Begin(ctx context.Context) (pgx.Tx, error) {
+	return db.tx, nil
+}
+
+type walletMocks struct {
+	walletRepo    *mocks.MockWalletRepository
+	trxRepo       *mocks.MockTransactionRepository
+	transferRepo  *mocks.MockTransferRepository
+	recurringRepo *mocks.MockRecurringRepository
+	billRepo      *mocks.MockBillRepository
+	tx            *fakeTx
+}
+
 // Helper setup
-func setupWalletService(t *testing.T) (WalletService, *mocks.MockWalletRepository) {
-	mockRepo := mocks.NewMockWalletRepository(t)
-	service := NewWalletService(mockRepo)
-	return service, mockRepo
+func setupWalletService(t *testing.T) (WalletService, *mocks.MockWalletRepository, *mocks.MockTransactionRepository, *mocks.MockTransferRepository) {
+	service, m := setupWalletServiceWithMocks(t)
+	return service, m.walletRepo, m.trxRepo, m.transferRepo
+}
+
+func setupWalletServiceWithMocks(t *testing.T) (WalletService, walletMocks) {
+	m := walletMocks{
+		walletRepo:    mocks.NewMockWalletRepository(t),
+		trxRepo:       mocks.NewMockTransactionRepository(t),
+		transferRepo:  mocks.NewMockTransferRepository(t),
+		recurringRepo: mocks.NewMockRecurringRepository(t),
+		billRepo:      mocks.NewMockBillRepository(t),
+		tx:            &fakeTx{},
+	}
+	service := NewWalletService(&fakeDB{tx: m.tx}, m.walletRepo, m.trxRepo, m.transferRepo, m.recurringRepo, m.billRepo)
+	return service, m
 }
 
 func TestWalletService_CreateWallet(t *testing.T) {
-	service, mockRepo := setupWalletService(t)
+	service, mockRepo, _, _ := setupWalletService(t)
 	ctx := context.Background()
 	testUserID := uuid.New()
 	req := models.CreateWalletRequest{Name: "Dompet Tunai", InitialBalance: 50000} // 50000 sen = Rp 500
@@ -55,7 +102,7 @@ func TestWalletService_CreateWallet(t *testing.T) {
 }
 
 func TestWalletService_UpdateWallet(t *testing.T) {
-	service, mockRepo := setupWalletService(t)
+	service, mockRepo, _, _ := setupWalletService(t)
 	ctx := context.Background()
 
 	testUserID := uuid.New()
@@ -105,7 +152,7 @@ func TestWalletService_UpdateWallet(t *testing.T) {
 }
 
 func TestWalletService_DeleteWallet(t *testing.T) {
-	service, mockRepo := setupWalletService(t)
+	service, m := setupWalletServiceWithMocks(t)
 	ctx := context.Background()
 
 	testUserID := uuid.New()
@@ -113,12 +160,33 @@ func TestWalletService_DeleteWallet(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		// 1. Setup
-		mockRepo.EXPECT().
+		m.walletRepo.EXPECT().
 			CheckOwnership(ctx, walletID, testUserID).
 			Return(&models.Wallet{ID: walletID, UserID: testUserID}, nil).
 			Once()
 
-		mockRepo.EXPECT().
+		// Dompet tanpa riwayat boleh langsung dihapus
+		m.trxRepo.EXPECT().
+			CountByWalletID(ctx, walletID).
+			Return(int64(0), nil).
+			Once()
+
+		m.transferRepo.EXPECT().
+			CountByWalletID(ctx, walletID).
+			Return(int64(0), nil).
+			Once()
+
+		m.recurringRepo.EXPECT().
+			CountByWalletID(ctx, walletID).
+			Return(int64(0), nil).
+			Once()
+
+		m.billRepo.EXPECT().
+			CountByWalletID(ctx, walletID).
+			Return(int64(0), nil).
+			Once()
+
+		m.walletRepo.EXPECT().
 			Delete(ctx, walletID).
 			Return(nil).
 			Once()
@@ -133,7 +201,7 @@ func TestWalletService_DeleteWallet(t *testing.T) {
 	t.Run("Fail - Forbidden (Not Owner)", func(t *testing.T) {
 		// 1. Setup
 		otherUserID := uuid.New()
-		mockRepo.EXPECT().
+		m.walletRepo.EXPECT().
 			CheckOwnership(ctx, walletID, otherUserID).
 			Return(nil, errors.New("not found")).
 			Once()
@@ -145,6 +213,215 @@ func TestWalletService_DeleteWallet(t *testing.T) {
 		assert.Error(t, err)
 		assert.Equal(t, ErrForbidden, err)
 
-		mockRepo.AssertNotCalled(t, "Delete")
+		m.walletRepo.AssertNotCalled(t, "Delete")
+	})
+	t.Run("Fail - Conflict (Still Has Transactions)", func(t *testing.T) {
+		// 1. Setup
+		m.walletRepo.EXPECT().
+			CheckOwnership(ctx, int64(2), testUserID).
+			Return(&models.Wallet{ID: 2, UserID: testUserID}, nil).
+			Once()
+
+		m.trxRepo.EXPECT().
+			CountByWalletID(ctx, int64(2)).
+			Return(int64(12), nil).
+			Once()
+
+		m.transferRepo.EXPECT().
+			CountByWalletID(ctx, int64(2)).
+			Return(int64(1), nil).
+			Once()
+
+		m.recurringRepo.EXPECT().
+			CountByWalletID(ctx, int64(2)).
+			Return(int64(0), nil).
+			Once()
+
+		m.billRepo.EXPECT().
+			CountByWalletID(ctx, int64(2)).
+			Return(int64(0), nil).
+			Once()
+
+		// 2. Act
+		err := service.DeleteWallet(ctx, 2, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrConflict)
+		var inUse *WalletInUseError
+		assert.ErrorAs(t, err, &inUse)
+		assert.Equal(t, int64(12), inUse.TransactionCount)
+		assert.Equal(t, int64(1), inUse.TransferCount)
+	})
+
+	t.Run("Fail - Conflict (Still Has Recurring Transactions And Bills)", func(t *testing.T) {
+		// 1. Setup
+		m.walletRepo.EXPECT().
+			CheckOwnership(ctx, int64(3), testUserID).
+			Return(&models.Wallet{ID: 3, UserID: testUserID}, nil).
+			Once()
+
+		m.trxRepo.EXPECT().CountByWalletID(ctx, int64(3)).Return(int64(0), nil).Once()
+		m.transferRepo.EXPECT().CountByWalletID(ctx, int64(3)).Return(int64(0), nil).Once()
+
+		// Template berulang & tagihan akan ikut terhapus (ON DELETE CASCADE) bila dompet dihapus
+		m.recurringRepo.EXPECT().CountByWalletID(ctx, int64(3)).Return(int64(2), nil).Once()
+		m.billRepo.EXPECT().CountByWalletID(ctx, int64(3)).Return(int64(1), nil).Once()
+
+		// 2. Act
+		err := service.DeleteWallet(ctx, 3, testUserID)
+
+		// 3. Assert
+		var inUse *WalletInUseError
+		assert.ErrorAs(t, err, &inUse)
+		assert.Equal(t, int64(2), inUse.RecurringCount)
+		assert.Equal(t, int64(1), inUse.BillCount)
+		m.walletRepo.AssertNotCalled(t, "Delete", ctx, int64(3))
+	})
+
+	t.Run("Fail - Conflict (Still Has Balance)", func(t *testing.T) {
+		// 1. Setup: dompet tanpa riwayat tapi masih menyimpan saldo awal
+		m.walletRepo.EXPECT().
+			CheckOwnership(ctx, int64(4), testUserID).
+			Return(&models.Wallet{ID: 4, UserID: testUserID, Balance: 150000}, nil).
+			Once()
+
+		m.trxRepo.EXPECT().CountByWalletID(ctx, int64(4)).Return(int64(0), nil).Once()
+		m.transferRepo.EXPECT().CountByWalletID(ctx, int64(4)).Return(int64(0), nil).Once()
+		m.recurringRepo.EXPECT().CountByWalletID(ctx, int64(4)).Return(int64(0), nil).Once()
+		m.billRepo.EXPECT().CountByWalletID(ctx, int64(4)).Return(int64(0), nil).Once()
+
+		// 2. Act
+		err := service.DeleteWallet(ctx, 4, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrWalletHasBalance)
+		m.walletRepo.AssertNotCalled(t, "Delete", ctx, int64(4))
+	})
+}
+
+func TestWalletService_ReassignAndDeleteWallet(t *testing.T) {
+	service, mockRepo, _, mockTransferRepo := setupWalletService(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+
+	t.Run("Fail - Same Wallet", func(t *testing.T) {
+		// 2. Act
+		err := service.ReassignAndDeleteWallet(ctx, 1, 1, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrSameWallet)
 	})
+
+	t.Run("Fail - Target Not Owned", func(t *testing.T) {
+		// 1. Setup
+		mockRepo.EXPECT().
+			CheckOwnership(ctx, int64(1), testUserID).
+			Return(&models.Wallet{ID: 1}, nil).
+			Once()
+
+		mockRepo.EXPECT().
+			CheckOwnership(ctx, int64(99), testUserID).
+			Return(nil, errors.New("not found")).
+			Once()
+
+		// 2. Act
+		err := service.ReassignAndDeleteWallet(ctx, 1, 99, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("Fail - Transfers Between Both Wallets", func(t *testing.T) {
+		// 1. Setup
+		mockRepo.EXPECT().
+			CheckOwnership(ctx, int64(1), testUserID).
+			Return(&models.Wallet{ID: 1}, nil).
+			Once()
+
+		mockRepo.EXPECT().
+			CheckOwnership(ctx, int64(2), testUserID).
+			Return(&models.Wallet{ID: 2}, nil).
+			Once()
+
+		mockTransferRepo.EXPECT().
+			CountBetweenWallets(ctx, int64(1), int64(2)).
+			Return(int64(3), nil).
+			Once()
+
+		// 2. Act
+		err := service.ReassignAndDeleteWallet(ctx, 1, 2, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrConflict)
+	})
+
+	t.Run("Fail - Target Archived", func(t *testing.T) {
+		// 1. Setup
+		archivedAt := time.Now()
+		mockRepo.EXPECT().
+			CheckOwnership(ctx, int64(1), testUserID).
+			Return(&models.Wallet{ID: 1}, nil).
+			Once()
+
+		mockRepo.EXPECT().
+			CheckOwnership(ctx, int64(2), testUserID).
+			Return(&models.Wallet{ID: 2, ArchivedAt: &archivedAt}, nil).
+			Once()
+
+		// 2. Act
+		err := service.ReassignAndDeleteWallet(ctx, 1, 2, testUserID)
+
+		// 3. Assert
+		assert.ErrorIs(t, err, ErrWalletArchived)
+	})
+}
+
+func TestWalletService_ReassignAndDeleteWallet_MovesFullBalance(t *testing.T) {
+	service, m := setupWalletServiceWithMocks(t)
+	tx := m.tx
+	ctx := context.Background()
+	testUserID := uuid.New()
+
+	// 1. Setup
+	// Saldo awal 100000, ditambah transaksi bersih 25000 dan transfer keluar 5000
+	m.walletRepo.EXPECT().CheckOwnership(ctx, int64(1), testUserID).Return(&models.Wallet{ID: 1}, nil).Once()
+	m.walletRepo.EXPECT().CheckOwnership(ctx, int64(2), testUserID).Return(&models.Wallet{ID: 2}, nil).Once()
+	m.transferRepo.EXPECT().CountBetweenWallets(ctx, int64(1), int64(2)).Return(int64(0), nil).Once()
+	m.walletRepo.EXPECT().GetForUpdateTx(ctx, tx, int64(1)).Return(&models.Wallet{ID: 1, Balance: 120000}, nil).Once()
+	m.trxRepo.EXPECT().ReassignWalletTx(ctx, tx, int64(1), int64(2)).Return(int64(25000), nil).Once()
+	m.transferRepo.EXPECT().ReassignWalletTx(ctx, tx, int64(1), int64(2)).Return(int64(-5000), nil).Once()
+	m.recurringRepo.EXPECT().ReassignWalletTx(ctx, tx, int64(1), int64(2)).Return(nil).Once()
+	m.billRepo.EXPECT().ReassignWalletTx(ctx, tx, int64(1), int64(2)).Return(nil).Once()
+	m.walletRepo.EXPECT().UpdateBalanceTx(ctx, tx, int64(2), int64(120000)).Return(nil).Once()
+	m.walletRepo.EXPECT().DeleteTx(ctx, tx, int64(1)).Return(nil).Once()
+
+	// 2. Act
+	err := service.ReassignAndDeleteWallet(ctx, 1, 2, testUserID)
+
+	// 3. Assert
+	assert.NoError(t, err)
+	assert.True(t, tx.committed)
+}
+
+func TestWalletService_ArchiveWallet(t *testing.T) {
+	service, mockRepo, _, _ := setupWalletService(t)
+	ctx := context.Background()
+	testUserID := uuid.New()
+
+	// 1. Setup
+	mockRepo.EXPECT().
+		CheckOwnership(ctx, int64(1), testUserID).
+		Return(&models.Wallet{ID: 1, UserID: testUserID}, nil).
+		Once()
+
+	mockRepo.EXPECT().
+		Archive(ctx, int64(1)).
+		Return(nil).
+		Once()
+
+	// 2. Act
+	err := service.ArchiveWallet(ctx, 1, testUserID)
+
+	// 3. Assert
+	assert.NoError(t, err)
 }
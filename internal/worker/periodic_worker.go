@@ -0,0 +1,50 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Task memproses semua pekerjaan yang jatuh tempo sampai `now` dan mengembalikan jumlah
+// item yang diproses, contoh: RecurringService.ProcessDue.
+type Task func(ctx context.Context, now time.Time) (int, error)
+
+// PeriodicWorker menjalankan sebuah Task secara berkala di dalam proses API.
+type PeriodicWorker struct {
+	name     string
+	task     Task
+	interval time.Duration
+	now      func() time.Time
+}
+
+func NewPeriodicWorker(name string, task Task, interval time.Duration) *PeriodicWorker {
+	return &PeriodicWorker{name: name, task: task, interval: interval, now: time.Now}
+}
+
+// Start langsung memproses sekali (mengejar pekerjaan yang tertinggal saat server mati),
+// lalu mengulang setiap interval sampai ctx dibatalkan. Dipanggil sebagai goroutine.
+func (w *PeriodicWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.runOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *PeriodicWorker) runOnce(ctx context.Context) {
+	processed, err := w.task(ctx, w.now())
+	if err != nil {
+		log.Printf("Gagal memproses sebagian %s: %v", w.name, err)
+	}
+	if processed > 0 {
+		log.Printf("Berhasil memproses %d %s", processed, w.name)
+	}
+}
@@ -0,0 +1,68 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	serviceMocks "github.com/Udean777/uang-bijak-go/internal/service/mocks"
+)
+
+func TestPeriodicWorker_Start(t *testing.T) {
+	// 1. Setup
+	mockService := serviceMocks.NewMockRecurringService(t)
+	now := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	mockService.EXPECT().
+		ProcessDue(ctx, now).
+		Run(func(context.Context, time.Time) { cancel() }).
+		Return(2, nil).
+		Once()
+
+	w := NewPeriodicWorker("transaksi berulang", mockService.ProcessDue, time.Hour)
+	w.now = func() time.Time { return now }
+
+	// 2. Act: putaran pertama berjalan tanpa menunggu interval, lalu berhenti saat ctx dibatalkan
+	done := make(chan struct{})
+	go func() {
+		w.Start(ctx)
+		close(done)
+	}()
+
+	// 3. Assert
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		assert.Fail(t, "worker did not stop after context cancellation")
+	}
+}
+
+func TestPeriodicWorker_KeepsRunningAfterError(t *testing.T) {
+	// 1. Setup
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	runs := make(chan struct{}, 2)
+	task := func(context.Context, time.Time) (int, error) {
+		runs <- struct{}{}
+		return 0, errors.New("db down")
+	}
+	w := NewPeriodicWorker("tes", task, 10*time.Millisecond)
+
+	// 2. Act
+	go w.Start(ctx)
+
+	// 3. Assert: putaran kedua tetap berjalan meski putaran pertama gagal
+	for range 2 {
+		select {
+		case <-runs:
+		case <-time.After(time.Second):
+			assert.Fail(t, "worker stopped after a failed run")
+			return
+		}
+	}
+}
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS transfers (
+    id             BIGSERIAL PRIMARY KEY,
+    user_id        UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    from_wallet_id BIGINT      NOT NULL REFERENCES wallets (id),
+    to_wallet_id   BIGINT      NOT NULL REFERENCES wallets (id),
+    amount         BIGINT      NOT NULL CHECK (amount > 0),
+    fee            BIGINT      NOT NULL DEFAULT 0 CHECK (fee >= 0),
+    description    TEXT,
+    transfer_date  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT transfers_distinct_wallets CHECK (from_wallet_id <> to_wallet_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_transfers_user_date ON transfers (user_id, transfer_date DESC);
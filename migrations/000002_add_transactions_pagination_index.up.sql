@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS idx_transactions_user_date_id ON transactions (user_id, transaction_date DESC, id DESC);
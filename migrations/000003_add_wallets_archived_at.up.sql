@@ -0,0 +1 @@
+ALTER TABLE wallets ADD COLUMN IF NOT EXISTS archived_at TIMESTAMPTZ;
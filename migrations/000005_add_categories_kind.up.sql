@@ -0,0 +1,8 @@
+ALTER TABLE categories
+    ADD COLUMN IF NOT EXISTS kind VARCHAR(10) NOT NULL DEFAULT 'expense' CHECK (kind IN ('income', 'expense'));
+
+-- Kategori yang selama ini hanya dipakai untuk pemasukan ditandai sebagai 'income'
+UPDATE categories c
+SET kind = 'income'
+WHERE EXISTS (SELECT 1 FROM transactions t WHERE t.category_id = c.id)
+  AND NOT EXISTS (SELECT 1 FROM transactions t WHERE t.category_id = c.id AND t.type <> 'income');
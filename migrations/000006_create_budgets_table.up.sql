@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS budgets (
+    id          BIGSERIAL PRIMARY KEY,
+    user_id     UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    category_id BIGINT      NOT NULL REFERENCES categories (id) ON DELETE CASCADE,
+    year        INT         NOT NULL CHECK (year BETWEEN 2000 AND 2100),
+    month       INT         NOT NULL CHECK (month BETWEEN 1 AND 12),
+    amount      BIGINT      NOT NULL CHECK (amount > 0),
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT budgets_category_period_unique UNIQUE (category_id, year, month)
+);
+
+CREATE INDEX IF NOT EXISTS idx_budgets_user_period ON budgets (user_id, year, month);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS envelopes;
+
+ALTER TABLE budgets DROP COLUMN IF EXISTS rollover;
@@ -0,0 +1,17 @@
+ALTER TABLE budgets
+    ADD COLUMN IF NOT EXISTS alert_thresholds INT[] NOT NULL DEFAULT '{80,100}';
+
+CREATE TABLE IF NOT EXISTS notifications (
+    id         BIGSERIAL PRIMARY KEY,
+    user_id    UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    type       VARCHAR(50) NOT NULL,
+    message    TEXT        NOT NULL,
+    budget_id  BIGINT      REFERENCES budgets (id) ON DELETE CASCADE,
+    threshold  INT,
+    dedup_key  VARCHAR(100) NOT NULL,
+    read_at    TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT notifications_dedup_unique UNIQUE (user_id, dedup_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user_created ON notifications (user_id, created_at DESC);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_transactions_recurring_occurrence;
+
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS recurrence_index,
+    DROP COLUMN IF EXISTS recurring_id;
+
+DROP TABLE IF EXISTS recurring_transactions;
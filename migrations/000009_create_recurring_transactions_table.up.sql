@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS recurring_transactions (
+    id               BIGSERIAL PRIMARY KEY,
+    user_id          UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    wallet_id        BIGINT      NOT NULL REFERENCES wallets (id) ON DELETE CASCADE,
+    category_id      BIGINT      NOT NULL REFERENCES categories (id) ON DELETE CASCADE,
+    amount           BIGINT      NOT NULL CHECK (amount > 0),
+    type             VARCHAR(10) NOT NULL CHECK (type IN ('expense', 'income')),
+    description      TEXT,
+    frequency        VARCHAR(10) NOT NULL CHECK (frequency IN ('daily', 'weekly', 'monthly', 'yearly')),
+    repeat_interval  INT         NOT NULL DEFAULT 1 CHECK (repeat_interval >= 1),
+    start_date       TIMESTAMPTZ NOT NULL,
+    end_date         TIMESTAMPTZ,
+    count            INT CHECK (count >= 1),
+    occurrence_count INT         NOT NULL DEFAULT 0,
+    next_run_at      TIMESTAMPTZ,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at       TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_recurring_transactions_user_id ON recurring_transactions (user_id);
+CREATE INDEX IF NOT EXISTS idx_recurring_transactions_next_run_at ON recurring_transactions (next_run_at)
+    WHERE next_run_at IS NOT NULL;
+
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS recurring_id BIGINT REFERENCES recurring_transactions (id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS recurrence_index INT;
+
+-- Pengaman terakhir: satu kemunculan template tidak boleh tercatat dua kali
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transactions_recurring_occurrence
+    ON transactions (recurring_id, recurrence_index);
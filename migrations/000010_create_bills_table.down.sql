@@ -0,0 +1,3 @@
+ALTER TABLE notifications DROP COLUMN IF EXISTS bill_id;
+
+DROP TABLE IF EXISTS bills;
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS bills (
+    id                 BIGSERIAL PRIMARY KEY,
+    user_id            UUID         NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    wallet_id          BIGINT       NOT NULL REFERENCES wallets (id) ON DELETE CASCADE,
+    category_id        BIGINT       NOT NULL REFERENCES categories (id) ON DELETE CASCADE,
+    name               VARCHAR(100) NOT NULL,
+    amount             BIGINT       NOT NULL CHECK (amount > 0),
+    frequency          VARCHAR(10)  NOT NULL CHECK (frequency IN ('once', 'daily', 'weekly', 'monthly', 'yearly')),
+    repeat_interval    INT          NOT NULL DEFAULT 1 CHECK (repeat_interval >= 1),
+    start_date         TIMESTAMPTZ  NOT NULL,
+    end_date           TIMESTAMPTZ,
+    count              INT CHECK (count >= 1),
+    remind_days_before INT          NOT NULL DEFAULT 3 CHECK (remind_days_before BETWEEN 0 AND 30),
+    paid_count         INT          NOT NULL DEFAULT 0,
+    due_date           TIMESTAMPTZ,
+    created_at         TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at         TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_bills_user_id ON bills (user_id);
+CREATE INDEX IF NOT EXISTS idx_bills_due_date ON bills (due_date) WHERE due_date IS NOT NULL;
+
+ALTER TABLE notifications
+    ADD COLUMN IF NOT EXISTS bill_id BIGINT REFERENCES bills (id) ON DELETE CASCADE;
@@ -0,0 +1,10 @@
+-- Preferensi per user; user tanpa baris di tabel ini memakai nilai default aplikasi
+CREATE TABLE IF NOT EXISTS user_preferences (
+    user_id           UUID PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    currency          CHAR(3)     NOT NULL DEFAULT 'IDR',
+    timezone          VARCHAR(64) NOT NULL DEFAULT 'Asia/Jakarta',
+    locale            VARCHAR(10) NOT NULL DEFAULT 'id',
+    first_day_of_week SMALLINT    NOT NULL DEFAULT 1 CHECK (first_day_of_week BETWEEN 0 AND 6),
+    month_start_day   SMALLINT    NOT NULL DEFAULT 1 CHECK (month_start_day BETWEEN 1 AND 28),
+    updated_at        TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
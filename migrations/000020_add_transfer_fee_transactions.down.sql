@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_transfers_user_date_id;
+CREATE INDEX IF NOT EXISTS idx_transfers_user_date ON transfers (user_id, transfer_date DESC);
+
+-- Biaya kembali menjadi bagian dari potongan transfer, saldo dompet tetap sama
+DELETE FROM transactions WHERE transfer_id IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_transactions_transfer_id;
+
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS transfer_id;
@@ -0,0 +1,29 @@
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS transfer_id BIGINT REFERENCES transfers (id) ON DELETE CASCADE;
+
+-- Satu transfer paling banyak memiliki satu transaksi biaya admin
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transactions_transfer_id ON transactions (transfer_id);
+
+-- Biaya transfer lama dicatat ulang sebagai pengeluaran di kategori "Biaya Transfer".
+-- Saldo dompet tidak berubah: biaya sudah terpotong, kini sebagai transaksi, bukan bagian transfer.
+INSERT INTO categories (user_id, name, kind)
+SELECT DISTINCT tr.user_id, 'Biaya Transfer', 'expense'
+FROM transfers tr
+WHERE tr.fee > 0
+  AND NOT EXISTS (
+      SELECT 1 FROM categories c
+      WHERE c.user_id = tr.user_id AND c.name = 'Biaya Transfer' AND c.kind = 'expense'
+  );
+
+INSERT INTO transactions (user_id, wallet_id, category_id, amount, type, description, transaction_date, transfer_id)
+SELECT tr.user_id, tr.from_wallet_id,
+       (SELECT c.id FROM categories c
+        WHERE c.user_id = tr.user_id AND c.name = 'Biaya Transfer' AND c.kind = 'expense'
+        ORDER BY c.id LIMIT 1),
+       tr.fee, 'expense', tr.description, tr.transfer_date, tr.id
+FROM transfers tr
+WHERE tr.fee > 0
+  AND NOT EXISTS (SELECT 1 FROM transactions t WHERE t.transfer_id = tr.id);
+
+DROP INDEX IF EXISTS idx_transfers_user_date;
+CREATE INDEX IF NOT EXISTS idx_transfers_user_date_id ON transfers (user_id, transfer_date DESC, id DESC);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_transactions_envelope_id;
+
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS envelope_id;
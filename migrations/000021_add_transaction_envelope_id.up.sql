@@ -0,0 +1,31 @@
+-- Amplop yang benar-benar dipotong saat transaksi dicatat. Pengubahan/penghapusan transaksi hanya
+-- mengembalikan dana ke amplop ini, bukan ke amplop kategori saat ini.
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS envelope_id BIGINT REFERENCES envelopes (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_transactions_envelope_id ON transactions (envelope_id);
+
+-- Transaksi lama: amplop kategori (atau leluhur terdekat) yang sudah ada saat transaksi dibuat.
+-- Transaksi yang lebih tua dari amplopnya tidak pernah memotong amplop, jadi dibiarkan NULL.
+WITH RECURSIVE chain AS (
+    SELECT t.id AS transaction_id, t.created_at AS transaction_created_at, c.id AS category_id, c.parent_id, 0 AS depth
+    FROM transactions t
+    JOIN categories c ON c.id = t.category_id
+    WHERE t.type = 'expense'
+    UNION ALL
+    SELECT chain.transaction_id, chain.transaction_created_at, c.id, c.parent_id, chain.depth + 1
+    FROM categories c
+    JOIN chain ON c.id = chain.parent_id
+    WHERE chain.depth < 3
+),
+drawn AS (
+    SELECT DISTINCT ON (chain.transaction_id) chain.transaction_id, e.id AS envelope_id
+    FROM chain
+    JOIN envelopes e ON e.category_id = chain.category_id
+    WHERE e.created_at <= chain.transaction_created_at
+    ORDER BY chain.transaction_id, chain.depth ASC
+)
+UPDATE transactions t
+SET envelope_id = drawn.envelope_id
+FROM drawn
+WHERE t.id = drawn.transaction_id;